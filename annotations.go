@@ -0,0 +1,96 @@
+package sdk
+
+import (
+	"context"
+	"fmt"
+)
+
+// Annotation keys commonly set by the Neon integrations.
+const (
+	// AnnotationKeyVercelCommitRef the git ref of the Vercel deployment the branch was created for.
+	AnnotationKeyVercelCommitRef = "vercel-commit-ref"
+	// AnnotationKeyVercelCommitSHA the git commit SHA of the Vercel deployment the branch was created for.
+	AnnotationKeyVercelCommitSHA = "vercel-commit-sha"
+	// AnnotationKeyGithubCommitRef the git ref of the GitHub workflow the branch was created by.
+	AnnotationKeyGithubCommitRef = "github-commit-ref"
+	// AnnotationKeyGithubCommitSHA the git commit SHA of the GitHub workflow the branch was created by.
+	AnnotationKeyGithubCommitSHA = "github-commit-sha"
+)
+
+// NewAnnotationValue creates the annotation value from the key-value pairs.
+func NewAnnotationValue(kv map[string]string) AnnotationValueData {
+	o := make(AnnotationValueData, len(kv))
+	for k, v := range kv {
+		o[k] = v
+	}
+	return o
+}
+
+// Get returns the annotation's string value and the flag indicating whether the key is set.
+func (v AnnotationValueData) Get(key string) (string, bool) {
+	el, ok := v[key]
+	if !ok {
+		return "", false
+	}
+	s, ok := el.(string)
+	return s, ok
+}
+
+// Set sets the annotation's value. Note that the receiver must not be nil.
+func (v AnnotationValueData) Set(key, value string) {
+	v[key] = value
+}
+
+// CreateProjectBranchWithAnnotations creates the branch annotated with the given values.
+// Note that the API only accepts annotations upon the branch creation, it does not expose
+// an endpoint to update annotations of the existing branch.
+func (c Client) CreateProjectBranchWithAnnotations(
+	ctx context.Context, projectID string, branch BranchCreateRequest, annotations AnnotationValueData,
+) (CreatedBranch, error) {
+	if err := ctx.Err(); err != nil {
+		return CreatedBranch{}, err
+	}
+
+	cfg := &CreateProjectBranchReqObj{BranchCreateRequest: branch}
+	if len(annotations) > 0 {
+		cfg.AnnotationValue = &annotations
+	}
+
+	resp, err := c.CreateProjectBranch(projectID, cfg)
+	if err != nil {
+		return CreatedBranch{}, fmt.Errorf("could not create annotated branch in project %s: %w", projectID, err)
+	}
+	return resp, nil
+}
+
+// ListBranchAnnotations returns the annotations of the project's branches keyed by the branch ID.
+// The branches without annotations are omitted.
+func (c Client) ListBranchAnnotations(ctx context.Context, projectID string) (map[string]AnnotationValueData, error) {
+	if err := ctx.Err(); err != nil {
+		return nil, err
+	}
+
+	resp, err := c.ListProjectBranches(projectID, nil)
+	if err != nil {
+		return nil, fmt.Errorf("could not list branches of project %s: %w", projectID, err)
+	}
+
+	o := make(map[string]AnnotationValueData, len(resp.Annotations))
+	for branchID, a := range resp.Annotations {
+		o[branchID] = a.Value
+	}
+	return o, nil
+}
+
+// GetBranchAnnotation returns the annotation of the branch.
+func (c Client) GetBranchAnnotation(ctx context.Context, projectID, branchID string) (AnnotationValueData, error) {
+	if err := ctx.Err(); err != nil {
+		return nil, err
+	}
+
+	resp, err := c.GetProjectBranch(projectID, branchID)
+	if err != nil {
+		return nil, fmt.Errorf("could not read branch %s: %w", branchID, err)
+	}
+	return resp.Annotation.Value, nil
+}
@@ -0,0 +1,106 @@
+package sdk
+
+import (
+	"context"
+	"io"
+	"net/http"
+	"strings"
+	"testing"
+)
+
+func TestAnnotationValueData_GetSet(t *testing.T) {
+	v := NewAnnotationValue(map[string]string{AnnotationKeyVercelCommitRef: "main"})
+	v.Set(AnnotationKeyGithubCommitSHA, "abc")
+	v["foo"] = 1
+
+	tests := []struct {
+		name   string
+		key    string
+		want   string
+		wantOK bool
+	}{
+		{name: "from constructor", key: AnnotationKeyVercelCommitRef, want: "main", wantOK: true},
+		{name: "from setter", key: AnnotationKeyGithubCommitSHA, want: "abc", wantOK: true},
+		{name: "missing key", key: "bar", want: "", wantOK: false},
+		{name: "not a string", key: "foo", want: "", wantOK: false},
+	}
+	for _, tt := range tests {
+		t.Run(
+			tt.name, func(t *testing.T) {
+				got, ok := v.Get(tt.key)
+				if got != tt.want || ok != tt.wantOK {
+					t.Errorf("Get() = %v, %v, want %v, %v", got, ok, tt.want, tt.wantOK)
+				}
+			},
+		)
+	}
+}
+
+func TestClient_CreateProjectBranchWithAnnotations(t *testing.T) {
+	var gotBody string
+	api := newFakeAPI().onFunc(
+		http.MethodPost, "/projects/foo/branches", func(req *http.Request) (int, string) {
+			b, _ := io.ReadAll(req.Body)
+			gotBody = string(b)
+			return http.StatusCreated, `{"branch":{"id":"br-foo"},"operations":[],"endpoints":[],"databases":[],"roles":[]}`
+		},
+	)
+	c, err := NewClient(Config{Key: "foo", HTTPClient: api})
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	got, err := c.CreateProjectBranchWithAnnotations(
+		context.TODO(), "foo", BranchCreateRequest{},
+		NewAnnotationValue(map[string]string{AnnotationKeyVercelCommitRef: "main"}),
+	)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if got.Branch.ID != "br-foo" {
+		t.Errorf("unexpected branch: %v", got.Branch)
+	}
+	if !strings.Contains(gotBody, `"annotation_value":{"vercel-commit-ref":"main"}`) {
+		t.Errorf("annotation is missing in the request body: %s", gotBody)
+	}
+}
+
+func TestClient_ListBranchAnnotations(t *testing.T) {
+	api := newFakeAPI().on(
+		http.MethodGet, "/projects/foo/branches", http.StatusOK, `{"branches":[],"annotations":{
+"br-foo":{"object":{"type":"console/branch","id":"br-foo"},"value":{"vercel-commit-ref":"main"}}
+}}`,
+	)
+	c, err := NewClient(Config{Key: "foo", HTTPClient: api})
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	got, err := c.ListBranchAnnotations(context.TODO(), "foo")
+	if err != nil {
+		t.Fatal(err)
+	}
+	if v, _ := got["br-foo"].Get(AnnotationKeyVercelCommitRef); v != "main" || len(got) != 1 {
+		t.Errorf("unexpected annotations: %v", got)
+	}
+}
+
+func TestClient_GetBranchAnnotation(t *testing.T) {
+	api := newFakeAPI().on(
+		http.MethodGet, "/projects/foo/branches/br-foo", http.StatusOK, `{"branch":{"id":"br-foo"},"annotation":{
+"object":{"type":"console/branch","id":"br-foo"},"value":{"github-commit-ref":"dev"}
+}}`,
+	)
+	c, err := NewClient(Config{Key: "foo", HTTPClient: api})
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	got, err := c.GetBranchAnnotation(context.TODO(), "foo", "br-foo")
+	if err != nil {
+		t.Fatal(err)
+	}
+	if v, _ := got.Get(AnnotationKeyGithubCommitRef); v != "dev" {
+		t.Errorf("unexpected annotation: %v", got)
+	}
+}
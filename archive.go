@@ -0,0 +1,127 @@
+package sdk
+
+import (
+	"context"
+	"fmt"
+)
+
+// archivedAllowedIP the only IP allowed to connect to the archived project. The empty list of the allowed IPs
+// permits connections from all IPs, hence the loopback address is used to deny all external connections.
+const archivedAllowedIP = "127.0.0.1"
+
+// ArchiveProjectOptions defines how the project is archived.
+type ArchiveProjectOptions struct {
+	// RestrictAllowedIps denies connections to all project's branches by restricting the allowed IPs.
+	RestrictAllowedIps bool
+}
+
+// ProjectArchive the state of the project before archival required to unarchive it.
+// It is JSON-serializable, hence it can be persisted by the caller.
+type ProjectArchive struct {
+	ProjectID string `json:"project_id"`
+	// ProtectedBranchIDs the branches protected upon archival, the branches protected before are not listed.
+	ProtectedBranchIDs []string `json:"protected_branch_ids,omitempty"`
+	// AllowedIps the allowed IPs before archival, nil if they were not restricted upon archival.
+	AllowedIps *AllowedIps `json:"allowed_ips,omitempty"`
+	// AllowedIpsRestricted defines if the allowed IPs were restricted upon archival.
+	AllowedIpsRestricted bool `json:"allowed_ips_restricted,omitempty"`
+}
+
+// ArchiveProject suspends all project's compute endpoints, protects all branches, and optionally restricts
+// the allowed IPs to deny all connections. It returns the state required to reverse the changes with
+// UnarchiveProject. If a change fails, the state of the changes applied until the failure is returned
+// along with the error.
+func (c Client) ArchiveProject(
+	ctx context.Context, projectID string, opts ArchiveProjectOptions,
+) (ProjectArchive, error) {
+	o := ProjectArchive{ProjectID: projectID}
+
+	if opts.RestrictAllowedIps {
+		resp, err := c.GetProject(projectID)
+		if err != nil {
+			return o, fmt.Errorf("could not read project %s: %w", projectID, err)
+		}
+		if s := resp.Project.Settings; s != nil && s.AllowedIps != nil {
+			o.AllowedIps = s.AllowedIps
+		} else {
+			o.AllowedIps = &AllowedIps{}
+		}
+
+		ips, protectedOnly := []string{archivedAllowedIP}, false
+		if err := c.updateAllowedIps(
+			ctx, projectID, AllowedIps{Ips: &ips, ProtectedBranchesOnly: &protectedOnly},
+		); err != nil {
+			o.AllowedIps = nil
+			return o, err
+		}
+		o.AllowedIpsRestricted = true
+	}
+
+	branches, err := c.ListProjectBranches(projectID, nil)
+	if err != nil {
+		return o, fmt.Errorf("could not list branches of project %s: %w", projectID, err)
+	}
+	for _, b := range branches.Branches {
+		if b.Protected {
+			continue
+		}
+		if err := c.setBranchProtected(ctx, projectID, b.ID, true); err != nil {
+			return o, err
+		}
+		o.ProtectedBranchIDs = append(o.ProtectedBranchIDs, b.ID)
+	}
+
+	if err := c.SuspendAllEndpoints(ctx, projectID, 0); err != nil {
+		return o, fmt.Errorf("could not suspend endpoints of project %s: %w", projectID, err)
+	}
+	return o, nil
+}
+
+// UnarchiveProject reverses the changes of ArchiveProject: it unprotects the branches protected upon archival,
+// and restores the allowed IPs. The compute endpoints are started on the next connection.
+func (c Client) UnarchiveProject(ctx context.Context, archive ProjectArchive) error {
+	for _, branchID := range archive.ProtectedBranchIDs {
+		if err := c.setBranchProtected(ctx, archive.ProjectID, branchID, false); err != nil && !isNotFound(err) {
+			return err
+		}
+	}
+
+	if archive.AllowedIpsRestricted && archive.AllowedIps != nil {
+		v := *archive.AllowedIps
+		if v.Ips == nil {
+			v.Ips = &[]string{}
+		}
+		return c.updateAllowedIps(ctx, archive.ProjectID, v)
+	}
+	return nil
+}
+
+func (c Client) updateAllowedIps(ctx context.Context, projectID string, v AllowedIps) error {
+	resp, err := callUnlessLocked(
+		ctx, func() (UpdateProjectRespObj, error) {
+			return c.UpdateProject(
+				projectID, ProjectUpdateRequest{
+					Project: ProjectUpdateRequestProject{Settings: &ProjectSettingsData{AllowedIps: &v}},
+				},
+			)
+		},
+	)
+	if err != nil {
+		return fmt.Errorf("could not update allowed IPs of project %s: %w", projectID, err)
+	}
+	return c.WaitForOperations(ctx, projectID, resp.Operations)
+}
+
+func (c Client) setBranchProtected(ctx context.Context, projectID, branchID string, protected bool) error {
+	resp, err := callUnlessLocked(
+		ctx, func() (BranchOperations, error) {
+			return c.UpdateProjectBranch(
+				projectID, branchID, BranchUpdateRequest{Branch: BranchUpdateRequestBranch{Protected: &protected}},
+			)
+		},
+	)
+	if err != nil {
+		return fmt.Errorf("could not update protection of branch %s: %w", branchID, err)
+	}
+	return c.WaitForOperations(ctx, projectID, resp.Operations)
+}
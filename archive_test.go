@@ -0,0 +1,103 @@
+package sdk
+
+import (
+	"context"
+	"encoding/json"
+	"io"
+	"net/http"
+	"reflect"
+	"strings"
+	"sync"
+	"testing"
+)
+
+func TestClient_ArchiveProject(t *testing.T) {
+	var (
+		mu       sync.Mutex
+		requests = map[string][]string{}
+	)
+	record := func(code int, body string) func(req *http.Request) (int, string) {
+		return func(req *http.Request) (int, string) {
+			b, _ := io.ReadAll(req.Body)
+			mu.Lock()
+			key := strings.TrimPrefix(req.URL.Path, "/api/v2")
+			requests[key] = append(requests[key], string(b))
+			mu.Unlock()
+			return code, body
+		}
+	}
+
+	api := newFakeAPI().
+		on(
+			http.MethodGet, "/projects/foo", http.StatusOK,
+			`{"project":{"id":"foo","settings":{"allowed_ips":{"ips":["10.0.0.1"],"protected_branches_only":true}}}}`,
+		).
+		onFunc(http.MethodPatch, "/projects/foo", record(http.StatusOK, `{"project":{"id":"foo"},"operations":[]}`)).
+		on(
+			http.MethodGet, "/projects/foo/branches", http.StatusOK,
+			`{"branches":[{"id":"br-main","protected":true},{"id":"br-dev","protected":false}]}`,
+		).
+		onFunc(
+			http.MethodPatch, "/projects/foo/branches/br-dev",
+			record(http.StatusOK, `{"branch":{"id":"br-dev"},"operations":[]}`),
+		).
+		on(
+			http.MethodGet, "/projects/foo/endpoints", http.StatusOK,
+			`{"endpoints":[{"id":"ep-foo","current_state":"active"}]}`,
+		).
+		on(
+			http.MethodPost, "/projects/foo/endpoints/ep-foo/suspend", http.StatusOK,
+			`{"endpoint":{"id":"ep-foo"},"operations":[]}`,
+		)
+	c, err := NewClient(Config{Key: "foo", HTTPClient: api})
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	archive, err := c.ArchiveProject(context.TODO(), "foo", ArchiveProjectOptions{RestrictAllowedIps: true})
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	ips, protectedOnly := []string{"10.0.0.1"}, true
+	want := ProjectArchive{
+		ProjectID:            "foo",
+		ProtectedBranchIDs:   []string{"br-dev"},
+		AllowedIps:           &AllowedIps{Ips: &ips, ProtectedBranchesOnly: &protectedOnly},
+		AllowedIpsRestricted: true,
+	}
+	if !reflect.DeepEqual(archive, want) {
+		t.Errorf("ArchiveProject() got = %+v, want %+v", archive, want)
+	}
+	if api.called(http.MethodPost, "/projects/foo/endpoints/ep-foo/suspend") != 1 {
+		t.Errorf("endpoint shall be suspended")
+	}
+
+	// the archive is persisted by the caller
+	b, err := json.Marshal(archive)
+	if err != nil {
+		t.Fatal(err)
+	}
+	var restored ProjectArchive
+	if err := json.Unmarshal(b, &restored); err != nil {
+		t.Fatal(err)
+	}
+
+	if err := c.UnarchiveProject(context.TODO(), restored); err != nil {
+		t.Fatal(err)
+	}
+
+	wantRequests := map[string][]string{
+		"/projects/foo": {
+			`{"project":{"settings":{"allowed_ips":{"ips":["127.0.0.1"],"protected_branches_only":false}}}}`,
+			`{"project":{"settings":{"allowed_ips":{"ips":["10.0.0.1"],"protected_branches_only":true}}}}`,
+		},
+		"/projects/foo/branches/br-dev": {
+			`{"branch":{"protected":true}}`,
+			`{"branch":{"protected":false}}`,
+		},
+	}
+	if !reflect.DeepEqual(requests, wantRequests) {
+		t.Errorf("unexpected requests: %v, want %v", requests, wantRequests)
+	}
+}
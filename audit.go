@@ -0,0 +1,254 @@
+package sdk
+
+import (
+	"bytes"
+	"encoding/json"
+	"errors"
+	"io"
+	"net/http"
+	"net/url"
+	"sync"
+	"time"
+)
+
+// AuditOutcome the outcome of the audited call.
+type AuditOutcome string
+
+const (
+	AuditOutcomeSuccess AuditOutcome = "success"
+	AuditOutcomeFailure AuditOutcome = "failure"
+)
+
+// AuditRecord the record of the API call.
+type AuditRecord struct {
+	// Time the time the call started.
+	Time time.Time `json:"time"`
+	// Caller the identity of the caller defined by AuditLog.
+	Caller string `json:"caller,omitempty"`
+	Method string `json:"method"`
+	URL    string `json:"url"`
+	// Resources the IDs of the resources in the call's path keyed by the path parameter, e.g. "project_id".
+	Resources map[string]string `json:"resources,omitempty"`
+	Outcome   AuditOutcome      `json:"outcome"`
+	// HTTPCode the status code of the API error, 0 if the call succeeded, or failed before the response.
+	HTTPCode int `json:"http_code,omitempty"`
+	// Error the failure's message, empty if the call succeeded.
+	Error    string        `json:"error,omitempty"`
+	Duration time.Duration `json:"duration"`
+}
+
+// AuditSink receives the records of all calls. Record is called synchronously after every call,
+// hence the sink shall not block.
+type AuditSink interface {
+	Record(r AuditRecord)
+}
+
+// AuditSinkFunc the function implementing AuditSink.
+type AuditSinkFunc func(r AuditRecord)
+
+// Record calls f(r).
+func (f AuditSinkFunc) Record(r AuditRecord) {
+	f(r)
+}
+
+// AuditLog records every API call, successful or not, to the sink, e.g. to satisfy the change-management
+// requirements. Note that the calls rejected by DestructiveGuard are recorded as failures.
+type AuditLog struct {
+	Sink AuditSink
+	// Caller the identity of the caller added to every record, e.g. the name of the automation.
+	Caller string
+	// SkipReads records only the calls changing the resources, i.e. the calls other than GET.
+	SkipReads bool
+}
+
+func (a *AuditLog) record(method, u string, start time.Time, err error) {
+	if a == nil || a.Sink == nil || (a.SkipReads && method == http.MethodGet) {
+		return
+	}
+
+	r := AuditRecord{
+		Time:      start.UTC(),
+		Caller:    a.Caller,
+		Method:    method,
+		URL:       u,
+		Resources: auditResources(u),
+		Outcome:   AuditOutcomeSuccess,
+		Duration:  time.Since(start),
+	}
+	if err != nil {
+		r.Outcome = AuditOutcomeFailure
+		r.Error = err.Error()
+		var e Error
+		if errors.As(err, &e) {
+			r.HTTPCode = e.HTTPCode
+		}
+	}
+	a.Sink.Record(r)
+}
+
+// auditResourceKeys the path parameters following the collections of the API's paths.
+var auditResourceKeys = map[string]string{
+	"api_keys":      "key_id",
+	"branches":      "branch_id",
+	"databases":     "database_name",
+	"endpoints":     "endpoint_id",
+	"jwks":          "jwks_id",
+	"members":       "member_id",
+	"operations":    "operation_id",
+	"organizations": "org_id",
+	"permissions":   "permission_id",
+	"projects":      "project_id",
+	"roles":         "role_name",
+}
+
+// auditResources returns the IDs of the resources in the URL's path keyed by the path parameter.
+func auditResources(u string) map[string]string {
+	route := eventsRoute(u)
+	var o map[string]string
+	for i := 0; i+1 < len(route); i++ {
+		k, ok := auditResourceKeys[route[i]]
+		if !ok || (route[i] == "projects" && route[i+1] == "shared") {
+			continue
+		}
+		if o == nil {
+			o = map[string]string{}
+		}
+		v, err := url.PathUnescape(route[i+1])
+		if err != nil {
+			v = route[i+1]
+		}
+		o[k] = v
+		i++
+	}
+	return o
+}
+
+// NewAuditWriterSink returns AuditSink writing every record as the JSON line to the writer, e.g. to the file.
+// The writes are serialised, hence the sink can be used by the concurrent calls.
+func NewAuditWriterSink(w io.Writer) AuditSink {
+	return &auditWriterSink{enc: json.NewEncoder(w)}
+}
+
+type auditWriterSink struct {
+	mu  sync.Mutex
+	enc *json.Encoder
+}
+
+func (s *auditWriterSink) Record(r AuditRecord) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	_ = s.enc.Encode(r)
+}
+
+// ErrAuditQueueFull the error passed to AuditWebhookSink.OnError when the record is dropped
+// because the queue of the records awaiting the delivery is full.
+var ErrAuditQueueFull = errors.New("audit queue is full")
+
+// ErrAuditSinkClosed the error passed to AuditWebhookSink.OnError when the record is dropped
+// because the sink is closed.
+var ErrAuditSinkClosed = errors.New("audit sink is closed")
+
+// defaultAuditQueueSize the default maximum number of the records awaiting the delivery to the webhook.
+const defaultAuditQueueSize = 100
+
+// AuditWebhookSink posts every record as JSON to the URL. The records are queued by Record, and posted one by one
+// in the background, hence the slow webhook does not delay the calls. Close delivers the queued records.
+type AuditWebhookSink struct {
+	URL string
+	// HTTPClient the client to send the records, the client with the 10 seconds timeout is used if it is nil.
+	HTTPClient HTTPClient
+	// OnError is invoked when the record is not delivered, i.e. the request failed,
+	// or the response status code is not 2xx, or the record was dropped, see ErrAuditQueueFull
+	// and ErrAuditSinkClosed. It is invoked from the background goroutine for the failed deliveries.
+	OnError func(r AuditRecord, err error)
+	// QueueSize the maximum number of records awaiting the delivery, 100 if it is not positive.
+	QueueSize int
+
+	once   sync.Once
+	mu     sync.Mutex
+	closed bool
+	queue  chan AuditRecord
+	done   chan struct{}
+}
+
+// Record queues the record to be posted to the webhook. The record is dropped if the queue is full.
+func (s *AuditWebhookSink) Record(r AuditRecord) {
+	s.once.Do(s.start)
+
+	if err := s.enqueue(r); err != nil && s.OnError != nil {
+		s.OnError(r, err)
+	}
+}
+
+func (s *AuditWebhookSink) enqueue(r AuditRecord) error {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	if s.closed {
+		return ErrAuditSinkClosed
+	}
+	select {
+	case s.queue <- r:
+		return nil
+	default:
+		return ErrAuditQueueFull
+	}
+}
+
+// Close stops accepting the records, and returns once the queued records are posted.
+func (s *AuditWebhookSink) Close() error {
+	s.once.Do(s.start)
+
+	s.mu.Lock()
+	if !s.closed {
+		s.closed = true
+		close(s.queue)
+	}
+	s.mu.Unlock()
+	<-s.done
+	return nil
+}
+
+func (s *AuditWebhookSink) start() {
+	size := s.QueueSize
+	if size <= 0 {
+		size = defaultAuditQueueSize
+	}
+	s.queue = make(chan AuditRecord, size)
+	s.done = make(chan struct{})
+
+	go func() {
+		defer close(s.done)
+		for r := range s.queue {
+			if err := s.post(r); err != nil && s.OnError != nil {
+				s.OnError(r, err)
+			}
+		}
+	}()
+}
+
+func (s *AuditWebhookSink) post(r AuditRecord) error {
+	b, err := json.Marshal(r)
+	if err != nil {
+		return err
+	}
+	req, err := http.NewRequest(http.MethodPost, s.URL, bytes.NewReader(b))
+	if err != nil {
+		return err
+	}
+	req.Header.Set("Content-Type", "application/json")
+
+	client := s.HTTPClient
+	if client == nil {
+		client = &http.Client{Timeout: 10 * time.Second}
+	}
+	res, err := client.Do(req)
+	if err != nil {
+		return err
+	}
+	defer func() { _ = res.Body.Close() }()
+	_, _ = io.Copy(io.Discard, res.Body)
+	if res.StatusCode > 299 {
+		return errors.New("audit webhook responded with " + res.Status)
+	}
+	return nil
+}
@@ -0,0 +1,228 @@
+package sdk
+
+import (
+	"bytes"
+	"encoding/json"
+	"errors"
+	"io"
+	"net/http"
+	"reflect"
+	"strings"
+	"sync"
+	"testing"
+)
+
+func Test_auditResources(t *testing.T) {
+	tests := []struct {
+		name string
+		u    string
+		want map[string]string
+	}{
+		{
+			name: "branch's role",
+			u:    baseURL + "/projects/foo/branches/br-bar/roles/my%20role/reveal_password",
+			want: map[string]string{"project_id": "foo", "branch_id": "br-bar", "role_name": "my role"},
+		},
+		{
+			name: "organization's api key",
+			u:    baseURL + "/organizations/org-foo/api_keys/42",
+			want: map[string]string{"org_id": "org-foo", "key_id": "42"},
+		},
+		{
+			name: "shared projects",
+			u:    baseURL + "/projects/shared?limit=1",
+		},
+		{
+			name: "collection",
+			u:    baseURL + "/projects",
+		},
+	}
+	for _, tt := range tests {
+		t.Run(
+			tt.name, func(t *testing.T) {
+				if got := auditResources(tt.u); !reflect.DeepEqual(got, tt.want) {
+					t.Errorf("auditResources() = %v, want %v", got, tt.want)
+				}
+			},
+		)
+	}
+}
+
+func TestClient_Audit(t *testing.T) {
+	var got []AuditRecord
+	code := http.StatusOK
+	c, err := NewClient(
+		Config{
+			Key: "foo",
+			HTTPClient: doerFunc(
+				func(req *http.Request) (*http.Response, error) {
+					body := `{}`
+					if code != http.StatusOK {
+						body = `{"code":"","message":"not found"}`
+					}
+					return &http.Response{
+						StatusCode: code,
+						Body:       io.NopCloser(strings.NewReader(body)),
+						Request:    req,
+					}, nil
+				},
+			),
+			Audit: &AuditLog{
+				Sink: AuditSinkFunc(
+					func(r AuditRecord) {
+						got = append(got, r)
+					},
+				),
+				Caller: "ci-pipeline",
+			},
+		},
+	)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if _, err := c.GetProjectBranch("foo", "bar"); err != nil {
+		t.Fatal(err)
+	}
+	code = http.StatusNotFound
+	if _, err := c.GetProjectBranch("foo", "baz"); err == nil {
+		t.Fatal("error is expected")
+	}
+
+	if len(got) != 2 {
+		t.Fatalf("unexpected records: %+v", got)
+	}
+	if r := got[0]; r.Caller != "ci-pipeline" || r.Method != http.MethodGet || r.Outcome != AuditOutcomeSuccess ||
+		!reflect.DeepEqual(r.Resources, map[string]string{"project_id": "foo", "branch_id": "bar"}) {
+		t.Errorf("unexpected record of the successful call: %+v", r)
+	}
+	if r := got[1]; r.Outcome != AuditOutcomeFailure || r.HTTPCode != http.StatusNotFound || r.Error == "" ||
+		r.Resources["branch_id"] != "baz" {
+		t.Errorf("unexpected record of the failed call: %+v", r)
+	}
+}
+
+func TestNewAuditWriterSink(t *testing.T) {
+	var buf bytes.Buffer
+	sink := NewAuditWriterSink(&buf)
+	sink.Record(AuditRecord{Method: http.MethodDelete, URL: "foo", Outcome: AuditOutcomeSuccess})
+	sink.Record(AuditRecord{Method: http.MethodPost, URL: "bar", Outcome: AuditOutcomeFailure})
+
+	lines := strings.Split(strings.TrimSpace(buf.String()), "\n")
+	if len(lines) != 2 {
+		t.Fatalf("unexpected output: %s", buf.String())
+	}
+	var r AuditRecord
+	if err := json.Unmarshal([]byte(lines[1]), &r); err != nil {
+		t.Fatal(err)
+	}
+	if r.Method != http.MethodPost || r.Outcome != AuditOutcomeFailure {
+		t.Errorf("unexpected record: %+v", r)
+	}
+}
+
+func TestAuditWebhookSink_Record(t *testing.T) {
+	var (
+		mu      sync.Mutex
+		gotBody []byte
+		gotErrs []error
+	)
+	code := http.StatusOK
+	sink := &AuditWebhookSink{
+		URL: "https://audit.example.com/hook",
+		HTTPClient: doerFunc(
+			func(req *http.Request) (*http.Response, error) {
+				b, _ := io.ReadAll(req.Body)
+				mu.Lock()
+				gotBody = b
+				code := code
+				mu.Unlock()
+				return &http.Response{
+					StatusCode: code,
+					Status:     http.StatusText(code),
+					Body:       io.NopCloser(strings.NewReader("")),
+					Request:    req,
+				}, nil
+			},
+		),
+		OnError: func(_ AuditRecord, err error) {
+			mu.Lock()
+			defer mu.Unlock()
+			gotErrs = append(gotErrs, err)
+		},
+	}
+
+	sink.Record(AuditRecord{Method: http.MethodDelete, URL: "foo"})
+	waitFor(
+		t, func() bool {
+			mu.Lock()
+			defer mu.Unlock()
+			return gotBody != nil
+		},
+	)
+	mu.Lock()
+	if len(gotErrs) > 0 || !bytes.Contains(gotBody, []byte(`"method":"DELETE"`)) {
+		t.Fatalf("unexpected delivery: %s, errors: %v", gotBody, gotErrs)
+	}
+	code = http.StatusBadGateway
+	mu.Unlock()
+
+	sink.Record(AuditRecord{Method: http.MethodDelete, URL: "foo"})
+	if err := sink.Close(); err != nil {
+		t.Fatal(err)
+	}
+	if len(gotErrs) != 1 {
+		t.Errorf("delivery failure is expected to be reported, got %v", gotErrs)
+	}
+
+	sink.Record(AuditRecord{Method: http.MethodDelete, URL: "foo"})
+	if len(gotErrs) != 2 || !errors.Is(gotErrs[1], ErrAuditSinkClosed) {
+		t.Errorf("record is expected to be dropped by the closed sink, got %v", gotErrs)
+	}
+}
+
+func TestAuditWebhookSink_Record_queueFull(t *testing.T) {
+	var (
+		started = make(chan struct{}, 1)
+		release = make(chan struct{})
+		dropped []error
+	)
+	sink := &AuditWebhookSink{
+		URL:       "https://audit.example.com/hook",
+		QueueSize: 1,
+		HTTPClient: doerFunc(
+			func(req *http.Request) (*http.Response, error) {
+				select {
+				case started <- struct{}{}:
+				default:
+				}
+				<-release
+				return &http.Response{
+					StatusCode: http.StatusOK,
+					Body:       io.NopCloser(strings.NewReader("")),
+					Request:    req,
+				}, nil
+			},
+		),
+		OnError: func(_ AuditRecord, err error) {
+			dropped = append(dropped, err)
+		},
+	}
+
+	// the first record is being posted, the second one is queued, and the third one is dropped
+	sink.Record(AuditRecord{URL: "foo"})
+	<-started
+	sink.Record(AuditRecord{URL: "bar"})
+	sink.Record(AuditRecord{URL: "baz"})
+	if len(dropped) != 1 || !errors.Is(dropped[0], ErrAuditQueueFull) {
+		t.Errorf("record is expected to be dropped, got %v", dropped)
+	}
+
+	close(release)
+	if err := sink.Close(); err != nil {
+		t.Fatal(err)
+	}
+	if len(dropped) != 1 {
+		t.Errorf("queued record is expected to be delivered, got %v", dropped)
+	}
+}
@@ -0,0 +1,43 @@
+package sdk
+
+import (
+	"fmt"
+	"net/http"
+)
+
+// Authenticator sets the request's credentials before it is sent, e.g. the API key,
+// or the token exchanged for the marketplace-managed identity.
+type Authenticator interface {
+	Authenticate(req *http.Request) error
+}
+
+// AuthenticatorFunc the function implementing Authenticator.
+type AuthenticatorFunc func(req *http.Request) error
+
+func (f AuthenticatorFunc) Authenticate(req *http.Request) error {
+	return f(req)
+}
+
+// BearerKey authenticates the request with the API key passed as the Bearer token.
+type BearerKey string
+
+func (k BearerKey) Authenticate(req *http.Request) error {
+	req.Header.Set("Authorization", "Bearer "+string(k))
+	return nil
+}
+
+// authenticate sets the request's credentials using the Authenticator, or the Key if the Authenticator is not set.
+// The request is not sent if the Authenticator returns the error.
+func (c Client) authenticate(req *http.Request) error {
+	a := c.cfg.Authenticator
+	if a == nil {
+		if c.cfg.Key == "" {
+			return nil
+		}
+		a = BearerKey(c.cfg.Key)
+	}
+	if err := a.Authenticate(req); err != nil {
+		return fmt.Errorf("could not authenticate request %s %s: %w", req.Method, req.URL, err)
+	}
+	return nil
+}
@@ -0,0 +1,123 @@
+package sdk
+
+import (
+	"errors"
+	"io"
+	"net/http"
+	"strings"
+	"testing"
+)
+
+func TestNewClient_Authenticator(t *testing.T) {
+	tests := []struct {
+		name    string
+		cfg     Config
+		wantErr bool
+	}{
+		{
+			name: "key",
+			cfg:  Config{Key: "foo"},
+		},
+		{
+			name: "authenticator",
+			cfg:  Config{Authenticator: BearerKey("foo")},
+		},
+		{
+			name:    "unhappy path: no credentials",
+			cfg:     Config{},
+			wantErr: true,
+		},
+		{
+			name:    "unhappy path: key and authenticator",
+			cfg:     Config{Key: "foo", Authenticator: BearerKey("bar")},
+			wantErr: true,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(
+			tt.name, func(t *testing.T) {
+				if _, err := NewClient(tt.cfg); (err != nil) != tt.wantErr {
+					t.Errorf("NewClient() error = %v, wantErr %v", err, tt.wantErr)
+				}
+			},
+		)
+	}
+}
+
+func TestClient_authenticate(t *testing.T) {
+	errAuth := errors.New("token exchange failed")
+
+	tests := []struct {
+		name          string
+		cfg           Config
+		wantAuthz     string
+		wantErr       error
+		wantNotCalled bool
+	}{
+		{
+			name:      "key",
+			cfg:       Config{Key: "foo"},
+			wantAuthz: "Bearer foo",
+		},
+		{
+			name: "custom authenticator",
+			cfg: Config{
+				Authenticator: AuthenticatorFunc(
+					func(req *http.Request) error {
+						req.Header.Set("Authorization", "Bearer exchanged-token")
+						return nil
+					},
+				),
+			},
+			wantAuthz: "Bearer exchanged-token",
+		},
+		{
+			name: "unhappy path: authenticator failed",
+			cfg: Config{
+				Authenticator: AuthenticatorFunc(
+					func(*http.Request) error {
+						return errAuth
+					},
+				),
+			},
+			wantErr:       errAuth,
+			wantNotCalled: true,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(
+			tt.name, func(t *testing.T) {
+				var gotAuthz string
+				var called bool
+				cfg := tt.cfg
+				cfg.HTTPClient = doerFunc(
+					func(req *http.Request) (*http.Response, error) {
+						called = true
+						gotAuthz = req.Header.Get("Authorization")
+						return &http.Response{
+							StatusCode: http.StatusOK,
+							Body:       io.NopCloser(strings.NewReader(`{}`)),
+							Request:    req,
+						}, nil
+					},
+				)
+
+				c, err := NewClient(cfg)
+				if err != nil {
+					t.Fatal(err)
+				}
+
+				_, err = c.GetProjectBranch("foo", "bar")
+				if !errors.Is(err, tt.wantErr) {
+					t.Fatalf("unexpected error: %v, want %v", err, tt.wantErr)
+				}
+				if called == tt.wantNotCalled {
+					t.Errorf("unexpected request sent: %v", called)
+				}
+				if gotAuthz != tt.wantAuthz {
+					t.Errorf("unexpected Authorization header: %q, want %q", gotAuthz, tt.wantAuthz)
+				}
+			},
+		)
+	}
+}
@@ -0,0 +1,115 @@
+package sdk
+
+import (
+	"context"
+	"fmt"
+	"sort"
+	"strings"
+	"sync"
+)
+
+// BatchOptions defines how the batch helpers, e.g. ForEachProject, run the caller's function.
+type BatchOptions struct {
+	// Parallelism the maximum number of concurrent calls, the default is used if it is not positive.
+	Parallelism int
+	// RequestsPerSecond limits the rate of calls, no limit if it is not positive.
+	RequestsPerSecond float64
+	// ContinueOnError runs the function for all items regardless of failures and returns *BatchError
+	// aggregating the failures. By default, the first failure cancels the remaining calls and is returned.
+	ContinueOnError bool
+}
+
+// BatchError the failures of the batch keyed by the item's ID.
+type BatchError struct {
+	Errors map[string]error
+}
+
+func (e *BatchError) Error() string {
+	ids := make([]string, 0, len(e.Errors))
+	for id := range e.Errors {
+		ids = append(ids, id)
+	}
+	sort.Strings(ids)
+
+	msgs := make([]string, len(ids))
+	for i, id := range ids {
+		msgs[i] = id + ": " + e.Errors[id].Error()
+	}
+	return fmt.Sprintf("%d batch items failed: %s", len(ids), strings.Join(msgs, "; "))
+}
+
+// Unwrap returns the failures, hence errors.Is and errors.As match any of them starting from Go 1.20.
+func (e *BatchError) Unwrap() []error {
+	o := make([]error, 0, len(e.Errors))
+	for _, err := range e.Errors {
+		o = append(o, err)
+	}
+	return o
+}
+
+// ForEachProject lists all projects and calls fn for every project concurrently.
+// The context passed to fn is cancelled when ctx is cancelled, or when the batch fails.
+func (c Client) ForEachProject(
+	ctx context.Context, list ListAllProjectsOptions, opts BatchOptions,
+	fn func(ctx context.Context, p ProjectListItem) error,
+) error {
+	projects, err := c.ListAllProjects(ctx, list)
+	if err != nil {
+		return err
+	}
+	return runBatch(
+		ctx, projects, opts, func(p ProjectListItem) string { return p.ID }, fn,
+	)
+}
+
+// ForEachBranch lists all project's branches and calls fn for every branch concurrently.
+// The context passed to fn is cancelled when ctx is cancelled, or when the batch fails.
+func (c Client) ForEachBranch(
+	ctx context.Context, projectID string, opts BatchOptions, fn func(ctx context.Context, b Branch) error,
+) error {
+	if err := ctx.Err(); err != nil {
+		return err
+	}
+	resp, err := c.ListProjectBranches(projectID, nil)
+	if err != nil {
+		return fmt.Errorf("could not list branches of project %s: %w", projectID, err)
+	}
+	return runBatch(
+		ctx, resp.Branches, opts, func(b Branch) string { return b.ID }, fn,
+	)
+}
+
+// runBatch calls fn for every item with the bounded parallelism and rate.
+func runBatch[T any](
+	ctx context.Context, items []T, opts BatchOptions, id func(T) string, fn func(context.Context, T) error,
+) error {
+	limiter := newRateLimiter(opts.RequestsPerSecond)
+	defer limiter.stop()
+
+	var (
+		mu       sync.Mutex
+		failures = map[string]error{}
+	)
+	err := forEach(
+		ctx, len(items), opts.Parallelism, func(ctx context.Context, i int) error {
+			if err := limiter.wait(ctx); err != nil {
+				return err
+			}
+			err := fn(ctx, items[i])
+			if err == nil || !opts.ContinueOnError {
+				return err
+			}
+			mu.Lock()
+			failures[id(items[i])] = err
+			mu.Unlock()
+			return nil
+		},
+	)
+	if err != nil {
+		return err
+	}
+	if len(failures) > 0 {
+		return &BatchError{Errors: failures}
+	}
+	return nil
+}
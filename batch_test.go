@@ -0,0 +1,112 @@
+package sdk
+
+import (
+	"context"
+	"errors"
+	"net/http"
+	"sync"
+	"testing"
+)
+
+func TestClient_ForEachBranch(t *testing.T) {
+	api := newFakeAPI().on(
+		http.MethodGet, "/projects/foo/branches", http.StatusOK,
+		`{"branches":[{"id":"br-1"},{"id":"br-2"},{"id":"br-3"}]}`,
+	)
+	c, err := NewClient(Config{Key: "foo", HTTPClient: api})
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	errFoo := errors.New("foo")
+
+	tests := []struct {
+		name     string
+		opts     BatchOptions
+		failOn   string
+		wantErr  error
+		wantCall int
+	}{
+		{
+			name:     "all succeeded",
+			opts:     BatchOptions{Parallelism: 2, RequestsPerSecond: 1000},
+			wantCall: 3,
+		},
+		{
+			name:     "failures aggregated",
+			opts:     BatchOptions{Parallelism: 1, ContinueOnError: true},
+			failOn:   "br-2",
+			wantErr:  errFoo,
+			wantCall: 3,
+		},
+		{
+			name:    "first failure returned",
+			opts:    BatchOptions{Parallelism: 1},
+			failOn:  "br-1",
+			wantErr: errFoo,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(
+			tt.name, func(t *testing.T) {
+				var (
+					mu    sync.Mutex
+					calls int
+				)
+				err := c.ForEachBranch(
+					context.TODO(), "foo", tt.opts, func(_ context.Context, b Branch) error {
+						mu.Lock()
+						calls++
+						mu.Unlock()
+						if b.ID == tt.failOn {
+							return errFoo
+						}
+						return nil
+					},
+				)
+				if !errors.Is(err, tt.wantErr) {
+					t.Fatalf("ForEachBranch() error = %v, wantErr %v", err, tt.wantErr)
+				}
+				if tt.wantCall > 0 && calls != tt.wantCall {
+					t.Errorf("unexpected number of calls: %d, want %d", calls, tt.wantCall)
+				}
+
+				var e *BatchError
+				if errors.As(err, &e) != tt.opts.ContinueOnError && tt.wantErr != nil {
+					t.Errorf("BatchError expected only if ContinueOnError is set, got %v", err)
+				}
+				if e != nil && e.Errors[tt.failOn] == nil {
+					t.Errorf("failure of %s is missing: %v", tt.failOn, e)
+				}
+			},
+		)
+	}
+}
+
+func TestClient_ForEachProject(t *testing.T) {
+	api := newFakeAPI().on(
+		http.MethodGet, "/projects", http.StatusOK, `{"projects":[{"id":"foo"},{"id":"bar"}]}`,
+	)
+	c, err := NewClient(Config{Key: "foo", HTTPClient: api})
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	var (
+		mu  sync.Mutex
+		got = map[string]bool{}
+	)
+	if err := c.ForEachProject(
+		context.TODO(), ListAllProjectsOptions{}, BatchOptions{}, func(_ context.Context, p ProjectListItem) error {
+			mu.Lock()
+			got[p.ID] = true
+			mu.Unlock()
+			return nil
+		},
+	); err != nil {
+		t.Fatal(err)
+	}
+	if len(got) != 2 || !got["foo"] || !got["bar"] {
+		t.Errorf("unexpected projects: %v", got)
+	}
+}
@@ -0,0 +1,75 @@
+package sdk
+
+import (
+	"context"
+	"errors"
+	"fmt"
+)
+
+// ErrNotFound the error returned when the object searched by the helper does not exist.
+var ErrNotFound = errors.New("not found")
+
+// FindBranchByName returns the project's branch with exactly the name. The API's search matches
+// the branches by the substring of their names and IDs, hence the results are filtered client-side.
+// The error wrapping ErrNotFound is returned if no branch has the name.
+// Note that the branches' listing is not paginated by the API, all matching branches are returned at once.
+func (c Client) FindBranchByName(ctx context.Context, projectID, name string) (Branch, error) {
+	if err := ctx.Err(); err != nil {
+		return Branch{}, err
+	}
+
+	resp, err := c.ListProjectBranches(projectID, &name)
+	if err != nil {
+		return Branch{}, fmt.Errorf("could not list branches of project %s: %w", projectID, err)
+	}
+
+	for _, b := range resp.Branches {
+		if b.Name == name {
+			return b, nil
+		}
+	}
+	return Branch{}, fmt.Errorf("%w: branch %s in project %s", ErrNotFound, name, projectID)
+}
+
+// GetDefaultBranchEndpoint returns the project's default branch and the branch's read-write endpoint,
+// e.g. to build the connection string to the project's primary database.
+// The error wrapping ErrNotFound is returned if the project has no default branch,
+// or the default branch has no read-write endpoint.
+func (c Client) GetDefaultBranchEndpoint(ctx context.Context, projectID string) (Branch, Endpoint, error) {
+	if err := ctx.Err(); err != nil {
+		return Branch{}, Endpoint{}, err
+	}
+
+	branches, err := c.ListProjectBranches(projectID, nil)
+	if err != nil {
+		return Branch{}, Endpoint{}, fmt.Errorf("could not list branches of project %s: %w", projectID, err)
+	}
+
+	var branch *Branch
+	for i := range branches.Branches {
+		if branches.Branches[i].Default {
+			branch = &branches.Branches[i]
+			break
+		}
+	}
+	if branch == nil {
+		return Branch{}, Endpoint{}, fmt.Errorf("%w: default branch in project %s", ErrNotFound, projectID)
+	}
+
+	if err := ctx.Err(); err != nil {
+		return Branch{}, Endpoint{}, err
+	}
+	endpoints, err := c.ListProjectBranchEndpoints(projectID, branch.ID)
+	if err != nil {
+		return Branch{}, Endpoint{}, fmt.Errorf("could not list endpoints of branch %s: %w", branch.ID, err)
+	}
+
+	for _, e := range endpoints.Endpoints {
+		if e.Type == EndpointTypeReadWrite {
+			return *branch, e, nil
+		}
+	}
+	return *branch, Endpoint{}, fmt.Errorf(
+		"%w: read-write endpoint of branch %s in project %s", ErrNotFound, branch.ID, projectID,
+	)
+}
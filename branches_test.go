@@ -0,0 +1,100 @@
+package sdk
+
+import (
+	"context"
+	"errors"
+	"net/http"
+	"testing"
+)
+
+func TestClient_FindBranchByName(t *testing.T) {
+	api := newFakeAPI().onFunc(
+		http.MethodGet, "/projects/foo/branches", func(req *http.Request) (int, string) {
+			switch req.URL.Query().Get("search") {
+			case "dev", "qa":
+				return http.StatusOK, `{"branches":[
+{"id":"br-dev-2","name":"dev-2"},
+{"id":"br-dev","name":"dev"},
+{"id":"br-qa-2","name":"qa-2"}
+]}`
+			default:
+				return http.StatusInternalServerError, `{"message":"internal"}`
+			}
+		},
+	)
+
+	tests := []struct {
+		name         string
+		search       string
+		wantID       string
+		wantErr      bool
+		wantNotFound bool
+	}{
+		{
+			name:   "exact match among fuzzy results",
+			search: "dev",
+			wantID: "br-dev",
+		},
+		{
+			name:         "unhappy path: no exact match",
+			search:       "qa",
+			wantErr:      true,
+			wantNotFound: true,
+		},
+		{
+			name:    "unhappy path: API error",
+			search:  "feature",
+			wantErr: true,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(
+			tt.name, func(t *testing.T) {
+				c, err := NewClient(Config{Key: "foo", HTTPClient: api})
+				if err != nil {
+					t.Fatal(err)
+				}
+
+				got, err := c.FindBranchByName(context.TODO(), "foo", tt.search)
+				if (err != nil) != tt.wantErr {
+					t.Fatalf("FindBranchByName() error = %v, wantErr %v", err, tt.wantErr)
+				}
+				if errors.Is(err, ErrNotFound) != tt.wantNotFound {
+					t.Errorf("FindBranchByName() error = %v, wantNotFound %v", err, tt.wantNotFound)
+				}
+				if got.ID != tt.wantID {
+					t.Errorf("FindBranchByName() = %v, want %v", got.ID, tt.wantID)
+				}
+			},
+		)
+	}
+}
+
+func TestClient_GetDefaultBranchEndpoint(t *testing.T) {
+	api := newFakeAPI().
+		on(
+			http.MethodGet, "/projects/foo/branches", http.StatusOK,
+			`{"branches":[{"id":"br-dev","name":"dev"},{"id":"br-main","name":"main","default":true}]}`,
+		).
+		on(
+			http.MethodGet, "/projects/foo/branches/br-main/endpoints", http.StatusOK,
+			`{"endpoints":[{"id":"ep-ro","type":"read_only"},{"id":"ep-rw","type":"read_write"}]}`,
+		).
+		on(http.MethodGet, "/projects/bar/branches", http.StatusOK, `{"branches":[{"id":"br-dev","name":"dev"}]}`)
+	c, err := NewClient(Config{Key: "foo", HTTPClient: api})
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	b, e, err := c.GetDefaultBranchEndpoint(context.TODO(), "foo")
+	if err != nil {
+		t.Fatal(err)
+	}
+	if b.ID != "br-main" || e.ID != "ep-rw" {
+		t.Errorf("unexpected branch %s and endpoint %s", b.ID, e.ID)
+	}
+
+	if _, _, err := c.GetDefaultBranchEndpoint(context.TODO(), "bar"); !errors.Is(err, ErrNotFound) {
+		t.Errorf("unexpected error: %v", err)
+	}
+}
@@ -0,0 +1,67 @@
+package sdk
+
+import (
+	"context"
+	"fmt"
+)
+
+// BranchInventory the branch with its endpoints, roles and databases.
+type BranchInventory struct {
+	Branch     Branch
+	Annotation AnnotationData
+	Endpoints  []Endpoint
+	Roles      []Role
+	Databases  []Database
+}
+
+// ListBranchInventory fetches the branch, its endpoints, roles and databases concurrently.
+// The first error cancels the remaining calls and is returned.
+func (c Client) ListBranchInventory(ctx context.Context, projectID, branchID string) (BranchInventory, error) {
+	var o BranchInventory
+
+	calls := []func() error{
+		func() error {
+			resp, err := c.GetProjectBranch(projectID, branchID)
+			if err != nil {
+				return fmt.Errorf("could not read branch %s: %w", branchID, err)
+			}
+			o.Branch = resp.Branch
+			o.Annotation = resp.Annotation
+			return nil
+		},
+		func() error {
+			resp, err := c.ListProjectBranchEndpoints(projectID, branchID)
+			if err != nil {
+				return fmt.Errorf("could not list endpoints of branch %s: %w", branchID, err)
+			}
+			o.Endpoints = resp.Endpoints
+			return nil
+		},
+		func() error {
+			resp, err := c.ListProjectBranchRoles(projectID, branchID)
+			if err != nil {
+				return fmt.Errorf("could not list roles of branch %s: %w", branchID, err)
+			}
+			o.Roles = resp.Roles
+			return nil
+		},
+		func() error {
+			resp, err := c.ListProjectBranchDatabases(projectID, branchID)
+			if err != nil {
+				return fmt.Errorf("could not list databases of branch %s: %w", branchID, err)
+			}
+			o.Databases = resp.Databases
+			return nil
+		},
+	}
+
+	// every call sets its own attributes, hence no synchronisation is required
+	if err := forEach(
+		ctx, len(calls), len(calls), func(_ context.Context, i int) error {
+			return calls[i]()
+		},
+	); err != nil {
+		return BranchInventory{}, err
+	}
+	return o, nil
+}
@@ -0,0 +1,60 @@
+package sdk
+
+import (
+	"context"
+	"errors"
+	"net/http"
+	"testing"
+)
+
+func TestClient_ListBranchInventory(t *testing.T) {
+	newAPI := func() *fakeAPI {
+		return newFakeAPI().
+			on(http.MethodGet, "/projects/foo/branches/bar", http.StatusOK, `{"branch":{"id":"bar","name":"main"}}`).
+			on(
+				http.MethodGet, "/projects/foo/branches/bar/endpoints", http.StatusOK,
+				`{"endpoints":[{"id":"ep-foo","branch_id":"bar"}]}`,
+			).
+			on(http.MethodGet, "/projects/foo/branches/bar/roles", http.StatusOK, `{"roles":[{"name":"owner"}]}`).
+			on(
+				http.MethodGet, "/projects/foo/branches/bar/databases", http.StatusOK,
+				`{"databases":[{"name":"neondb","owner_name":"owner"},{"name":"app","owner_name":"owner"}]}`,
+			)
+	}
+
+	t.Run(
+		"happy path", func(t *testing.T) {
+			c, err := NewClient(Config{Key: "foo", HTTPClient: newAPI()})
+			if err != nil {
+				t.Fatal(err)
+			}
+
+			got, err := c.ListBranchInventory(context.TODO(), "foo", "bar")
+			if err != nil {
+				t.Fatal(err)
+			}
+			if got.Branch.Name != "main" || len(got.Endpoints) != 1 || len(got.Roles) != 1 || len(got.Databases) != 2 {
+				t.Errorf("unexpected inventory: %+v", got)
+			}
+		},
+	)
+
+	t.Run(
+		"unhappy path: roles cannot be listed", func(t *testing.T) {
+			api := newAPI().on(
+				http.MethodGet, "/projects/foo/branches/bar/roles", http.StatusInternalServerError,
+				`{"code":"","message":"foo"}`,
+			)
+			c, err := NewClient(Config{Key: "foo", HTTPClient: api})
+			if err != nil {
+				t.Fatal(err)
+			}
+
+			var e Error
+			if _, err := c.ListBranchInventory(context.TODO(), "foo", "bar"); !errors.As(err, &e) ||
+				e.HTTPCode != http.StatusInternalServerError {
+				t.Errorf("API error is expected, got %v", err)
+			}
+		},
+	)
+}
@@ -0,0 +1,134 @@
+package sdk
+
+import (
+	"context"
+	"fmt"
+	"time"
+)
+
+// BranchMetrics the consumption metrics of the branch.
+// The counters are accumulated over the current billing period and reset at its beginning.
+type BranchMetrics struct {
+	BranchID string
+	Name     string
+	// Annotation the branch's annotation, e.g. to attribute the consumption to the team, nil if not annotated.
+	Annotation AnnotationValueData
+
+	ActiveTimeSeconds  int64
+	ComputeTimeSeconds int64
+	CpuUsedSec         int64
+	WrittenDataBytes   int64
+	DataTransferBytes  int64
+	// LogicalSizeBytes the branch's logical size. It is the gauge, hence Diff reports its current value.
+	LogicalSizeBytes int64
+}
+
+// Add returns the sum of the metrics. The identifiers of the receiver are kept.
+func (m BranchMetrics) Add(v BranchMetrics) BranchMetrics {
+	m.ActiveTimeSeconds += v.ActiveTimeSeconds
+	m.ComputeTimeSeconds += v.ComputeTimeSeconds
+	m.CpuUsedSec += v.CpuUsedSec
+	m.WrittenDataBytes += v.WrittenDataBytes
+	m.DataTransferBytes += v.DataTransferBytes
+	m.LogicalSizeBytes += v.LogicalSizeBytes
+	return m
+}
+
+// sub returns the counters' increase since prev. The counter which decreased is considered reset
+// at the beginning of the billing period, hence its current value is the increase.
+func (m BranchMetrics) sub(prev BranchMetrics) BranchMetrics {
+	delta := func(cur, prev int64) int64 {
+		if cur < prev {
+			return cur
+		}
+		return cur - prev
+	}
+	m.ActiveTimeSeconds = delta(m.ActiveTimeSeconds, prev.ActiveTimeSeconds)
+	m.ComputeTimeSeconds = delta(m.ComputeTimeSeconds, prev.ComputeTimeSeconds)
+	m.CpuUsedSec = delta(m.CpuUsedSec, prev.CpuUsedSec)
+	m.WrittenDataBytes = delta(m.WrittenDataBytes, prev.WrittenDataBytes)
+	m.DataTransferBytes = delta(m.DataTransferBytes, prev.DataTransferBytes)
+	return m
+}
+
+// BranchMetricsSnapshot the consumption metrics of the project's branches at the point in time.
+type BranchMetricsSnapshot struct {
+	ProjectID string
+	TakenAt   time.Time
+	Branches  []BranchMetrics
+}
+
+// Total returns the sum of the branches' metrics.
+func (s BranchMetricsSnapshot) Total() BranchMetrics {
+	var o BranchMetrics
+	for _, b := range s.Branches {
+		o = o.Add(b)
+	}
+	return o
+}
+
+// Diff returns the branches' consumption since the snapshot prev taken earlier.
+// The branches created after prev are reported with their entire consumption,
+// the branches deleted after prev are omitted because their consumption is no longer reported by the API.
+func (s BranchMetricsSnapshot) Diff(prev BranchMetricsSnapshot) BranchMetricsSnapshot {
+	index := make(map[string]BranchMetrics, len(prev.Branches))
+	for _, b := range prev.Branches {
+		index[b.BranchID] = b
+	}
+
+	o := BranchMetricsSnapshot{
+		ProjectID: s.ProjectID, TakenAt: s.TakenAt, Branches: make([]BranchMetrics, len(s.Branches)),
+	}
+	for i, b := range s.Branches {
+		o.Branches[i] = b.sub(index[b.BranchID])
+	}
+	return o
+}
+
+// SumBranchMetrics sums up the branches' metrics grouped by the key, e.g. the team from the branch's annotation.
+func SumBranchMetrics(branches []BranchMetrics, key func(BranchMetrics) string) map[string]BranchMetrics {
+	o := map[string]BranchMetrics{}
+	for _, b := range branches {
+		k := key(b)
+		o[k] = o[k].Add(b)
+	}
+	for k, v := range o {
+		v.BranchID, v.Name, v.Annotation = "", "", nil
+		o[k] = v
+	}
+	return o
+}
+
+// GetBranchMetrics returns the consumption metrics of all project's branches.
+func (c Client) GetBranchMetrics(ctx context.Context, projectID string) (BranchMetricsSnapshot, error) {
+	if err := ctx.Err(); err != nil {
+		return BranchMetricsSnapshot{}, err
+	}
+
+	resp, err := c.ListProjectBranches(projectID, nil)
+	if err != nil {
+		return BranchMetricsSnapshot{}, fmt.Errorf("could not list branches of project %s: %w", projectID, err)
+	}
+
+	o := BranchMetricsSnapshot{
+		ProjectID: projectID, TakenAt: time.Now().UTC(), Branches: make([]BranchMetrics, len(resp.Branches)),
+	}
+	for i, b := range resp.Branches {
+		o.Branches[i] = BranchMetrics{
+			BranchID:           b.ID,
+			Name:               b.Name,
+			ActiveTimeSeconds:  b.ActiveTimeSeconds,
+			ComputeTimeSeconds: b.ComputeTimeSeconds,
+			CpuUsedSec:         b.CpuUsedSec,
+			WrittenDataBytes:   b.WrittenDataBytes,
+			DataTransferBytes:  b.DataTransferBytes,
+		}
+		if b.LogicalSize != nil {
+			o.Branches[i].LogicalSizeBytes = *b.LogicalSize
+		}
+		if a, ok := resp.Annotations[b.ID]; ok {
+			o.Branches[i].Annotation = a.Value
+		}
+	}
+	return o, nil
+}
@@ -0,0 +1,103 @@
+package sdk
+
+import (
+	"context"
+	"net/http"
+	"reflect"
+	"testing"
+)
+
+func TestClient_GetBranchMetrics(t *testing.T) {
+	api := newFakeAPI().on(
+		http.MethodGet, "/projects/foo/branches", http.StatusOK, `{
+"branches": [
+	{"id": "br-main", "name": "main", "active_time_seconds": 100, "compute_time_seconds": 10, "cpu_used_sec": 10,
+		"written_data_bytes": 1000, "data_transfer_bytes": 10, "logical_size": 2048},
+	{"id": "br-dev", "name": "dev", "active_time_seconds": 50, "compute_time_seconds": 5, "cpu_used_sec": 5,
+		"written_data_bytes": 500, "data_transfer_bytes": 5}
+],
+"annotations": {"br-dev": {"object": {"id": "br-dev", "type": "branch"}, "value": {"team": "data"}}}
+}`,
+	)
+	c, err := NewClient(Config{Key: "foo", HTTPClient: api})
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	got, err := c.GetBranchMetrics(context.TODO(), "foo")
+	if err != nil {
+		t.Fatal(err)
+	}
+	if got.ProjectID != "foo" || got.TakenAt.IsZero() {
+		t.Errorf("unexpected snapshot %+v", got)
+	}
+
+	want := []BranchMetrics{
+		{
+			BranchID: "br-main", Name: "main", ActiveTimeSeconds: 100, ComputeTimeSeconds: 10, CpuUsedSec: 10,
+			WrittenDataBytes: 1000, DataTransferBytes: 10, LogicalSizeBytes: 2048,
+		},
+		{
+			BranchID: "br-dev", Name: "dev", Annotation: AnnotationValueData{"team": "data"}, ActiveTimeSeconds: 50,
+			ComputeTimeSeconds: 5, CpuUsedSec: 5, WrittenDataBytes: 500, DataTransferBytes: 5,
+		},
+	}
+	if !reflect.DeepEqual(got.Branches, want) {
+		t.Errorf("GetBranchMetrics() got = %+v, want %+v", got.Branches, want)
+	}
+
+	wantTotal := BranchMetrics{
+		ActiveTimeSeconds: 150, ComputeTimeSeconds: 15, CpuUsedSec: 15, WrittenDataBytes: 1500, DataTransferBytes: 15,
+		LogicalSizeBytes: 2048,
+	}
+	if total := got.Total(); !reflect.DeepEqual(total, wantTotal) {
+		t.Errorf("Total() got = %+v, want %+v", total, wantTotal)
+	}
+}
+
+func TestBranchMetricsSnapshot_Diff(t *testing.T) {
+	prev := BranchMetricsSnapshot{
+		ProjectID: "foo",
+		Branches: []BranchMetrics{
+			{BranchID: "br-main", ActiveTimeSeconds: 100, WrittenDataBytes: 1000, LogicalSizeBytes: 10},
+			{BranchID: "br-reset", ActiveTimeSeconds: 500},
+			{BranchID: "br-deleted", ActiveTimeSeconds: 10},
+		},
+	}
+	cur := BranchMetricsSnapshot{
+		ProjectID: "foo",
+		Branches: []BranchMetrics{
+			{BranchID: "br-main", ActiveTimeSeconds: 150, WrittenDataBytes: 1200, LogicalSizeBytes: 20},
+			{BranchID: "br-reset", ActiveTimeSeconds: 30},
+			{BranchID: "br-new", ActiveTimeSeconds: 7},
+		},
+	}
+
+	want := []BranchMetrics{
+		{BranchID: "br-main", ActiveTimeSeconds: 50, WrittenDataBytes: 200, LogicalSizeBytes: 20},
+		{BranchID: "br-reset", ActiveTimeSeconds: 30},
+		{BranchID: "br-new", ActiveTimeSeconds: 7},
+	}
+	if got := cur.Diff(prev); !reflect.DeepEqual(got.Branches, want) {
+		t.Errorf("Diff() got = %+v, want %+v", got.Branches, want)
+	}
+}
+
+func TestSumBranchMetrics(t *testing.T) {
+	branches := []BranchMetrics{
+		{BranchID: "br-a", Annotation: AnnotationValueData{"team": "data"}, ActiveTimeSeconds: 1},
+		{BranchID: "br-b", Annotation: AnnotationValueData{"team": "data"}, ActiveTimeSeconds: 2},
+		{BranchID: "br-c", ActiveTimeSeconds: 4},
+	}
+
+	got := SumBranchMetrics(
+		branches, func(b BranchMetrics) string {
+			v, _ := b.Annotation.Get("team")
+			return v
+		},
+	)
+	want := map[string]BranchMetrics{"data": {ActiveTimeSeconds: 3}, "": {ActiveTimeSeconds: 4}}
+	if !reflect.DeepEqual(got, want) {
+		t.Errorf("SumBranchMetrics() got = %+v, want %+v", got, want)
+	}
+}
@@ -0,0 +1,91 @@
+package sdk
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+)
+
+// States of the branch.
+const (
+	// BranchStateInit the branch is being created and it is not available for querying.
+	BranchStateInit BranchState = "init"
+	// BranchStateReady the branch is ready for querying.
+	BranchStateReady BranchState = "ready"
+	// BranchStateArchived the branch is stored in the archival storage.
+	BranchStateArchived BranchState = "archived"
+)
+
+// ErrBranchArchived the error returned when the archived branch cannot be restored
+// because it has no compute endpoints.
+var ErrBranchArchived = errors.New("branch is archived")
+
+// WaitForBranchState polls the branch until it reaches the state and has no pending state transition.
+// The archived branch is restored when its compute endpoint starts, hence if the state BranchStateReady is awaited
+// for the archived branch without the pending transition, the branch's endpoint is started and its operations
+// are awaited. The error wrapping ErrBranchArchived is returned if the archived branch has no endpoints.
+func (c Client) WaitForBranchState(
+	ctx context.Context, projectID, branchID string, state BranchState,
+) (Branch, error) {
+	var restoreRequested bool
+	for {
+		if err := ctx.Err(); err != nil {
+			return Branch{}, err
+		}
+
+		resp, err := c.GetProjectBranch(projectID, branchID)
+		if err != nil {
+			return Branch{}, fmt.Errorf("could not read branch %s: %w", branchID, err)
+		}
+		b := resp.Branch
+
+		pending := b.PendingState != nil && *b.PendingState != b.CurrentState
+		switch {
+		case b.CurrentState == state && !pending:
+			return b, nil
+
+		case state == BranchStateReady && b.CurrentState == BranchStateArchived && !pending && !restoreRequested:
+			if err := c.restoreArchivedBranch(ctx, projectID, branchID); err != nil {
+				return b, err
+			}
+			restoreRequested = true
+			continue
+		}
+
+		select {
+		case <-ctx.Done():
+			return Branch{}, ctx.Err()
+		case <-time.After(operationsPollInterval):
+		}
+	}
+}
+
+// restoreArchivedBranch starts the branch's compute endpoint to restore the branch from the archival storage.
+func (c Client) restoreArchivedBranch(ctx context.Context, projectID, branchID string) error {
+	resp, err := c.ListProjectBranchEndpoints(projectID, branchID)
+	if err != nil {
+		return fmt.Errorf("could not list endpoints of branch %s: %w", branchID, err)
+	}
+	if len(resp.Endpoints) == 0 {
+		return fmt.Errorf("%w: branch %s has no endpoints to restore it", ErrBranchArchived, branchID)
+	}
+
+	ep := resp.Endpoints[0]
+	for _, e := range resp.Endpoints {
+		if e.Type == EndpointTypeReadWrite {
+			ep = e
+			break
+		}
+	}
+
+	started, err := callUnlessLocked(
+		ctx, func() (EndpointOperations, error) {
+			return c.StartProjectEndpoint(projectID, ep.ID)
+		},
+	)
+	if err != nil {
+		return fmt.Errorf("could not start endpoint %s to restore branch %s: %w", ep.ID, branchID, err)
+	}
+	return c.WaitForOperations(ctx, projectID, started.Operations)
+}
@@ -0,0 +1,101 @@
+package sdk
+
+import (
+	"context"
+	"errors"
+	"net/http"
+	"sync/atomic"
+	"testing"
+)
+
+func TestClient_WaitForBranchState(t *testing.T) {
+	tests := []struct {
+		name string
+		// states the branch's responses, the last one is repeated
+		states      []string
+		endpoints   string
+		state       BranchState
+		wantStarted bool
+		wantErr     error
+	}{
+		{
+			name:   "created branch ready",
+			states: []string{`"current_state":"init","pending_state":"ready"`, `"current_state":"ready"`},
+			state:  BranchStateReady,
+		},
+		{
+			name: "archived branch restored",
+			states: []string{
+				`"current_state":"archived"`,
+				`"current_state":"archived","pending_state":"ready"`,
+				`"current_state":"ready"`,
+			},
+			endpoints:   `[{"id":"ep-ro","type":"read_only"},{"id":"ep-rw","type":"read_write"}]`,
+			state:       BranchStateReady,
+			wantStarted: true,
+		},
+		{
+			name:    "archived branch without endpoints",
+			states:  []string{`"current_state":"archived"`},
+			state:   BranchStateReady,
+			wantErr: ErrBranchArchived,
+		},
+		{
+			name:   "branch archived",
+			states: []string{`"current_state":"ready","pending_state":"archived"`, `"current_state":"archived"`},
+			state:  BranchStateArchived,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(
+			tt.name, func(t *testing.T) {
+				var calls int32
+				endpoints := tt.endpoints
+				if endpoints == "" {
+					endpoints = "[]"
+				}
+				api := newFakeAPI().
+					onFunc(
+						http.MethodGet, "/projects/foo/branches/br-foo", func(_ *http.Request) (int, string) {
+							i := int(atomic.AddInt32(&calls, 1)) - 1
+							if i >= len(tt.states) {
+								i = len(tt.states) - 1
+							}
+							return http.StatusOK, `{"branch":{"id":"br-foo",` + tt.states[i] + `}}`
+						},
+					).
+					on(
+						http.MethodGet, "/projects/foo/branches/br-foo/endpoints", http.StatusOK,
+						`{"endpoints":`+endpoints+`}`,
+					).
+					on(
+						http.MethodPost, "/projects/foo/endpoints/ep-rw/start", http.StatusOK,
+						`{"endpoint":{"id":"ep-rw"},"operations":[]}`,
+					)
+
+				c, err := NewClient(Config{Key: "foo", HTTPClient: api})
+				if err != nil {
+					t.Fatal(err)
+				}
+
+				got, err := c.WaitForBranchState(context.TODO(), "foo", "br-foo", tt.state)
+				if tt.wantErr != nil {
+					if !errors.Is(err, tt.wantErr) {
+						t.Fatalf("WaitForBranchState() error = %v, want %v", err, tt.wantErr)
+					}
+					return
+				}
+				if err != nil {
+					t.Fatal(err)
+				}
+
+				if got.CurrentState != tt.state || got.PendingState != nil {
+					t.Errorf("unexpected branch state: %s", got.CurrentState)
+				}
+				if started := api.called(http.MethodPost, "/projects/foo/endpoints/ep-rw/start") > 0; started != tt.wantStarted {
+					t.Errorf("endpoint started = %v, want %v", started, tt.wantStarted)
+				}
+			},
+		)
+	}
+}
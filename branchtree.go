@@ -0,0 +1,118 @@
+package sdk
+
+import (
+	"bufio"
+	"context"
+	"encoding/json"
+	"fmt"
+	"io"
+	"sort"
+	"strconv"
+)
+
+// BranchNode the branch with its child branches.
+type BranchNode struct {
+	Branch   Branch        `json:"branch"`
+	Children []*BranchNode `json:"children,omitempty"`
+}
+
+// BranchTree the topology of the project's branches.
+type BranchTree struct {
+	ProjectID string `json:"project_id"`
+	// Roots the branches without the parent, and the branches which parent is not found, e.g. deleted.
+	Roots []*BranchNode `json:"roots"`
+}
+
+// BuildBranchTree lists the project's branches and links every branch to its parent.
+// The sibling branches are sorted by the creation time.
+func (c Client) BuildBranchTree(ctx context.Context, projectID string) (BranchTree, error) {
+	if err := ctx.Err(); err != nil {
+		return BranchTree{}, err
+	}
+
+	resp, err := c.ListProjectBranches(projectID, nil)
+	if err != nil {
+		return BranchTree{}, fmt.Errorf("could not list branches of project %s: %w", projectID, err)
+	}
+	return newBranchTree(projectID, resp.Branches), nil
+}
+
+func newBranchTree(projectID string, branches []Branch) BranchTree {
+	sorted := append([]Branch(nil), branches...)
+	sort.SliceStable(
+		sorted, func(i, j int) bool {
+			return sorted[i].CreatedAt.Before(sorted[j].CreatedAt)
+		},
+	)
+
+	nodes := make(map[string]*BranchNode, len(sorted))
+	for _, b := range sorted {
+		nodes[b.ID] = &BranchNode{Branch: b}
+	}
+
+	o := BranchTree{ProjectID: projectID, Roots: []*BranchNode{}}
+	for _, b := range sorted {
+		n := nodes[b.ID]
+		if b.ParentID != nil {
+			if parent, ok := nodes[*b.ParentID]; ok {
+				parent.Children = append(parent.Children, n)
+				continue
+			}
+		}
+		o.Roots = append(o.Roots, n)
+	}
+	return o
+}
+
+// Walk calls fn for every branch in the depth-first order with the branch's depth, the roots' depth is zero.
+func (t BranchTree) Walk(fn func(n *BranchNode, depth int)) {
+	var walk func(nodes []*BranchNode, depth int)
+	walk = func(nodes []*BranchNode, depth int) {
+		for _, n := range nodes {
+			fn(n, depth)
+			walk(n.Children, depth+1)
+		}
+	}
+	walk(t.Roots, 0)
+}
+
+// WriteJSON writes the tree as indented JSON.
+func (t BranchTree) WriteJSON(w io.Writer) error {
+	enc := json.NewEncoder(w)
+	enc.SetIndent("", "  ")
+	return enc.Encode(t)
+}
+
+// WriteDOT writes the tree in the Graphviz DOT format. The node's label contains the branch's name, state,
+// and logical size, the default branch is drawn bold, and the protected branches are drawn with the double border.
+func (t BranchTree) WriteDOT(w io.Writer) error {
+	bw := bufio.NewWriter(w)
+	_, _ = fmt.Fprintf(bw, "digraph %s {\n", strconv.Quote(t.ProjectID))
+	_, _ = bw.WriteString("  node [shape=box];\n")
+
+	t.Walk(
+		func(n *BranchNode, _ int) {
+			b := n.Branch
+			label := b.Name + "\n" + string(b.CurrentState)
+			if b.LogicalSize != nil {
+				label += "\n" + strconv.FormatInt(*b.LogicalSize, 10) + " B"
+			}
+
+			attrs := "label=" + strconv.Quote(label)
+			if b.Default {
+				attrs += ", style=bold"
+			}
+			if b.Protected {
+				attrs += ", peripheries=2"
+			}
+			_, _ = fmt.Fprintf(bw, "  %s [%s];\n", strconv.Quote(b.ID), attrs)
+
+			for _, child := range n.Children {
+				_, _ = fmt.Fprintf(bw, "  %s -> %s;\n", strconv.Quote(b.ID), strconv.Quote(child.Branch.ID))
+			}
+		},
+	)
+
+	_, _ = bw.WriteString("}\n")
+	return bw.Flush()
+}
@@ -0,0 +1,104 @@
+package sdk
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"net/http"
+	"strconv"
+	"testing"
+)
+
+func newBranchTreeFakeAPI() *fakeAPI {
+	return newFakeAPI().on(
+		http.MethodGet, "/projects/foo/branches", http.StatusOK, `{"branches":[
+{"id":"br-dev2","name":"dev2","parent_id":"br-dev","current_state":"ready","created_at":"2024-01-03T00:00:00Z"},
+{"id":"br-main","name":"main","default":true,"protected":true,"current_state":"ready","logical_size":1024,
+	"created_at":"2024-01-01T00:00:00Z"},
+{"id":"br-dev","name":"dev","parent_id":"br-main","current_state":"init","created_at":"2024-01-02T00:00:00Z"},
+{"id":"br-orphan","name":"orphan","parent_id":"br-deleted","current_state":"ready","created_at":"2024-01-04T00:00:00Z"}
+]}`,
+	)
+}
+
+func TestClient_BuildBranchTree(t *testing.T) {
+	c, err := NewClient(Config{Key: "foo", HTTPClient: newBranchTreeFakeAPI()})
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	tree, err := c.BuildBranchTree(context.TODO(), "foo")
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	var got []string
+	tree.Walk(
+		func(n *BranchNode, depth int) {
+			got = append(got, strconv.Itoa(depth)+":"+n.Branch.ID)
+		},
+	)
+	want := []string{"0:br-main", "1:br-dev", "2:br-dev2", "0:br-orphan"}
+	if len(got) != len(want) {
+		t.Fatalf("Walk() got = %v, want %v", got, want)
+	}
+	for i := range want {
+		if got[i] != want[i] {
+			t.Errorf("Walk() got = %v, want %v", got, want)
+			break
+		}
+	}
+}
+
+func TestBranchTree_WriteDOT(t *testing.T) {
+	c, err := NewClient(Config{Key: "foo", HTTPClient: newBranchTreeFakeAPI()})
+	if err != nil {
+		t.Fatal(err)
+	}
+	tree, err := c.BuildBranchTree(context.TODO(), "foo")
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	var buf bytes.Buffer
+	if err := tree.WriteDOT(&buf); err != nil {
+		t.Fatal(err)
+	}
+	want := `digraph "foo" {
+  node [shape=box];
+  "br-main" [label="main\nready\n1024 B", style=bold, peripheries=2];
+  "br-main" -> "br-dev";
+  "br-dev" [label="dev\ninit"];
+  "br-dev" -> "br-dev2";
+  "br-dev2" [label="dev2\nready"];
+  "br-orphan" [label="orphan\nready"];
+}
+`
+	if buf.String() != want {
+		t.Errorf("WriteDOT() got = %s, want %s", buf.String(), want)
+	}
+}
+
+func TestBranchTree_WriteJSON(t *testing.T) {
+	c, err := NewClient(Config{Key: "foo", HTTPClient: newBranchTreeFakeAPI()})
+	if err != nil {
+		t.Fatal(err)
+	}
+	tree, err := c.BuildBranchTree(context.TODO(), "foo")
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	var buf bytes.Buffer
+	if err := tree.WriteJSON(&buf); err != nil {
+		t.Fatal(err)
+	}
+
+	var got BranchTree
+	if err := json.Unmarshal(buf.Bytes(), &got); err != nil {
+		t.Fatal(err)
+	}
+	if len(got.Roots) != 2 || got.Roots[0].Children[0].Children[0].Branch.ID != "br-dev2" {
+		t.Errorf("unexpected tree: %s", buf.String())
+	}
+}
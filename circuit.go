@@ -0,0 +1,99 @@
+package sdk
+
+import (
+	"errors"
+	"fmt"
+	"net/http"
+	"net/url"
+	"sync"
+	"time"
+)
+
+// ErrCircuitOpen the call was not sent because the circuit breaker is open.
+var ErrCircuitOpen = errors.New("circuit breaker is open")
+
+// CircuitBreaker short-circuits the calls to the endpoint path after the threshold of consecutive failures
+// for the cool-down period. The failure is the transport error, or the response with the status code
+// 429 Too Many Requests, or 5xx. Once the cool-down period elapses, the calls are sent again,
+// and the next failure opens the circuit for another cool-down period.
+type CircuitBreaker struct {
+	threshold int
+	coolDown  time.Duration
+	now       func() time.Time
+
+	mu       sync.Mutex
+	circuits map[string]*circuit
+}
+
+type circuit struct {
+	failures  int
+	openUntil time.Time
+}
+
+// NewCircuitBreaker initializes the circuit breaker. The threshold below 1 is set to 1.
+func NewCircuitBreaker(threshold int, coolDown time.Duration) *CircuitBreaker {
+	if threshold < 1 {
+		threshold = 1
+	}
+	return &CircuitBreaker{
+		threshold: threshold,
+		coolDown:  coolDown,
+		now:       time.Now,
+		circuits:  map[string]*circuit{},
+	}
+}
+
+// allow returns the error wrapping ErrCircuitOpen if the circuit of the URL's path is open.
+func (b *CircuitBreaker) allow(u string) error {
+	if b == nil {
+		return nil
+	}
+
+	path := circuitPath(u)
+
+	b.mu.Lock()
+	defer b.mu.Unlock()
+	if v, ok := b.circuits[path]; ok && b.now().Before(v.openUntil) {
+		return fmt.Errorf("%w: %s until %s", ErrCircuitOpen, path, v.openUntil.Format(time.RFC3339))
+	}
+	return nil
+}
+
+// record registers the outcome of the call to the URL.
+func (b *CircuitBreaker) record(u string, res *http.Response, err error) {
+	if b == nil {
+		return
+	}
+
+	path := circuitPath(u)
+
+	b.mu.Lock()
+	defer b.mu.Unlock()
+	if !isCircuitFailure(res, err) {
+		delete(b.circuits, path)
+		return
+	}
+
+	v, ok := b.circuits[path]
+	if !ok {
+		v = &circuit{}
+		b.circuits[path] = v
+	}
+	v.failures++
+	if v.failures >= b.threshold {
+		v.openUntil = b.now().Add(b.coolDown)
+	}
+}
+
+func isCircuitFailure(res *http.Response, err error) bool {
+	return err != nil || res.StatusCode == http.StatusTooManyRequests || res.StatusCode >= http.StatusInternalServerError
+}
+
+// circuitPath returns the URL's path, the circuits are not distinguished by the query.
+func circuitPath(u string) string {
+	v, err := url.Parse(u)
+	if err != nil {
+		return u
+	}
+	return v.Path
+}
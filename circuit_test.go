@@ -0,0 +1,127 @@
+package sdk
+
+import (
+	"errors"
+	"io"
+	"net/http"
+	"strings"
+	"testing"
+	"time"
+)
+
+type circuitTestHTTPClient struct {
+	codes []int
+	calls int
+}
+
+func (c *circuitTestHTTPClient) Do(req *http.Request) (*http.Response, error) {
+	code := c.codes[c.calls%len(c.codes)]
+	c.calls++
+	if code == 0 {
+		return nil, errors.New("connection reset")
+	}
+	return &http.Response{
+		StatusCode: code,
+		Body:       io.NopCloser(strings.NewReader(`{}`)),
+		Request:    req,
+	}, nil
+}
+
+func TestCircuitBreaker(t *testing.T) {
+	tests := []struct {
+		name      string
+		codes     []int
+		threshold int
+		calls     int
+		wantSent  int
+		wantOpen  bool
+	}{
+		{
+			name:      "successful calls",
+			codes:     []int{http.StatusOK},
+			threshold: 2,
+			calls:     3,
+			wantSent:  3,
+		},
+		{
+			name:      "client errors do not open the circuit",
+			codes:     []int{http.StatusNotFound},
+			threshold: 2,
+			calls:     3,
+			wantSent:  3,
+		},
+		{
+			name:      "consecutive failures open the circuit",
+			codes:     []int{http.StatusInternalServerError, 0, http.StatusTooManyRequests},
+			threshold: 2,
+			calls:     3,
+			wantSent:  2,
+			wantOpen:  true,
+		},
+		{
+			name:      "success resets the failures",
+			codes:     []int{http.StatusInternalServerError, http.StatusOK},
+			threshold: 2,
+			calls:     4,
+			wantSent:  4,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(
+			tt.name, func(t *testing.T) {
+				httpClient := &circuitTestHTTPClient{codes: tt.codes}
+				breaker := NewCircuitBreaker(tt.threshold, time.Minute)
+				c, err := NewClient(Config{Key: "foo", HTTPClient: httpClient, CircuitBreaker: breaker})
+				if err != nil {
+					t.Fatal(err)
+				}
+
+				var lastErr error
+				for i := 0; i < tt.calls; i++ {
+					lastErr = c.requestHandler(c.baseURL+"/projects/foo?limit=1", http.MethodGet, nil, nil)
+				}
+				if httpClient.calls != tt.wantSent {
+					t.Errorf("unexpected number of sent calls: %d, want %d", httpClient.calls, tt.wantSent)
+				}
+				if errors.Is(lastErr, ErrCircuitOpen) != tt.wantOpen {
+					t.Errorf("unexpected error: %v", lastErr)
+				}
+
+				// other paths are not affected
+				if err := breaker.allow(c.baseURL + "/projects/bar"); err != nil {
+					t.Errorf("unexpected error: %v", err)
+				}
+			},
+		)
+	}
+}
+
+func TestCircuitBreaker_coolDown(t *testing.T) {
+	now := time.Now()
+	breaker := NewCircuitBreaker(1, time.Minute)
+	breaker.now = func() time.Time { return now }
+
+	const u = "https://console.neon.tech/api/v2/projects"
+	breaker.record(u, nil, errors.New("timeout"))
+	if err := breaker.allow(u); !errors.Is(err, ErrCircuitOpen) {
+		t.Fatalf("circuit must be open, error: %v", err)
+	}
+
+	now = now.Add(time.Minute)
+	if err := breaker.allow(u); err != nil {
+		t.Fatalf("circuit must be closed after cool-down, error: %v", err)
+	}
+
+	breaker.record(u, &http.Response{StatusCode: http.StatusBadGateway}, nil)
+	if err := breaker.allow(u); !errors.Is(err, ErrCircuitOpen) {
+		t.Fatalf("circuit must be re-opened, error: %v", err)
+	}
+}
+
+func TestCircuitBreaker_nil(t *testing.T) {
+	var breaker *CircuitBreaker
+	breaker.record("/projects", nil, errors.New("timeout"))
+	if err := breaker.allow("/projects"); err != nil {
+		t.Errorf("unexpected error: %v", err)
+	}
+}
@@ -0,0 +1,46 @@
+package sdk
+
+import (
+	"bytes"
+	"encoding/json"
+)
+
+// Codec defines the encoding of the requests' and the responses' JSON payload.
+// It allows to replace encoding/json with a faster implementation, e.g. github.com/json-iterator/go.
+type Codec interface {
+	Marshal(v interface{}) ([]byte, error)
+	Unmarshal(data []byte, v interface{}) error
+}
+
+// stdCodec the default Codec which uses encoding/json.
+type stdCodec struct{}
+
+func (stdCodec) Marshal(v interface{}) ([]byte, error) {
+	return json.Marshal(v)
+}
+
+func (stdCodec) Unmarshal(data []byte, v interface{}) error {
+	return json.Unmarshal(data, v)
+}
+
+// NumberCodec the Codec which uses encoding/json and decodes the numbers of the untyped values,
+// e.g. of PgSettingsData, into json.Number instead of float64, hence the large integers do not lose precision,
+// and the values round-trip losslessly.
+type NumberCodec struct{}
+
+func (NumberCodec) Marshal(v interface{}) ([]byte, error) {
+	return json.Marshal(v)
+}
+
+func (NumberCodec) Unmarshal(data []byte, v interface{}) error {
+	dec := json.NewDecoder(bytes.NewReader(data))
+	dec.UseNumber()
+	return dec.Decode(v)
+}
+
+func (c Client) codec() Codec {
+	if c.cfg.Codec != nil {
+		return c.cfg.Codec
+	}
+	return stdCodec{}
+}
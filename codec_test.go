@@ -0,0 +1,66 @@
+package sdk
+
+import (
+	"encoding/json"
+	"testing"
+)
+
+type countingCodec struct {
+	marshalCalls, unmarshalCalls int
+}
+
+func (c *countingCodec) Marshal(v interface{}) ([]byte, error) {
+	c.marshalCalls++
+	return json.Marshal(v)
+}
+
+func (c *countingCodec) Unmarshal(data []byte, v interface{}) error {
+	c.unmarshalCalls++
+	return json.Unmarshal(data, v)
+}
+
+func TestClient_codec(t *testing.T) {
+	c, err := NewClient(Config{Key: "foo", HTTPClient: NewMockHTTPClient()})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if _, ok := c.codec().(stdCodec); !ok {
+		t.Errorf("encoding/json codec expected by default, got %T", c.codec())
+	}
+
+	codec := &countingCodec{}
+	c, err = NewClient(Config{Key: "foo", HTTPClient: NewMockHTTPClient(), Codec: codec})
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	var v map[string]interface{}
+	if err := c.requestHandler(c.baseURL+"/projects", "POST", map[string]string{"foo": "bar"}, &v); err != nil {
+		t.Fatal(err)
+	}
+	if codec.marshalCalls != 1 || codec.unmarshalCalls != 1 {
+		t.Errorf(
+			"unexpected number of codec calls: marshal %d, unmarshal %d", codec.marshalCalls, codec.unmarshalCalls,
+		)
+	}
+}
+
+func TestNumberCodec(t *testing.T) {
+	const in = `{"id":9007199254740993,"ratio":0.5}`
+
+	var v map[string]interface{}
+	if err := (NumberCodec{}).Unmarshal([]byte(in), &v); err != nil {
+		t.Fatal(err)
+	}
+	if n, ok := v["id"].(json.Number); !ok || n.String() != "9007199254740993" {
+		t.Errorf("json.Number expected, got %T %v", v["id"], v["id"])
+	}
+
+	out, err := (NumberCodec{}).Marshal(v)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if string(out) != in {
+		t.Errorf("lossless round-trip expected, got %s, want %s", out, in)
+	}
+}
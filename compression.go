@@ -0,0 +1,34 @@
+package sdk
+
+import (
+	"bytes"
+	"compress/gzip"
+	"io"
+	"net/http"
+)
+
+// compressRequest compresses the request body with gzip if its size reaches the configured threshold.
+// It returns true if the body was compressed.
+func (c Client) compressRequest(req *http.Request, body []byte) (bool, error) {
+	if c.cfg.RequestCompressionThreshold <= 0 || len(body) < c.cfg.RequestCompressionThreshold {
+		return false, nil
+	}
+
+	var buf bytes.Buffer
+	w := gzip.NewWriter(&buf)
+	if _, err := w.Write(body); err != nil {
+		return false, err
+	}
+	if err := w.Close(); err != nil {
+		return false, err
+	}
+
+	compressed := buf.Bytes()
+	req.Body = io.NopCloser(bytes.NewReader(compressed))
+	req.ContentLength = int64(len(compressed))
+	req.GetBody = func() (io.ReadCloser, error) {
+		return io.NopCloser(bytes.NewReader(compressed)), nil
+	}
+	req.Header.Set("Content-Encoding", "gzip")
+	return true, nil
+}
@@ -0,0 +1,101 @@
+package sdk
+
+import (
+	"compress/gzip"
+	"io"
+	"net/http"
+	"strings"
+	"testing"
+)
+
+type compressionTestHTTPClient struct {
+	acceptGzip bool
+	encodings  []string
+	bodies     []string
+}
+
+func (c *compressionTestHTTPClient) Do(req *http.Request) (*http.Response, error) {
+	encoding := req.Header.Get("Content-Encoding")
+	c.encodings = append(c.encodings, encoding)
+
+	var r io.Reader = req.Body
+	if encoding == "gzip" {
+		if !c.acceptGzip {
+			return &http.Response{
+				StatusCode: http.StatusUnsupportedMediaType,
+				Body:       io.NopCloser(strings.NewReader(`{"message":"unsupported encoding"}`)),
+				Request:    req,
+			}, nil
+		}
+		gz, err := gzip.NewReader(req.Body)
+		if err != nil {
+			return nil, err
+		}
+		r = gz
+	}
+	b, err := io.ReadAll(r)
+	if err != nil {
+		return nil, err
+	}
+	c.bodies = append(c.bodies, string(b))
+
+	return &http.Response{StatusCode: http.StatusOK, Body: io.NopCloser(strings.NewReader(`{}`)), Request: req}, nil
+}
+
+func TestClient_requestHandler_compression(t *testing.T) {
+	payload := map[string]string{"name": strings.Repeat("foo", 100)}
+
+	tests := []struct {
+		name          string
+		threshold     int
+		acceptGzip    bool
+		wantEncodings []string
+	}{
+		{
+			name:          "compression disabled",
+			threshold:     0,
+			wantEncodings: []string{""},
+		},
+		{
+			name:          "body below threshold",
+			threshold:     1 << 20,
+			wantEncodings: []string{""},
+		},
+		{
+			name:          "body compressed",
+			threshold:     100,
+			acceptGzip:    true,
+			wantEncodings: []string{"gzip"},
+		},
+		{
+			name:          "encoding rejected: uncompressed body resent",
+			threshold:     100,
+			acceptGzip:    false,
+			wantEncodings: []string{"gzip", ""},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(
+			tt.name, func(t *testing.T) {
+				httpClient := &compressionTestHTTPClient{acceptGzip: tt.acceptGzip}
+				c, err := NewClient(
+					Config{Key: "foo", HTTPClient: httpClient, RequestCompressionThreshold: tt.threshold},
+				)
+				if err != nil {
+					t.Fatal(err)
+				}
+
+				if err := c.requestHandler(c.baseURL+"/projects", http.MethodPost, payload, nil); err != nil {
+					t.Fatal(err)
+				}
+
+				if strings.Join(httpClient.encodings, ",") != strings.Join(tt.wantEncodings, ",") {
+					t.Errorf("unexpected encodings: %v, want %v", httpClient.encodings, tt.wantEncodings)
+				}
+				if len(httpClient.bodies) != 1 || !strings.Contains(httpClient.bodies[0], payload["name"]) {
+					t.Errorf("unexpected request body: %v", httpClient.bodies)
+				}
+			},
+		)
+	}
+}
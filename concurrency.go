@@ -0,0 +1,103 @@
+package sdk
+
+import (
+	"context"
+	"sync"
+	"time"
+)
+
+// defaultParallelism the number of concurrent calls used by the helpers when no limit is set.
+const defaultParallelism = 4
+
+// forEach calls fn for every index in [0, n) running at most parallelism calls concurrently.
+// The first error cancels the context passed to the remaining calls and is returned.
+func forEach(ctx context.Context, n, parallelism int, fn func(ctx context.Context, i int) error) error {
+	if parallelism <= 0 {
+		parallelism = defaultParallelism
+	}
+
+	ctx, cancel := context.WithCancel(ctx)
+	defer cancel()
+
+	var (
+		wg       sync.WaitGroup
+		once     sync.Once
+		firstErr error
+		sem      = make(chan struct{}, parallelism)
+	)
+
+	setErr := func(err error) {
+		once.Do(
+			func() {
+				firstErr = err
+				cancel()
+			},
+		)
+	}
+
+loop:
+	for i := 0; i < n; i++ {
+		select {
+		case <-ctx.Done():
+			break loop
+		case sem <- struct{}{}:
+		}
+
+		wg.Add(1)
+		go func(i int) {
+			defer func() {
+				<-sem
+				wg.Done()
+			}()
+			if err := ctx.Err(); err != nil {
+				return
+			}
+			if err := fn(ctx, i); err != nil {
+				setErr(err)
+			}
+		}(i)
+	}
+	wg.Wait()
+
+	if firstErr != nil {
+		return firstErr
+	}
+	return ctx.Err()
+}
+
+// rateLimiter limits the rate of calls shared by concurrent goroutines.
+type rateLimiter struct {
+	t *time.Ticker
+}
+
+// newRateLimiter initialises the limiter allowing rps calls per second, it does not limit if rps is not positive,
+// or if it exceeds one call per nanosecond.
+func newRateLimiter(rps float64) *rateLimiter {
+	if rps <= 0 {
+		return &rateLimiter{}
+	}
+	interval := time.Duration(float64(time.Second) / rps)
+	if interval <= 0 {
+		return &rateLimiter{}
+	}
+	return &rateLimiter{t: time.NewTicker(interval)}
+}
+
+// wait blocks until the next call is allowed, or the context is cancelled.
+func (l *rateLimiter) wait(ctx context.Context) error {
+	if l.t == nil {
+		return ctx.Err()
+	}
+	select {
+	case <-ctx.Done():
+		return ctx.Err()
+	case <-l.t.C:
+		return nil
+	}
+}
+
+func (l *rateLimiter) stop() {
+	if l.t != nil {
+		l.t.Stop()
+	}
+}
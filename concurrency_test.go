@@ -0,0 +1,130 @@
+package sdk
+
+import (
+	"context"
+	"errors"
+	"sync"
+	"testing"
+	"time"
+)
+
+func Test_forEach(t *testing.T) {
+	errFoo := errors.New("foo")
+
+	tests := []struct {
+		name        string
+		n           int
+		parallelism int
+		failAt      int
+		wantErr     error
+	}{
+		{
+			name:        "all succeeded",
+			n:           20,
+			parallelism: 3,
+			failAt:      -1,
+		},
+		{
+			name:        "default parallelism",
+			n:           5,
+			parallelism: 0,
+			failAt:      -1,
+		},
+		{
+			name:        "first error returned",
+			n:           20,
+			parallelism: 3,
+			failAt:      5,
+			wantErr:     errFoo,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(
+			tt.name, func(t *testing.T) {
+				var (
+					mu                 sync.Mutex
+					inFlight, maxSeen  int
+					wantMaxParallelism = tt.parallelism
+				)
+				if wantMaxParallelism <= 0 {
+					wantMaxParallelism = defaultParallelism
+				}
+
+				err := forEach(
+					context.TODO(), tt.n, tt.parallelism, func(_ context.Context, i int) error {
+						mu.Lock()
+						inFlight++
+						if inFlight > maxSeen {
+							maxSeen = inFlight
+						}
+						mu.Unlock()
+
+						defer func() {
+							mu.Lock()
+							inFlight--
+							mu.Unlock()
+						}()
+
+						if i == tt.failAt {
+							return errFoo
+						}
+						return nil
+					},
+				)
+				if !errors.Is(err, tt.wantErr) {
+					t.Errorf("forEach() error = %v, wantErr %v", err, tt.wantErr)
+				}
+				if maxSeen > wantMaxParallelism {
+					t.Errorf("parallelism exceeded: want <= %d, got = %d", wantMaxParallelism, maxSeen)
+				}
+			},
+		)
+	}
+}
+
+func Test_forEach_cancelled(t *testing.T) {
+	ctx, cancel := context.WithCancel(context.TODO())
+	cancel()
+
+	var called bool
+	err := forEach(
+		ctx, 10, 2, func(_ context.Context, _ int) error {
+			called = true
+			return nil
+		},
+	)
+	if !errors.Is(err, context.Canceled) {
+		t.Errorf("forEach() error = %v, want context.Canceled", err)
+	}
+	if called {
+		t.Errorf("no call expected for the cancelled context")
+	}
+}
+
+func Test_rateLimiter(t *testing.T) {
+	l := newRateLimiter(100)
+	defer l.stop()
+
+	start := time.Now()
+	for i := 0; i < 3; i++ {
+		if err := l.wait(context.TODO()); err != nil {
+			t.Fatal(err)
+		}
+	}
+	if d := time.Since(start); d < 25*time.Millisecond {
+		t.Errorf("calls are not rate limited: 3 calls took %v", d)
+	}
+
+	ctx, cancel := context.WithCancel(context.TODO())
+	cancel()
+	if err := l.wait(ctx); !errors.Is(err, context.Canceled) {
+		t.Errorf("wait() error = %v, want context.Canceled", err)
+	}
+
+	if err := newRateLimiter(0).wait(context.TODO()); err != nil {
+		t.Errorf("unlimited wait() error = %v", err)
+	}
+	if err := newRateLimiter(1e10).wait(context.TODO()); err != nil {
+		t.Errorf("wait() error = %v for the rate above one call per nanosecond", err)
+	}
+}
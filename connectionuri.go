@@ -0,0 +1,60 @@
+package sdk
+
+import (
+	"context"
+	"fmt"
+	"time"
+)
+
+// connectionURIAttempts the number of attempts to read the connection URI of the created project.
+const connectionURIAttempts = 5
+
+// CreatedProjectConnectionURI returns the connection URI of the project's default database.
+// The URI is read from the response of CreateProject, or from GetConnectionURI if the response does not
+// contain it because the project is still being provisioned. The read is retried with the exponential backoff
+// until the attempts are exhausted, or the context is cancelled.
+func (c Client) CreatedProjectConnectionURI(ctx context.Context, created CreatedProject) (string, error) {
+	if uri, ok := created.FirstConnectionURI(); ok {
+		return uri, nil
+	}
+	if err := ctx.Err(); err != nil {
+		return "", err
+	}
+
+	projectID, branchID := created.Project.ID, created.Branch.ID
+	interval := operationsPollInterval
+
+	var lastErr error
+	for attempt := 0; attempt < connectionURIAttempts; attempt++ {
+		if attempt > 0 {
+			select {
+			case <-ctx.Done():
+				return "", ctx.Err()
+			case <-time.After(interval):
+			}
+			interval *= 2
+		}
+
+		db, ok := created.FirstDatabase()
+		if !ok {
+			resp, err := c.ListProjectBranchDatabases(projectID, branchID)
+			if err != nil {
+				lastErr = err
+				continue
+			}
+			if len(resp.Databases) == 0 {
+				lastErr = fmt.Errorf("%w: database of branch %s", ErrNotFound, branchID)
+				continue
+			}
+			db = resp.Databases[0]
+		}
+
+		resp, err := c.GetConnectionURI(projectID, &branchID, nil, db.Name, db.OwnerName, nil)
+		if err != nil {
+			lastErr = err
+			continue
+		}
+		return resp.URI, nil
+	}
+	return "", fmt.Errorf("could not read connection URI of project %s: %w", projectID, lastErr)
+}
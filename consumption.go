@@ -0,0 +1,284 @@
+package sdk
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+)
+
+// ErrInvalidConsumptionWindow the error returned when the consumption history time window
+// is not accepted by the API.
+var ErrInvalidConsumptionWindow = errors.New("invalid consumption history window")
+
+// listConsumptionPageLimit the maximum number of projects the consumption history API returns per page.
+const listConsumptionPageLimit = 100
+
+// consumptionHistoryStart the earliest date-time the consumption history is available from.
+var consumptionHistoryStart = time.Date(2024, 3, 1, 0, 0, 0, 0, time.UTC)
+
+// ConsumptionWindow defines the time window [From, To) of the consumption history query.
+type ConsumptionWindow struct {
+	From        time.Time
+	To          time.Time
+	Granularity ConsumptionHistoryGranularity
+}
+
+// earliestFrom returns the earliest start of the window accepted by the API given the granularity's lookback
+// from now: the last 168 hours for hourly, 60 days for daily, and 1 year for monthly granularity.
+func (w ConsumptionWindow) earliestFrom(now time.Time) (time.Time, error) {
+	switch w.Granularity {
+	case ConsumptionHistoryGranularityHourly:
+		return now.Add(-168 * time.Hour), nil
+	case ConsumptionHistoryGranularityDaily:
+		return now.AddDate(0, 0, -60), nil
+	case ConsumptionHistoryGranularityMonthly:
+		return now.AddDate(-1, 0, 0), nil
+	default:
+		return time.Time{}, fmt.Errorf("%w: unknown granularity %q", ErrInvalidConsumptionWindow, w.Granularity)
+	}
+}
+
+// chunkTo returns the end of the chunk which starts at from:
+// 7 days for hourly, 1 month for daily, and 1 year for monthly granularity.
+func (w ConsumptionWindow) chunkTo(from time.Time) time.Time {
+	switch w.Granularity {
+	case ConsumptionHistoryGranularityHourly:
+		return from.AddDate(0, 0, 7)
+	case ConsumptionHistoryGranularityDaily:
+		return from.AddDate(0, 1, 0)
+	default:
+		return from.AddDate(1, 0, 0)
+	}
+}
+
+// Validate checks if the API accepts the window. The returned error wraps ErrInvalidConsumptionWindow.
+func (w ConsumptionWindow) Validate() error {
+	earliest, err := w.earliestFrom(time.Now())
+	if err != nil {
+		return err
+	}
+	switch {
+	case !w.From.Before(w.To):
+		return fmt.Errorf(
+			"%w: start %s must precede end %s", ErrInvalidConsumptionWindow,
+			w.From.Format(time.RFC3339), w.To.Format(time.RFC3339),
+		)
+	case w.From.Before(consumptionHistoryStart):
+		return fmt.Errorf(
+			"%w: start %s precedes the start of consumption history %s", ErrInvalidConsumptionWindow,
+			w.From.Format(time.RFC3339), consumptionHistoryStart.Format(time.RFC3339),
+		)
+	case w.From.Before(earliest):
+		return fmt.Errorf(
+			"%w: %s granularity window must start after %s, use coarser granularity",
+			ErrInvalidConsumptionWindow, w.Granularity, earliest.Format(time.RFC3339),
+		)
+	}
+	return nil
+}
+
+// Chunks validates the window and splits it into consecutive windows to limit the size of every response.
+func (w ConsumptionWindow) Chunks() ([]ConsumptionWindow, error) {
+	if err := w.Validate(); err != nil {
+		return nil, err
+	}
+	var o []ConsumptionWindow
+	for from := w.From; from.Before(w.To); {
+		to := w.chunkTo(from)
+		if to.After(w.To) {
+			to = w.To
+		}
+		o = append(o, ConsumptionWindow{From: from, To: to, Granularity: w.Granularity})
+		from = to
+	}
+	return o, nil
+}
+
+// windows returns the window itself after validation, or its chunks if autoChunk is set.
+func (w ConsumptionWindow) windows(autoChunk bool) ([]ConsumptionWindow, error) {
+	if autoChunk {
+		return w.Chunks()
+	}
+	if err := w.Validate(); err != nil {
+		return nil, err
+	}
+	return []ConsumptionWindow{w}, nil
+}
+
+// GetAccountConsumption validates the window and reads the account's consumption history.
+// If autoChunk is set, the window longer than the granularity's chunk is split into multiple requests,
+// and the consumption of the same billing periods is merged.
+func (c Client) GetAccountConsumption(
+	ctx context.Context, w ConsumptionWindow, orgID *string, autoChunk bool,
+) (ConsumptionHistoryPerAccountResponse, error) {
+	windows, err := w.windows(autoChunk)
+	if err != nil {
+		return ConsumptionHistoryPerAccountResponse{}, err
+	}
+
+	var o ConsumptionHistoryPerAccountResponse
+	for _, chunk := range windows {
+		if err := ctx.Err(); err != nil {
+			return ConsumptionHistoryPerAccountResponse{}, err
+		}
+
+		resp, err := c.GetConsumptionHistoryPerAccount(chunk.From, chunk.To, chunk.Granularity, orgID, nil)
+		if err != nil {
+			return ConsumptionHistoryPerAccountResponse{}, fmt.Errorf("could not read consumption history: %w", err)
+		}
+		o.Periods = mergeConsumptionPeriods(o.Periods, resp.Periods)
+	}
+	return o, nil
+}
+
+// GetProjectsConsumption validates the window and reads the projects' consumption history paging through
+// all projects. The projectIDs filter the projects, all projects are returned if it is empty.
+// If autoChunk is set, the window longer than the granularity's chunk is split into multiple requests,
+// and the consumption of the same projects and billing periods is merged.
+func (c Client) GetProjectsConsumption(
+	ctx context.Context, w ConsumptionWindow, projectIDs []string, orgID *string, autoChunk bool,
+) ([]ConsumptionHistoryPerProject, error) {
+	var (
+		o     []ConsumptionHistoryPerProject
+		index = map[string]int{}
+	)
+	if err := c.walkProjectsConsumption(
+		ctx, w, projectIDs, orgID, autoChunk, func(p ConsumptionHistoryPerProject) error {
+			i, ok := index[p.ProjectID]
+			if !ok {
+				index[p.ProjectID] = len(o)
+				o = append(o, p)
+				return nil
+			}
+			o[i].Periods = mergeConsumptionPeriods(o[i].Periods, p.Periods)
+			return nil
+		},
+	); err != nil {
+		return nil, err
+	}
+	return o, nil
+}
+
+// ProjectConsumptionTotals the project's consumption summed over the time window, e.g. for billing ingestion.
+type ProjectConsumptionTotals struct {
+	ProjectID            string
+	ActiveTimeSeconds    int
+	ComputeTimeSeconds   int
+	WrittenDataBytes     int
+	DataStorageBytesHour int
+	// SyntheticStorageSizeBytes the storage size at the end of the latest timeframe, it is not summed
+	// because it is the size at the point in time.
+	SyntheticStorageSizeBytes int
+	// TimeframeEnd the end of the latest timeframe.
+	TimeframeEnd time.Time
+}
+
+func (t *ProjectConsumptionTotals) add(v ConsumptionHistoryPerTimeframe) {
+	t.ActiveTimeSeconds += v.ActiveTimeSeconds
+	t.ComputeTimeSeconds += v.ComputeTimeSeconds
+	t.WrittenDataBytes += v.WrittenDataBytes
+	if v.DataStorageBytesHour != nil {
+		t.DataStorageBytesHour += *v.DataStorageBytesHour
+	}
+	if !v.TimeframeEnd.Before(t.TimeframeEnd) {
+		t.TimeframeEnd = v.TimeframeEnd
+		t.SyntheticStorageSizeBytes = v.SyntheticStorageSizeBytes
+	}
+}
+
+// GetProjectsConsumptionTotals pages through the projects' consumption history like GetProjectsConsumption,
+// and sums up the consumption of every project over the window. The projects are returned in the order
+// reported by the API.
+func (c Client) GetProjectsConsumptionTotals(
+	ctx context.Context, w ConsumptionWindow, projectIDs []string, orgID *string, autoChunk bool,
+) ([]ProjectConsumptionTotals, error) {
+	var (
+		o     []ProjectConsumptionTotals
+		index = map[string]int{}
+	)
+	if err := c.walkProjectsConsumption(
+		ctx, w, projectIDs, orgID, autoChunk, func(p ConsumptionHistoryPerProject) error {
+			i, ok := index[p.ProjectID]
+			if !ok {
+				i = len(o)
+				index[p.ProjectID] = i
+				o = append(o, ProjectConsumptionTotals{ProjectID: p.ProjectID})
+			}
+			for _, period := range p.Periods {
+				for _, v := range period.Consumption {
+					o[i].add(v)
+				}
+			}
+			return nil
+		},
+	); err != nil {
+		return nil, err
+	}
+	return o, nil
+}
+
+// walkProjectsConsumption pages through the projects' consumption history of every window's chunk
+// and calls fn for every project.
+func (c Client) walkProjectsConsumption(
+	ctx context.Context, w ConsumptionWindow, projectIDs []string, orgID *string, autoChunk bool,
+	fn func(ConsumptionHistoryPerProject) error,
+) error {
+	windows, err := w.windows(autoChunk)
+	if err != nil {
+		return err
+	}
+
+	for _, chunk := range windows {
+		var (
+			cursor *string
+			limit  = listConsumptionPageLimit
+		)
+		for {
+			if err := ctx.Err(); err != nil {
+				return err
+			}
+
+			resp, err := c.GetConsumptionHistoryPerProject(
+				cursor, &limit, projectIDs, chunk.From, chunk.To, chunk.Granularity, orgID, nil,
+			)
+			if err != nil {
+				return fmt.Errorf("could not read consumption history: %w", err)
+			}
+
+			for _, p := range resp.Projects {
+				if err := fn(p); err != nil {
+					return err
+				}
+			}
+
+			if !hasNextPage(len(resp.Projects), limit, cursor, resp.PaginationResponse) {
+				break
+			}
+			next := resp.Pagination.Cursor
+			cursor = &next
+		}
+	}
+	return nil
+}
+
+// mergeConsumptionPeriods appends the periods to dst merging the consumption of the periods with the same ID.
+func mergeConsumptionPeriods(dst, periods []ConsumptionHistoryPerPeriod) []ConsumptionHistoryPerPeriod {
+	for _, p := range periods {
+		merged := false
+		for i := range dst {
+			if dst[i].PeriodID == p.PeriodID {
+				dst[i].Consumption = append(dst[i].Consumption, p.Consumption...)
+				if p.PeriodEnd != nil {
+					dst[i].PeriodEnd = p.PeriodEnd
+				}
+				merged = true
+				break
+			}
+		}
+		if !merged {
+			dst = append(dst, p)
+		}
+	}
+	return dst
+}
@@ -0,0 +1,217 @@
+package sdk
+
+import (
+	"context"
+	"errors"
+	"net/http"
+	"reflect"
+	"testing"
+	"time"
+)
+
+func TestConsumptionWindow_Validate(t *testing.T) {
+	now := time.Now().UTC()
+
+	tests := []struct {
+		name    string
+		w       ConsumptionWindow
+		wantErr bool
+	}{
+		{
+			name: "hourly within the last 168 hours",
+			w: ConsumptionWindow{
+				From: now.Add(-167 * time.Hour), To: now, Granularity: ConsumptionHistoryGranularityHourly,
+			},
+		},
+		{
+			name: "hourly starts before the last 168 hours",
+			w: ConsumptionWindow{
+				From: now.Add(-169 * time.Hour), To: now.Add(-168 * time.Hour),
+				Granularity: ConsumptionHistoryGranularityHourly,
+			},
+			wantErr: true,
+		},
+		{
+			name: "daily starts before the last 60 days",
+			w: ConsumptionWindow{
+				From: now.AddDate(0, 0, -61), To: now.AddDate(0, 0, -50), Granularity: ConsumptionHistoryGranularityDaily,
+			},
+			wantErr: true,
+		},
+		{
+			name: "monthly within the last year",
+			w: ConsumptionWindow{
+				From: now.AddDate(0, -11, 0), To: now, Granularity: ConsumptionHistoryGranularityMonthly,
+			},
+		},
+		{
+			name: "end precedes start",
+			w: ConsumptionWindow{
+				From: now.Add(-time.Hour), To: now.Add(-2 * time.Hour), Granularity: ConsumptionHistoryGranularityDaily,
+			},
+			wantErr: true,
+		},
+		{
+			name: "start precedes the consumption history",
+			w: ConsumptionWindow{
+				From:        time.Date(2024, 2, 1, 0, 0, 0, 0, time.UTC),
+				To:          time.Date(2024, 3, 2, 0, 0, 0, 0, time.UTC),
+				Granularity: ConsumptionHistoryGranularityMonthly,
+			},
+			wantErr: true,
+		},
+		{
+			name:    "unknown granularity",
+			w:       ConsumptionWindow{From: now.Add(-time.Hour), To: now, Granularity: "weekly"},
+			wantErr: true,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(
+			tt.name, func(t *testing.T) {
+				err := tt.w.Validate()
+				if (err != nil) != tt.wantErr {
+					t.Fatalf("Validate() error = %v, wantErr %v", err, tt.wantErr)
+				}
+				if tt.wantErr && !errors.Is(err, ErrInvalidConsumptionWindow) {
+					t.Errorf("Validate() error = %v, want ErrInvalidConsumptionWindow", err)
+				}
+			},
+		)
+	}
+}
+
+func TestConsumptionWindow_Chunks(t *testing.T) {
+	t.Run(
+		"window is split", func(t *testing.T) {
+			to := time.Now().UTC()
+			w := ConsumptionWindow{From: to.AddDate(0, 0, -59), To: to, Granularity: ConsumptionHistoryGranularityDaily}
+
+			got, err := w.Chunks()
+			if err != nil {
+				t.Fatal(err)
+			}
+			if len(got) != 2 {
+				t.Fatalf("unexpected number of chunks: %d", len(got))
+			}
+			if !got[0].From.Equal(w.From) || !got[1].From.Equal(got[0].To) || !got[1].To.Equal(w.To) {
+				t.Errorf("chunks are not consecutive: %v", got)
+			}
+		},
+	)
+
+	t.Run(
+		"window outside the lookback is not split", func(t *testing.T) {
+			to := time.Now().UTC()
+			w := ConsumptionWindow{From: to.AddDate(0, 0, -90), To: to, Granularity: ConsumptionHistoryGranularityDaily}
+
+			if _, err := w.Chunks(); !errors.Is(err, ErrInvalidConsumptionWindow) {
+				t.Errorf("Chunks() error = %v, want ErrInvalidConsumptionWindow", err)
+			}
+		},
+	)
+}
+
+func TestClient_GetAccountConsumption(t *testing.T) {
+	to := time.Now().UTC()
+	w := ConsumptionWindow{From: to.AddDate(0, 0, -59), To: to, Granularity: ConsumptionHistoryGranularityDaily}
+
+	tests := []struct {
+		name      string
+		w         ConsumptionWindow
+		autoChunk bool
+		wantCalls int
+		wantErr   bool
+	}{
+		{
+			name: "window precedes the lookback",
+			w: ConsumptionWindow{
+				From: to.AddDate(0, 0, -90), To: to, Granularity: ConsumptionHistoryGranularityDaily,
+			},
+			autoChunk: true,
+			wantErr:   true,
+		},
+		{name: "window is not chunked", w: w, wantCalls: 1},
+		{name: "window is chunked", w: w, autoChunk: true, wantCalls: 2},
+	}
+	for _, tt := range tests {
+		t.Run(
+			tt.name, func(t *testing.T) {
+				api := newFakeAPI().on(
+					http.MethodGet, "/consumption_history/account", http.StatusOK,
+					`{"periods":[{"period_id":"p1","period_plan":"scale","period_start":"2024-06-01T00:00:00Z",
+"consumption":[{"compute_time_seconds":1}]}]}`,
+				)
+				c, err := NewClient(Config{Key: "foo", HTTPClient: api})
+				if err != nil {
+					t.Fatal(err)
+				}
+
+				got, err := c.GetAccountConsumption(context.TODO(), tt.w, nil, tt.autoChunk)
+				if (err != nil) != tt.wantErr {
+					t.Fatalf("GetAccountConsumption() error = %v, wantErr %v", err, tt.wantErr)
+				}
+				if n := api.called(http.MethodGet, "/consumption_history/account"); n != tt.wantCalls {
+					t.Errorf("unexpected number of calls: %d, want %d", n, tt.wantCalls)
+				}
+				if tt.wantErr {
+					return
+				}
+				if len(got.Periods) != 1 || len(got.Periods[0].Consumption) != tt.wantCalls {
+					t.Errorf("periods are not merged: %+v", got.Periods)
+				}
+			},
+		)
+	}
+}
+
+func TestClient_GetProjectsConsumptionTotals(t *testing.T) {
+	to := time.Now().UTC().Truncate(time.Second)
+	from := to.AddDate(0, 0, -59)
+	w := ConsumptionWindow{From: from, To: to, Granularity: ConsumptionHistoryGranularityDaily}
+
+	api := newFakeAPI().onFunc(
+		http.MethodGet, "/consumption_history/projects", func(req *http.Request) (int, string) {
+			if req.URL.Query().Get("from") == from.Format(time.RFC3339) {
+				return http.StatusOK, `{"projects":[{"project_id":"foo","periods":[{"period_id":"p1","consumption":[
+{"timeframe_start":"2024-06-01T00:00:00Z","timeframe_end":"2024-06-02T00:00:00Z",
+"compute_time_seconds":10,"active_time_seconds":20,"written_data_bytes":1,"synthetic_storage_size_bytes":100,
+"data_storage_bytes_hour":5}]}]}]}`
+			}
+			return http.StatusOK, `{"projects":[{"project_id":"foo","periods":[{"period_id":"p1","consumption":[
+{"timeframe_start":"2024-08-01T00:00:00Z","timeframe_end":"2024-08-02T00:00:00Z",
+"compute_time_seconds":1,"active_time_seconds":2,"written_data_bytes":3,"synthetic_storage_size_bytes":200}]}]},
+{"project_id":"bar","periods":[{"period_id":"p1","consumption":[
+{"timeframe_start":"2024-08-01T00:00:00Z","timeframe_end":"2024-08-02T00:00:00Z","compute_time_seconds":7}]}]}]}`
+		},
+	)
+	c, err := NewClient(Config{Key: "foo", HTTPClient: api})
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	got, err := c.GetProjectsConsumptionTotals(context.TODO(), w, nil, nil, true)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	want := []ProjectConsumptionTotals{
+		{
+			ProjectID:                 "foo",
+			ActiveTimeSeconds:         22,
+			ComputeTimeSeconds:        11,
+			WrittenDataBytes:          4,
+			DataStorageBytesHour:      5,
+			SyntheticStorageSizeBytes: 200,
+			TimeframeEnd:              time.Date(2024, 8, 2, 0, 0, 0, 0, time.UTC),
+		},
+		{
+			ProjectID:          "bar",
+			ComputeTimeSeconds: 7,
+			TimeframeEnd:       time.Date(2024, 8, 2, 0, 0, 0, 0, time.UTC),
+		},
+	}
+	if !reflect.DeepEqual(got, want) {
+		t.Errorf("GetProjectsConsumptionTotals() = %+v, want %+v", got, want)
+	}
+}
@@ -0,0 +1,102 @@
+package sdk
+
+import (
+	"context"
+	"fmt"
+	"time"
+)
+
+const (
+	bytesInGiB   = 1 << 30
+	hoursInMonth = 730
+)
+
+// CostRates defines the prices of the billing plan's metered resources.
+type CostRates struct {
+	// ComputeHour the price of one compute hour, i.e. the CPU hour used by compute endpoints.
+	ComputeHour float64
+	// StorageGiBMonth the price of one GiB of storage stored for a month of 730 hours.
+	StorageGiBMonth float64
+	// WrittenDataGiB the price of one GiB of data written to storage.
+	WrittenDataGiB float64
+}
+
+// PlanRates defines the cost rates per billing plan, e.g. "scale", or "business".
+type PlanRates map[string]CostRates
+
+// ProjectCost the estimated cost breakdown of the project.
+// Note that the consumption history does not report the data transfer, hence its cost is not estimated.
+type ProjectCost struct {
+	ProjectID   string  `json:"project_id"`
+	Compute     float64 `json:"compute"`
+	Storage     float64 `json:"storage"`
+	WrittenData float64 `json:"written_data"`
+	Total       float64 `json:"total"`
+}
+
+// EstimateCost estimates the cost of the projects' consumption in the time window [from, to)
+// using the rates of the billing plan which was active in every billing period.
+// The daily consumption history is used, so the window must start within the last 60 days,
+// the window longer than a month is split into multiple requests. The orgID defines the organization, it is optional.
+func (c Client) EstimateCost(
+	ctx context.Context, from, to time.Time, rates PlanRates, orgID *string,
+) ([]ProjectCost, error) {
+	var (
+		o     []ProjectCost
+		index = map[string]int{}
+	)
+	if err := c.walkProjectsConsumption(
+		ctx, ConsumptionWindow{From: from, To: to, Granularity: ConsumptionHistoryGranularityDaily}, nil, orgID, true,
+		func(p ConsumptionHistoryPerProject) error {
+			cost, err := estimateProjectCost(p, rates)
+			if err != nil {
+				return err
+			}
+
+			i, ok := index[p.ProjectID]
+			if !ok {
+				index[p.ProjectID] = len(o)
+				o = append(o, cost)
+				return nil
+			}
+			o[i].Compute += cost.Compute
+			o[i].Storage += cost.Storage
+			o[i].WrittenData += cost.WrittenData
+			o[i].Total += cost.Total
+			return nil
+		},
+	); err != nil {
+		return nil, err
+	}
+	return o, nil
+}
+
+func estimateProjectCost(p ConsumptionHistoryPerProject, rates PlanRates) (ProjectCost, error) {
+	o := ProjectCost{ProjectID: p.ProjectID}
+	for _, period := range p.Periods {
+		r, ok := rates[period.PeriodPlan]
+		if !ok {
+			return ProjectCost{}, fmt.Errorf(
+				"no rates defined for plan %s of project %s", period.PeriodPlan, p.ProjectID,
+			)
+		}
+
+		for _, v := range period.Consumption {
+			o.Compute += float64(v.ComputeTimeSeconds) / 3600 * r.ComputeHour
+			o.Storage += storageGiBMonth(v) * r.StorageGiBMonth
+			o.WrittenData += float64(v.WrittenDataBytes) / bytesInGiB * r.WrittenDataGiB
+		}
+	}
+	o.Total = o.Compute + o.Storage + o.WrittenData
+	return o, nil
+}
+
+// storageGiBMonth returns the storage consumed in the timeframe in GiB-month.
+// The synthetic storage size is used if the hourly storage consumption is not reported.
+func storageGiBMonth(v ConsumptionHistoryPerTimeframe) float64 {
+	if v.DataStorageBytesHour != nil {
+		return float64(*v.DataStorageBytesHour) / bytesInGiB / hoursInMonth
+	}
+	hours := v.TimeframeEnd.Sub(v.TimeframeStart).Hours()
+	return float64(v.SyntheticStorageSizeBytes) / bytesInGiB * hours / hoursInMonth
+}
@@ -0,0 +1,79 @@
+package sdk
+
+import (
+	"context"
+	"math"
+	"net/http"
+	"testing"
+	"time"
+)
+
+func TestClient_EstimateCost(t *testing.T) {
+	const body = `{"projects":[{"project_id":"foo","periods":[{"period_id":"p1","period_plan":"scale",
+"period_start":"2024-03-01T00:00:00Z","consumption":[{
+"timeframe_start":"2024-03-01T00:00:00Z","timeframe_end":"2024-03-02T00:00:00Z",
+"compute_time_seconds":7200,"data_storage_bytes_hour":783831531520,"written_data_bytes":2147483648
+}]}]}],"pagination":{"cursor":"foo"}}`
+
+	tests := []struct {
+		name    string
+		rates   PlanRates
+		want    ProjectCost
+		wantErr bool
+	}{
+		{
+			name:  "happy path",
+			rates: PlanRates{"scale": {ComputeHour: 0.5, StorageGiBMonth: 2, WrittenDataGiB: 0.1}},
+			want:  ProjectCost{ProjectID: "foo", Compute: 1, Storage: 2, WrittenData: 0.2, Total: 3.2},
+		},
+		{
+			name:    "unhappy path: no rates for plan",
+			rates:   PlanRates{"business": {ComputeHour: 0.5}},
+			wantErr: true,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(
+			tt.name, func(t *testing.T) {
+				api := newFakeAPI().on(http.MethodGet, "/consumption_history/projects", http.StatusOK, body)
+				c, err := NewClient(Config{Key: "foo", HTTPClient: api})
+				if err != nil {
+					t.Fatal(err)
+				}
+
+				to := time.Now().UTC()
+				got, err := c.EstimateCost(context.TODO(), to.AddDate(0, 0, -1), to, tt.rates, nil)
+				if (err != nil) != tt.wantErr {
+					t.Fatalf("EstimateCost() error = %v, wantErr %v", err, tt.wantErr)
+				}
+				if tt.wantErr {
+					return
+				}
+				if len(got) != 1 {
+					t.Fatalf("unexpected number of projects: %d", len(got))
+				}
+				g := got[0]
+				if g.ProjectID != tt.want.ProjectID || !almostEqual(g.Compute, tt.want.Compute) ||
+					!almostEqual(g.Storage, tt.want.Storage) || !almostEqual(g.WrittenData, tt.want.WrittenData) ||
+					!almostEqual(g.Total, tt.want.Total) {
+					t.Errorf("EstimateCost() got = %+v, want %+v", g, tt.want)
+				}
+			},
+		)
+	}
+}
+
+func Test_storageGiBMonth(t *testing.T) {
+	v := ConsumptionHistoryPerTimeframe{
+		SyntheticStorageSizeBytes: bytesInGiB,
+		TimeframeStart:            time.Date(2024, 3, 1, 0, 0, 0, 0, time.UTC),
+		TimeframeEnd:              time.Date(2024, 3, 1, 0, 0, 0, 0, time.UTC).Add(hoursInMonth * time.Hour),
+	}
+	if got := storageGiBMonth(v); !almostEqual(got, 1) {
+		t.Errorf("storageGiBMonth() = %v, want 1", got)
+	}
+}
+
+func almostEqual(a, b float64) bool {
+	return math.Abs(a-b) < 1e-9
+}
@@ -0,0 +1,110 @@
+package sdk
+
+import (
+	"bytes"
+	"encoding/json"
+	"io"
+	"strconv"
+	"strings"
+	"sync"
+	"sync/atomic"
+)
+
+// DebugDump writes the sanitized requests and responses to the writer for debugging.
+// The JSON bodies are pretty-printed, the values of the secret attributes, e.g. "password", are redacted.
+// The dump can be enabled and disabled at runtime.
+type DebugDump struct {
+	enabled int32
+
+	mu sync.Mutex
+	w  io.Writer
+}
+
+// NewDebugDump initialises the enabled DebugDump which writes to w.
+func NewDebugDump(w io.Writer) *DebugDump {
+	return &DebugDump{enabled: 1, w: w}
+}
+
+// Enable enables the dump.
+func (d *DebugDump) Enable() {
+	atomic.StoreInt32(&d.enabled, 1)
+}
+
+// Disable disables the dump.
+func (d *DebugDump) Disable() {
+	atomic.StoreInt32(&d.enabled, 0)
+}
+
+// Enabled defines if the dump is enabled.
+func (d *DebugDump) Enabled() bool {
+	return d != nil && atomic.LoadInt32(&d.enabled) == 1
+}
+
+const redacted = "REDACTED"
+
+// secretAttributes the attributes which values are redacted.
+var secretAttributes = []string{"password", "key", "token", "secret", "connection_uri", "connection_uris"}
+
+func (d *DebugDump) dump(method, url string, reqBody []byte, statusCode int, respBody []byte) {
+	if !d.Enabled() {
+		return
+	}
+
+	var buf bytes.Buffer
+	buf.WriteString("> " + method + " " + url + "\n")
+	writeSanitizedBody(&buf, reqBody)
+	buf.WriteString("< " + strconv.Itoa(statusCode) + "\n")
+	writeSanitizedBody(&buf, respBody)
+	buf.WriteString("\n")
+
+	d.mu.Lock()
+	defer d.mu.Unlock()
+	_, _ = d.w.Write(buf.Bytes())
+}
+
+func writeSanitizedBody(buf *bytes.Buffer, body []byte) {
+	if len(body) == 0 {
+		return
+	}
+
+	var v interface{}
+	if err := json.Unmarshal(body, &v); err != nil {
+		buf.WriteString("[non-JSON body of " + strconv.Itoa(len(body)) + " bytes]\n")
+		return
+	}
+
+	o, _ := json.MarshalIndent(sanitize(v), "", "  ")
+	buf.Write(o)
+	buf.WriteString("\n")
+}
+
+func sanitize(v interface{}) interface{} {
+	switch vv := v.(type) {
+	case map[string]interface{}:
+		for k, el := range vv {
+			if isSecretAttribute(k) {
+				vv[k] = redacted
+				continue
+			}
+			vv[k] = sanitize(el)
+		}
+		return vv
+	case []interface{}:
+		for i, el := range vv {
+			vv[i] = sanitize(el)
+		}
+		return vv
+	default:
+		return v
+	}
+}
+
+func isSecretAttribute(k string) bool {
+	k = strings.ToLower(k)
+	for _, s := range secretAttributes {
+		if k == s || strings.HasSuffix(k, "_"+s) {
+			return true
+		}
+	}
+	return false
+}
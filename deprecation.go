@@ -0,0 +1,145 @@
+package sdk
+
+import (
+	"log"
+	"net/http"
+	"net/url"
+	"strconv"
+	"strings"
+	"sync"
+	"time"
+)
+
+// DeprecationNotice the notice of the endpoint's retirement reported by the API
+// with the Deprecation and Sunset response headers, see RFC 9745 and RFC 8594.
+type DeprecationNotice struct {
+	Method string
+	URL    string
+	// Deprecated defines if the endpoint is deprecated, i.e. the Deprecation header is set.
+	Deprecated bool
+	// DeprecatedAt the time of the deprecation, nil if unknown.
+	DeprecatedAt *time.Time
+	// Sunset the time when the endpoint is expected to become unavailable, nil if unknown.
+	Sunset *time.Time
+	// Link the URL of the deprecation, or the sunset policy documentation, empty if not set.
+	Link string
+}
+
+func (n DeprecationNotice) String() string {
+	o := n.Method + " " + n.URL + " is deprecated"
+	if n.DeprecatedAt != nil {
+		o += " since " + n.DeprecatedAt.Format(time.RFC3339)
+	}
+	if n.Sunset != nil {
+		o += ", sunset at " + n.Sunset.Format(time.RFC3339)
+	}
+	if n.Link != "" {
+		o += ", see " + n.Link
+	}
+	return o
+}
+
+// DeprecationFunc is invoked with the notice when the API reports the deprecation of the called endpoint.
+type DeprecationFunc func(DeprecationNotice)
+
+// LogDeprecation returns DeprecationFunc writing the warning to the logger, or to the standard logger if it is nil.
+// The warning is written once per endpoint's method and path.
+func LogDeprecation(logger *log.Logger) DeprecationFunc {
+	var reported sync.Map
+	return func(n DeprecationNotice) {
+		k := n.Method + " " + n.URL
+		if u, err := url.Parse(n.URL); err == nil {
+			k = n.Method + " " + u.Path
+		}
+		if _, ok := reported.LoadOrStore(k, struct{}{}); ok {
+			return
+		}
+		if logger == nil {
+			log.Printf("WARNING: %s", n)
+			return
+		}
+		logger.Printf("WARNING: %s", n)
+	}
+}
+
+// notifyDeprecation calls the OnDeprecation hook if it is set and the response reports the deprecation.
+func (c Client) notifyDeprecation(method, u string, res *http.Response) {
+	if c.cfg.OnDeprecation == nil {
+		return
+	}
+	if n, ok := parseDeprecationNotice(res.Header); ok {
+		n.Method = method
+		n.URL = u
+		c.cfg.OnDeprecation(n)
+	}
+}
+
+func parseDeprecationNotice(h http.Header) (DeprecationNotice, bool) {
+	var o DeprecationNotice
+
+	if v := strings.TrimSpace(h.Get("Deprecation")); v != "" {
+		o.Deprecated = true
+		o.DeprecatedAt = parseDeprecationDate(v)
+	}
+
+	if v := strings.TrimSpace(h.Get("Sunset")); v != "" {
+		if t, err := http.ParseTime(v); err == nil {
+			t = t.UTC()
+			o.Sunset = &t
+		}
+	}
+
+	if !o.Deprecated && o.Sunset == nil {
+		return DeprecationNotice{}, false
+	}
+	o.Link = deprecationLink(h.Values("Link"))
+	return o, true
+}
+
+// parseDeprecationDate parses the Deprecation header's value: the structured field date, e.g. "@1688169599",
+// or the HTTP-date used by the earlier drafts. Nil is returned for other values, e.g. "true".
+func parseDeprecationDate(v string) *time.Time {
+	if strings.HasPrefix(v, "@") {
+		if sec, err := strconv.ParseInt(v[1:], 10, 64); err == nil {
+			t := time.Unix(sec, 0).UTC()
+			return &t
+		}
+		return nil
+	}
+	if t, err := http.ParseTime(v); err == nil {
+		t = t.UTC()
+		return &t
+	}
+	return nil
+}
+
+// deprecationLink returns the target of the Link header with the relation type "deprecation", or "sunset".
+func deprecationLink(values []string) string {
+	var sunset string
+	for _, v := range values {
+		for _, link := range strings.Split(v, ",") {
+			target, params, ok := strings.Cut(link, ";")
+			if !ok {
+				continue
+			}
+			target = strings.Trim(strings.TrimSpace(target), "<>")
+			for _, p := range strings.Split(params, ";") {
+				k, rel, _ := strings.Cut(strings.TrimSpace(p), "=")
+				if !strings.EqualFold(k, "rel") {
+					continue
+				}
+				for _, r := range strings.Fields(strings.Trim(rel, `"`)) {
+					switch strings.ToLower(r) {
+					case "deprecation":
+						return target
+					case "sunset":
+						if sunset == "" {
+							sunset = target
+						}
+					}
+				}
+			}
+		}
+	}
+	return sunset
+}
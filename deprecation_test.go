@@ -0,0 +1,131 @@
+package sdk
+
+import (
+	"bytes"
+	"io"
+	"log"
+	"net/http"
+	"reflect"
+	"strings"
+	"testing"
+	"time"
+)
+
+func Test_parseDeprecationNotice(t *testing.T) {
+	deprecatedAt := time.Date(2023, 6, 30, 23, 59, 59, 0, time.UTC)
+	sunset := time.Date(2024, 12, 31, 23, 59, 59, 0, time.UTC)
+
+	tests := []struct {
+		name   string
+		header http.Header
+		want   DeprecationNotice
+		wantOk bool
+	}{
+		{
+			name:   "no deprecation",
+			header: http.Header{},
+		},
+		{
+			name: "structured field date",
+			header: http.Header{
+				"Deprecation": []string{"@1688169599"},
+				"Link":        []string{`<https://neon.tech/docs/changelog>; rel="deprecation"; type="text/html"`},
+			},
+			want: DeprecationNotice{
+				Deprecated: true, DeprecatedAt: &deprecatedAt, Link: "https://neon.tech/docs/changelog",
+			},
+			wantOk: true,
+		},
+		{
+			name: "boolean deprecation and sunset",
+			header: http.Header{
+				"Deprecation": []string{"true"},
+				"Sunset":      []string{"Tue, 31 Dec 2024 23:59:59 GMT"},
+				"Link": []string{
+					`<https://neon.tech>; rel="alternate", <https://neon.tech/docs/sunset>; rel="sunset"`,
+				},
+			},
+			want: DeprecationNotice{
+				Deprecated: true, Sunset: &sunset, Link: "https://neon.tech/docs/sunset",
+			},
+			wantOk: true,
+		},
+		{
+			name:   "sunset only",
+			header: http.Header{"Sunset": []string{"Tue, 31 Dec 2024 23:59:59 GMT"}},
+			want:   DeprecationNotice{Sunset: &sunset},
+			wantOk: true,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(
+			tt.name, func(t *testing.T) {
+				got, ok := parseDeprecationNotice(tt.header)
+				if ok != tt.wantOk {
+					t.Fatalf("parseDeprecationNotice() ok = %v, want %v", ok, tt.wantOk)
+				}
+				if !reflect.DeepEqual(got, tt.want) {
+					t.Errorf("parseDeprecationNotice() = %+v, want %+v", got, tt.want)
+				}
+			},
+		)
+	}
+}
+
+func TestClient_OnDeprecation(t *testing.T) {
+	header := http.Header{"Deprecation": []string{"true"}}
+
+	var got []DeprecationNotice
+	c, err := NewClient(
+		Config{
+			Key: "foo",
+			HTTPClient: doerFunc(
+				func(req *http.Request) (*http.Response, error) {
+					return &http.Response{
+						StatusCode: http.StatusOK,
+						Header:     header,
+						Body:       io.NopCloser(strings.NewReader(`{}`)),
+						Request:    req,
+					}, nil
+				},
+			),
+			OnDeprecation: func(n DeprecationNotice) {
+				got = append(got, n)
+			},
+		},
+	)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if _, err := c.GetProjectBranch("foo", "bar"); err != nil {
+		t.Fatal(err)
+	}
+	if len(got) != 1 || !got[0].Deprecated || got[0].Method != http.MethodGet ||
+		got[0].URL != c.baseURL+"/projects/foo/branches/bar" {
+		t.Fatalf("unexpected notices: %+v", got)
+	}
+
+	header = http.Header{}
+	if _, err := c.GetProjectBranch("foo", "bar"); err != nil {
+		t.Fatal(err)
+	}
+	if len(got) != 1 {
+		t.Errorf("no notice is expected for the response without the deprecation headers, got %+v", got[1:])
+	}
+}
+
+func TestLogDeprecation(t *testing.T) {
+	var buf bytes.Buffer
+	fn := LogDeprecation(log.New(&buf, "", 0))
+
+	fn(DeprecationNotice{Method: http.MethodGet, URL: "https://foo.bar/api/v2/projects?cursor=foo", Deprecated: true})
+	fn(DeprecationNotice{Method: http.MethodGet, URL: "https://foo.bar/api/v2/projects?cursor=bar", Deprecated: true})
+	fn(DeprecationNotice{Method: http.MethodPost, URL: "https://foo.bar/api/v2/projects", Deprecated: true})
+
+	want := "WARNING: GET https://foo.bar/api/v2/projects?cursor=foo is deprecated\n" +
+		"WARNING: POST https://foo.bar/api/v2/projects is deprecated\n"
+	if buf.String() != want {
+		t.Errorf("unexpected log:\n%s\nwant:\n%s", buf.String(), want)
+	}
+}
@@ -0,0 +1,64 @@
+package sdk
+
+import (
+	"errors"
+	"fmt"
+	"net/http"
+)
+
+// ErrDestructiveCallRejected the destructive call was not confirmed.
+var ErrDestructiveCallRejected = errors.New("destructive call rejected")
+
+// ErrReadOnlyClient the call changing the resources was rejected by the read-only client, see Config.ReadOnly.
+var ErrReadOnlyClient = errors.New("read-only client")
+
+// DestructiveGuard protects shared automation from accidental removal of resources.
+// A destructive call, i.e. the DELETE request sent by DeleteProject, DeleteProjectBranch, RevokeApiKey etc.,
+// is only sent if AllowDestructive is set, or if Confirm approves it.
+type DestructiveGuard struct {
+	// AllowDestructive permits all destructive calls without confirmation.
+	AllowDestructive bool
+
+	// Confirm is invoked before the destructive call is sent, the call is rejected unless it returns true.
+	Confirm func(call DestructiveCall) bool
+}
+
+// DestructiveCall defines the destructive call pending confirmation.
+type DestructiveCall struct {
+	// Method HTTP request method.
+	Method string
+	// URL HTTP request URL.
+	URL string
+}
+
+func (g *DestructiveGuard) check(method, url string) error {
+	if g == nil || method != http.MethodDelete || g.AllowDestructive {
+		return nil
+	}
+
+	if g.Confirm != nil && g.Confirm(DestructiveCall{Method: method, URL: url}) {
+		return nil
+	}
+
+	return &destructiveCallError{call: DestructiveCall{Method: method, URL: url}}
+}
+
+type destructiveCallError struct {
+	call DestructiveCall
+}
+
+func (e *destructiveCallError) Error() string {
+	return ErrDestructiveCallRejected.Error() + ": " + e.call.Method + " " + e.call.URL
+}
+
+func (e *destructiveCallError) Unwrap() error {
+	return ErrDestructiveCallRejected
+}
+
+// checkReadOnly rejects the methods other than GET if the client is read-only.
+func checkReadOnly(readOnly bool, method, url string) error {
+	if !readOnly || method == http.MethodGet {
+		return nil
+	}
+	return fmt.Errorf("%w: %s %s", ErrReadOnlyClient, method, url)
+}
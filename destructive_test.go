@@ -0,0 +1,101 @@
+package sdk
+
+import (
+	"errors"
+	"net/http"
+	"testing"
+)
+
+func TestDestructiveGuard_check(t *testing.T) {
+	tests := []struct {
+		name    string
+		guard   *DestructiveGuard
+		method  string
+		wantErr bool
+	}{
+		{
+			name:   "no guard",
+			guard:  nil,
+			method: http.MethodDelete,
+		},
+		{
+			name:   "non destructive call",
+			guard:  &DestructiveGuard{},
+			method: http.MethodGet,
+		},
+		{
+			name:   "destructive calls allowed",
+			guard:  &DestructiveGuard{AllowDestructive: true},
+			method: http.MethodDelete,
+		},
+		{
+			name: "destructive call confirmed",
+			guard: &DestructiveGuard{
+				Confirm: func(call DestructiveCall) bool {
+					return call.URL == "/projects/foo"
+				},
+			},
+			method: http.MethodDelete,
+		},
+		{
+			name: "destructive call not confirmed",
+			guard: &DestructiveGuard{
+				Confirm: func(call DestructiveCall) bool {
+					return false
+				},
+			},
+			method:  http.MethodDelete,
+			wantErr: true,
+		},
+		{
+			name:    "destructive call without confirmation",
+			guard:   &DestructiveGuard{},
+			method:  http.MethodDelete,
+			wantErr: true,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(
+			tt.name, func(t *testing.T) {
+				err := tt.guard.check(tt.method, "/projects/foo")
+				if (err != nil) != tt.wantErr {
+					t.Errorf("check() error = %v, wantErr %v", err, tt.wantErr)
+				}
+				if tt.wantErr && !errors.Is(err, ErrDestructiveCallRejected) {
+					t.Errorf("check() error = %v, want ErrDestructiveCallRejected", err)
+				}
+			},
+		)
+	}
+}
+
+func TestClient_requestHandler_DestructiveGuard(t *testing.T) {
+	c, err := NewClient(
+		Config{Key: "foo", HTTPClient: NewMockHTTPClient(), DestructiveGuard: &DestructiveGuard{}},
+	)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if err := c.requestHandler(c.baseURL+"/projects/foo", http.MethodDelete, nil, nil); !errors.Is(
+		err, ErrDestructiveCallRejected,
+	) {
+		t.Errorf("requestHandler() error = %v, want ErrDestructiveCallRejected", err)
+	}
+}
+
+func TestClient_requestHandler_ReadOnly(t *testing.T) {
+	c, err := NewClient(Config{Key: "foo", HTTPClient: NewMockHTTPClient(), ReadOnly: true})
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if _, err := c.GetProjectBranch("foo", "bar"); errors.Is(err, ErrReadOnlyClient) {
+		t.Errorf("GET is not expected to be rejected, got %v", err)
+	}
+	for _, method := range []string{http.MethodPost, http.MethodPatch, http.MethodDelete} {
+		if err := c.requestHandler(c.baseURL+"/projects/foo", method, nil, nil); !errors.Is(err, ErrReadOnlyClient) {
+			t.Errorf("requestHandler() error = %v, want ErrReadOnlyClient for %s", err, method)
+		}
+	}
+}
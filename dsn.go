@@ -0,0 +1,111 @@
+package sdk
+
+import (
+	"errors"
+	"fmt"
+	"net/url"
+	"strings"
+)
+
+// ErrInvalidEndpointHost the error returned when the host does not follow the format of the compute endpoint's host,
+// e.g. "ep-cool-darkness-123456.us-east-2.aws.neon.tech".
+var ErrInvalidEndpointHost = errors.New("invalid endpoint host")
+
+// poolerSuffix the suffix of the endpoint ID in the host of the connection pooler.
+const poolerSuffix = "-pooler"
+
+// ValidateEndpointHost checks if the host follows the format of the compute endpoint's host,
+// i.e. the endpoint ID, optionally followed by the pooler suffix, and the domain.
+// The returned error wraps ErrInvalidEndpointHost.
+func ValidateEndpointHost(host string) error {
+	labels := strings.Split(host, ".")
+	if len(labels) < 3 {
+		return fmt.Errorf("%w: %q must contain the endpoint ID and the domain", ErrInvalidEndpointHost, host)
+	}
+	for _, l := range labels {
+		if !isHostLabel(l) {
+			return fmt.Errorf("%w: %q contains invalid label %q", ErrInvalidEndpointHost, host, l)
+		}
+	}
+	if !strings.HasPrefix(labels[0], EndpointIDPrefix) {
+		return fmt.Errorf("%w: %q must start with the endpoint ID", ErrInvalidEndpointHost, host)
+	}
+	return nil
+}
+
+func isHostLabel(s string) bool {
+	if s == "" || len(s) > 63 || s[0] == '-' || s[len(s)-1] == '-' {
+		return false
+	}
+	for _, r := range s {
+		if !(r >= 'a' && r <= 'z' || r >= 'A' && r <= 'Z' || r >= '0' && r <= '9' || r == '-') {
+			return false
+		}
+	}
+	return true
+}
+
+// PoolerHost returns the host of the endpoint's connection pooler, e.g.
+// "ep-cool-darkness-123456-pooler.us-east-2.aws.neon.tech" for "ep-cool-darkness-123456.us-east-2.aws.neon.tech".
+// The pooler host is returned as is.
+func PoolerHost(host string) (string, error) {
+	if err := ValidateEndpointHost(host); err != nil {
+		return "", err
+	}
+	id, domain, _ := strings.Cut(host, ".")
+	if strings.HasSuffix(id, poolerSuffix) {
+		return host, nil
+	}
+	return id + poolerSuffix + "." + domain, nil
+}
+
+// DirectHost returns the endpoint's host without the connection pooler.
+// The direct host is returned as is.
+func DirectHost(host string) (string, error) {
+	if err := ValidateEndpointHost(host); err != nil {
+		return "", err
+	}
+	id, domain, _ := strings.Cut(host, ".")
+	return strings.TrimSuffix(id, poolerSuffix) + "." + domain, nil
+}
+
+// EndpointDSNs the connection strings of the compute endpoint.
+type EndpointDSNs struct {
+	// Direct the connection string to the compute endpoint.
+	Direct string
+	// Pooled the connection string to the endpoint's connection pooler.
+	Pooled string
+}
+
+// NewEndpointDSNs builds the direct and pooled connection strings to the endpoint's database for the role.
+func NewEndpointDSNs(ep Endpoint, roleName, password, databaseName string) (EndpointDSNs, error) {
+	host := ep.Host
+	if host == "" {
+		host = ep.ProxyHost
+	}
+
+	direct, err := DirectHost(host)
+	if err != nil {
+		return EndpointDSNs{}, err
+	}
+	pooled, err := PoolerHost(host)
+	if err != nil {
+		return EndpointDSNs{}, err
+	}
+
+	return EndpointDSNs{
+		Direct: buildDSN(direct, roleName, password, databaseName),
+		Pooled: buildDSN(pooled, roleName, password, databaseName),
+	}, nil
+}
+
+func buildDSN(host, roleName, password, databaseName string) string {
+	u := url.URL{
+		Scheme:   "postgresql",
+		User:     url.UserPassword(roleName, password),
+		Host:     host,
+		Path:     "/" + databaseName,
+		RawQuery: "sslmode=require",
+	}
+	return u.String()
+}
@@ -19,18 +19,22 @@ func main() {
 
 	client, err := sdk.NewClient(sdk.Config{Key: token})
 	if err != nil {
-		log.Printf("could not initialize SDK: %v\n", err)
+		log.Fatalf("could not initialize SDK: %v\n", err)
 	}
 
 	// provision a project with default configurations
 	resp, err := client.CreateProject(sdk.ProjectCreateRequest{})
 	if err != nil {
-		log.Printf("could not create the project: %v\n", err)
+		log.Fatalf("could not create the project: %v\n", err)
 	}
 	// delete the provisioned project in the end of the process' execution
 	defer func() { _, _ = client.DeleteProject(resp.ProjectResponse.Project.ID) }()
 
 	// connect to the default database
+	if len(resp.ConnectionURIs) == 0 {
+		log.Println("no connection URI returned")
+		return
+	}
 	connectionURI := resp.ConnectionURIs[0].ConnectionURI
 	ctx := context.Background()
 	conn, err := pgx.Connect(ctx, connectionURI)
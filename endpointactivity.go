@@ -0,0 +1,100 @@
+package sdk
+
+import (
+	"context"
+	"fmt"
+	"sort"
+	"sync"
+	"time"
+)
+
+// EndpointActivity the activity of the compute endpoint.
+type EndpointActivity struct {
+	ProjectID   string `json:"project_id"`
+	ProjectName string `json:"project_name"`
+	BranchID    string `json:"branch_id"`
+	EndpointID  string `json:"endpoint_id"`
+	// Type the endpoint's type, i.e. read_write, or read_only.
+	Type EndpointType `json:"type"`
+	// State the endpoint's state, the compute is provisioned in the state EndpointStateActive.
+	State EndpointState `json:"state"`
+	// LastActive the last time the endpoint was active, nil if it was never active.
+	LastActive *time.Time `json:"last_active,omitempty"`
+	// IdleFor the time since the endpoint was last active, or since it was created if it was never active.
+	IdleFor               time.Duration         `json:"idle_for"`
+	AutoscalingLimitMinCu ComputeUnit           `json:"autoscaling_limit_min_cu"`
+	AutoscalingLimitMaxCu ComputeUnit           `json:"autoscaling_limit_max_cu"`
+	SuspendTimeoutSeconds SuspendTimeoutSeconds `json:"suspend_timeout_seconds"`
+}
+
+// Provisioned defines if the endpoint's compute is running.
+func (a EndpointActivity) Provisioned() bool {
+	return a.State == EndpointStateActive
+}
+
+// GetEndpointActivity lists the endpoints of all projects and reports their activity.
+// The report is sorted to find the idle but provisioned compute first: the provisioned endpoints precede
+// the suspended ones, and the endpoints idle for longer precede the recently active ones.
+func (c Client) GetEndpointActivity(
+	ctx context.Context, list ListAllProjectsOptions, opts BatchOptions,
+) ([]EndpointActivity, error) {
+	var (
+		mu  sync.Mutex
+		o   []EndpointActivity
+		now = time.Now()
+	)
+	err := c.ForEachProject(
+		ctx, list, opts, func(ctx context.Context, p ProjectListItem) error {
+			if err := ctx.Err(); err != nil {
+				return err
+			}
+			resp, err := c.ListProjectEndpoints(p.ID)
+			if err != nil {
+				return fmt.Errorf("could not list endpoints of project %s: %w", p.ID, err)
+			}
+
+			mu.Lock()
+			defer mu.Unlock()
+			for _, e := range resp.Endpoints {
+				o = append(o, newEndpointActivity(p, e, now))
+			}
+			return nil
+		},
+	)
+	sortEndpointActivity(o)
+	return o, err
+}
+
+func newEndpointActivity(p ProjectListItem, e Endpoint, now time.Time) EndpointActivity {
+	since := e.CreatedAt
+	if e.LastActive != nil {
+		since = *e.LastActive
+	}
+	return EndpointActivity{
+		ProjectID:             p.ID,
+		ProjectName:           p.Name,
+		BranchID:              e.BranchID,
+		EndpointID:            e.ID,
+		Type:                  e.Type,
+		State:                 e.CurrentState,
+		LastActive:            e.LastActive,
+		IdleFor:               now.Sub(since),
+		AutoscalingLimitMinCu: e.AutoscalingLimitMinCu,
+		AutoscalingLimitMaxCu: e.AutoscalingLimitMaxCu,
+		SuspendTimeoutSeconds: e.SuspendTimeoutSeconds,
+	}
+}
+
+func sortEndpointActivity(v []EndpointActivity) {
+	sort.SliceStable(
+		v, func(i, j int) bool {
+			if v[i].Provisioned() != v[j].Provisioned() {
+				return v[i].Provisioned()
+			}
+			if v[i].IdleFor != v[j].IdleFor {
+				return v[i].IdleFor > v[j].IdleFor
+			}
+			return v[i].EndpointID < v[j].EndpointID
+		},
+	)
+}
@@ -0,0 +1,62 @@
+package sdk
+
+import (
+	"context"
+	"net/http"
+	"testing"
+	"time"
+)
+
+func TestClient_GetEndpointActivity(t *testing.T) {
+	ts := func(d time.Duration) string {
+		return time.Now().Add(-d).UTC().Format(time.RFC3339)
+	}
+
+	api := newFakeAPI().
+		on(
+			http.MethodGet, "/projects", http.StatusOK,
+			`{"projects":[{"id":"foo","name":"Foo"},{"id":"bar","name":"Bar"}]}`,
+		).
+		on(
+			http.MethodGet, "/projects/foo/endpoints", http.StatusOK, `{"endpoints":[
+{"id":"ep-recent","branch_id":"br-foo","current_state":"active","last_active":"`+ts(time.Minute)+`","created_at":"`+ts(time.Hour)+`"},
+{"id":"ep-suspended","branch_id":"br-foo","current_state":"idle","last_active":"`+ts(72*time.Hour)+`","created_at":"`+ts(96*time.Hour)+`"}
+]}`,
+		).
+		on(
+			http.MethodGet, "/projects/bar/endpoints", http.StatusOK, `{"endpoints":[
+{"id":"ep-idle","branch_id":"br-bar","current_state":"active","last_active":"`+ts(24*time.Hour)+`","created_at":"`+ts(96*time.Hour)+`","autoscaling_limit_max_cu":4},
+{"id":"ep-never","branch_id":"br-bar","current_state":"active","created_at":"`+ts(48*time.Hour)+`"}
+]}`,
+		)
+
+	c, err := NewClient(Config{Key: "foo", HTTPClient: api})
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	got, err := c.GetEndpointActivity(context.TODO(), ListAllProjectsOptions{}, BatchOptions{})
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	want := []string{"ep-never", "ep-idle", "ep-recent", "ep-suspended"}
+	if len(got) != len(want) {
+		t.Fatalf("GetEndpointActivity() got = %v, want %v", got, want)
+	}
+	for i := range want {
+		if got[i].EndpointID != want[i] {
+			t.Errorf("GetEndpointActivity() got %s at %d, want %s", got[i].EndpointID, i, want[i])
+		}
+	}
+
+	if got[0].LastActive != nil || got[0].IdleFor < 47*time.Hour {
+		t.Errorf("unexpected activity of the endpoint never active: %+v", got[0])
+	}
+	if got[1].ProjectName != "Bar" || got[1].AutoscalingLimitMaxCu != 4 || got[1].IdleFor < 23*time.Hour {
+		t.Errorf("unexpected activity of the idle endpoint: %+v", got[1])
+	}
+	if got[3].Provisioned() {
+		t.Errorf("suspended endpoint is not expected to be provisioned")
+	}
+}
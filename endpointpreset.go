@@ -0,0 +1,115 @@
+package sdk
+
+import (
+	"context"
+	"fmt"
+)
+
+// EndpointPreset the standard compute settings of the endpoints.
+type EndpointPreset struct {
+	Name                  string
+	AutoscalingLimitMinCu ComputeUnit
+	AutoscalingLimitMaxCu ComputeUnit
+	// SuspendTimeoutSeconds the inactivity duration before the compute is suspended, -1 means never suspend.
+	SuspendTimeoutSeconds SuspendTimeoutSeconds
+}
+
+var (
+	// EndpointPresetDev the small compute suspended after a minute of inactivity.
+	EndpointPresetDev = EndpointPreset{
+		Name: "dev", AutoscalingLimitMinCu: 0.25, AutoscalingLimitMaxCu: 1, SuspendTimeoutSeconds: 60,
+	}
+	// EndpointPresetStaging the medium compute suspended after five minutes of inactivity.
+	EndpointPresetStaging = EndpointPreset{
+		Name: "staging", AutoscalingLimitMinCu: 0.5, AutoscalingLimitMaxCu: 2, SuspendTimeoutSeconds: 300,
+	}
+	// EndpointPresetProd the compute with at least 2 CU which is never suspended.
+	EndpointPresetProd = EndpointPreset{
+		Name: "prod", AutoscalingLimitMinCu: 2, AutoscalingLimitMaxCu: 8, SuspendTimeoutSeconds: -1,
+	}
+)
+
+// CreateRequest returns the request to create the branch's endpoint of the type with the preset's settings.
+func (p EndpointPreset) CreateRequest(branchID string, t EndpointType) EndpointCreateRequest {
+	minCu, maxCu, suspend := p.AutoscalingLimitMinCu, p.AutoscalingLimitMaxCu, p.SuspendTimeoutSeconds
+	return EndpointCreateRequest{
+		Endpoint: EndpointCreateRequestEndpoint{
+			BranchID:              branchID,
+			Type:                  t,
+			AutoscalingLimitMinCu: &minCu,
+			AutoscalingLimitMaxCu: &maxCu,
+			SuspendTimeoutSeconds: &suspend,
+		},
+	}
+}
+
+// UpdateRequest returns the request to set the preset's settings of the endpoint.
+func (p EndpointPreset) UpdateRequest() EndpointUpdateRequest {
+	minCu, maxCu, suspend := p.AutoscalingLimitMinCu, p.AutoscalingLimitMaxCu, p.SuspendTimeoutSeconds
+	return EndpointUpdateRequest{
+		Endpoint: EndpointUpdateRequestEndpoint{
+			AutoscalingLimitMinCu: &minCu,
+			AutoscalingLimitMaxCu: &maxCu,
+			SuspendTimeoutSeconds: &suspend,
+		},
+	}
+}
+
+// BranchEndpointOptions returns the options of the endpoint of the type created with the branch.
+func (p EndpointPreset) BranchEndpointOptions(t EndpointType) BranchCreateRequestEndpointOptions {
+	minCu, maxCu, suspend := p.AutoscalingLimitMinCu, p.AutoscalingLimitMaxCu, p.SuspendTimeoutSeconds
+	return BranchCreateRequestEndpointOptions{
+		Type:                  t,
+		AutoscalingLimitMinCu: &minCu,
+		AutoscalingLimitMaxCu: &maxCu,
+		SuspendTimeoutSeconds: &suspend,
+	}
+}
+
+// DefaultEndpointSettings returns the project's default settings of the endpoints, e.g. to create the project.
+func (p EndpointPreset) DefaultEndpointSettings() DefaultEndpointSettings {
+	minCu, maxCu, suspend := p.AutoscalingLimitMinCu, p.AutoscalingLimitMaxCu, p.SuspendTimeoutSeconds
+	return DefaultEndpointSettings{
+		AutoscalingLimitMinCu: &minCu,
+		AutoscalingLimitMaxCu: &maxCu,
+		SuspendTimeoutSeconds: &suspend,
+	}
+}
+
+// Matches defines if the endpoint has the preset's settings.
+func (p EndpointPreset) Matches(e Endpoint) bool {
+	return e.AutoscalingLimitMinCu == p.AutoscalingLimitMinCu &&
+		e.AutoscalingLimitMaxCu == p.AutoscalingLimitMaxCu &&
+		e.SuspendTimeoutSeconds == p.SuspendTimeoutSeconds
+}
+
+// ApplyEndpointPreset sets the preset's settings of the endpoint, and waits for the operations.
+// It is noop if the endpoint has the preset's settings already.
+func (c Client) ApplyEndpointPreset(
+	ctx context.Context, projectID, endpointID string, preset EndpointPreset,
+) (Endpoint, error) {
+	if err := ctx.Err(); err != nil {
+		return Endpoint{}, err
+	}
+
+	cur, err := c.GetProjectEndpoint(projectID, endpointID)
+	if err != nil {
+		return Endpoint{}, fmt.Errorf("could not read endpoint %s: %w", endpointID, err)
+	}
+	if preset.Matches(cur.Endpoint) {
+		return cur.Endpoint, nil
+	}
+
+	resp, err := callUnlessLocked(
+		ctx, func() (EndpointOperations, error) {
+			return c.UpdateProjectEndpoint(projectID, endpointID, preset.UpdateRequest())
+		},
+	)
+	if err != nil {
+		return Endpoint{}, fmt.Errorf("could not apply preset %s to endpoint %s: %w", preset.Name, endpointID, err)
+	}
+	if err := c.WaitForOperations(ctx, projectID, resp.Operations); err != nil {
+		return resp.Endpoint, err
+	}
+	return resp.Endpoint, nil
+}
@@ -0,0 +1,76 @@
+package sdk
+
+import (
+	"context"
+	"encoding/json"
+	"io"
+	"net/http"
+	"testing"
+)
+
+func TestEndpointPreset_UpdateRequest(t *testing.T) {
+	got, err := json.Marshal(EndpointPresetProd.UpdateRequest())
+	if err != nil {
+		t.Fatal(err)
+	}
+	want := `{"endpoint":{"autoscaling_limit_max_cu":8,"autoscaling_limit_min_cu":2,"suspend_timeout_seconds":-1}}`
+	if string(got) != want {
+		t.Errorf("UpdateRequest() = %s, want %s", got, want)
+	}
+}
+
+func TestClient_ApplyEndpointPreset(t *testing.T) {
+	tests := []struct {
+		name        string
+		endpoint    string
+		wantUpdated bool
+	}{
+		{
+			name:        "settings differ",
+			endpoint:    `{"id":"ep-foo","autoscaling_limit_min_cu":1,"autoscaling_limit_max_cu":4,"suspend_timeout_seconds":0}`,
+			wantUpdated: true,
+		},
+		{
+			name:     "preset applied already",
+			endpoint: `{"id":"ep-foo","autoscaling_limit_min_cu":0.25,"autoscaling_limit_max_cu":1,"suspend_timeout_seconds":60}`,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(
+			tt.name, func(t *testing.T) {
+				var gotReq EndpointUpdateRequest
+				api := newFakeAPI().
+					on(http.MethodGet, "/projects/foo/endpoints/ep-foo", http.StatusOK, `{"endpoint":`+tt.endpoint+`}`).
+					onFunc(
+						http.MethodPatch, "/projects/foo/endpoints/ep-foo", func(req *http.Request) (int, string) {
+							b, _ := io.ReadAll(req.Body)
+							_ = json.Unmarshal(b, &gotReq)
+							return http.StatusOK, `{"endpoint":{"id":"ep-foo","autoscaling_limit_min_cu":0.25,` +
+								`"autoscaling_limit_max_cu":1,"suspend_timeout_seconds":60},"operations":[]}`
+						},
+					)
+
+				c, err := NewClient(Config{Key: "foo", HTTPClient: api})
+				if err != nil {
+					t.Fatal(err)
+				}
+
+				got, err := c.ApplyEndpointPreset(context.TODO(), "foo", "ep-foo", EndpointPresetDev)
+				if err != nil {
+					t.Fatal(err)
+				}
+				if !EndpointPresetDev.Matches(got) {
+					t.Errorf("unexpected endpoint: %+v", got)
+				}
+
+				updated := api.called(http.MethodPatch, "/projects/foo/endpoints/ep-foo") == 1
+				if updated != tt.wantUpdated {
+					t.Fatalf("unexpected update: %v", updated)
+				}
+				if updated && *gotReq.Endpoint.SuspendTimeoutSeconds != 60 {
+					t.Errorf("unexpected update request: %+v", gotReq.Endpoint)
+				}
+			},
+		)
+	}
+}
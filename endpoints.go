@@ -0,0 +1,323 @@
+package sdk
+
+import (
+	"context"
+	"crypto/tls"
+	"encoding/binary"
+	"errors"
+	"fmt"
+	"io"
+	"net"
+	"net/http"
+	"strconv"
+	"time"
+)
+
+// SuspendAllEndpoints suspends all project's compute endpoints which are not idle.
+// The parallelism defines the maximum number of endpoints processed concurrently, the default is used
+// if it is not positive. The endpoint's slot is released only when its operations reached the final status,
+// hence the number of the project's operations running at once is bounded.
+func (c Client) SuspendAllEndpoints(ctx context.Context, projectID string, parallelism int) error {
+	return c.forEachEndpoint(
+		ctx, projectID, parallelism, EndpointStateIdle, c.SuspendProjectEndpoint,
+	)
+}
+
+// StartAllEndpoints starts all project's compute endpoints which are not active.
+// The parallelism defines the maximum number of endpoints processed concurrently, the default is used
+// if it is not positive. The endpoint's slot is released only when its operations reached the final status,
+// hence the number of the project's operations running at once is bounded.
+func (c Client) StartAllEndpoints(ctx context.Context, projectID string, parallelism int) error {
+	return c.forEachEndpoint(
+		ctx, projectID, parallelism, EndpointStateActive, c.StartProjectEndpoint,
+	)
+}
+
+// forEachEndpoint calls fn for every project's endpoint unless it is in the target state,
+// and waits for the operations triggered by the call.
+func (c Client) forEachEndpoint(
+	ctx context.Context, projectID string, parallelism int, target EndpointState,
+	fn func(projectID string, endpointID string) (EndpointOperations, error),
+) error {
+	resp, err := c.ListProjectEndpoints(projectID)
+	if err != nil {
+		return fmt.Errorf("could not list endpoints of project %s: %w", projectID, err)
+	}
+
+	var endpoints []Endpoint
+	for _, ep := range resp.Endpoints {
+		if ep.CurrentState != target {
+			endpoints = append(endpoints, ep)
+		}
+	}
+
+	return forEach(
+		ctx, len(endpoints), parallelism, func(ctx context.Context, i int) error {
+			endpointID := endpoints[i].ID
+			resp, err := callUnlessLocked(
+				ctx, func() (EndpointOperations, error) { return fn(projectID, endpointID) },
+			)
+			if err != nil {
+				return fmt.Errorf("could not change state of endpoint %s: %w", endpointID, err)
+			}
+			if err := c.WaitForOperations(ctx, projectID, resp.Operations); err != nil {
+				return fmt.Errorf("endpoint %s: %w", endpointID, err)
+			}
+			return nil
+		},
+	)
+}
+
+// callUnlessLocked calls fn and repeats the call while the API rejects it because
+// the project is locked by other running operations.
+func callUnlessLocked[T any](ctx context.Context, fn func() (T, error)) (T, error) {
+	for {
+		v, err := fn()
+		var e Error
+		if err == nil || !errors.As(err, &e) || e.HTTPCode != http.StatusLocked {
+			return v, err
+		}
+
+		select {
+		case <-ctx.Done():
+			return v, ctx.Err()
+		case <-time.After(operationsPollInterval):
+		}
+	}
+}
+
+// WaitForEndpointStateOptions defines how the endpoint's state is awaited.
+type WaitForEndpointStateOptions struct {
+	// InitialInterval the interval before the first repeated check, it doubles after every check.
+	// The default is one second.
+	InitialInterval time.Duration
+	// MaxInterval the maximum interval between the checks, the default is 30 seconds.
+	MaxInterval time.Duration
+	// CheckReachability defines if the endpoint's host shall accept the TCP connection
+	// before the endpoint is considered ready.
+	CheckReachability bool
+	// TLS defines if the TLS handshake shall be completed on top of the TCP connection.
+	// It requires CheckReachability.
+	TLS bool
+	// Port the port to check the reachability, the default is 5432.
+	Port int
+	// DialTimeout the timeout of a single reachability check, the default is 10 seconds.
+	DialTimeout time.Duration
+}
+
+func (o WaitForEndpointStateOptions) withDefaults() WaitForEndpointStateOptions {
+	if o.InitialInterval <= 0 {
+		o.InitialInterval = operationsPollInterval
+	}
+	if o.MaxInterval <= 0 {
+		o.MaxInterval = 30 * time.Second
+	}
+	if o.MaxInterval < o.InitialInterval {
+		o.MaxInterval = o.InitialInterval
+	}
+	if o.Port <= 0 {
+		o.Port = 5432
+	}
+	if o.DialTimeout <= 0 {
+		o.DialTimeout = 10 * time.Second
+	}
+	return o
+}
+
+// WaitForEndpointState polls the endpoint with the exponential backoff until it reaches the desired state
+// with no pending state change. If the reachability check is requested, the endpoint's host is also polled
+// until it accepts the connection. The last read endpoint is returned.
+func (c Client) WaitForEndpointState(
+	ctx context.Context, projectID, endpointID string, desired EndpointState, opts WaitForEndpointStateOptions,
+) (Endpoint, error) {
+	opts = opts.withDefaults()
+
+	var (
+		ep       Endpoint
+		interval = opts.InitialInterval
+		lastErr  error
+	)
+	for {
+		resp, err := c.GetProjectEndpoint(projectID, endpointID)
+		if err != nil {
+			return Endpoint{}, fmt.Errorf("could not read endpoint %s: %w", endpointID, err)
+		}
+		ep = resp.Endpoint
+
+		if endpointInState(ep, desired) {
+			if !opts.CheckReachability {
+				return ep, nil
+			}
+			if lastErr = checkEndpointReachability(ctx, ep.Host, opts); lastErr == nil {
+				return ep, nil
+			}
+		}
+
+		select {
+		case <-ctx.Done():
+			if lastErr != nil {
+				return ep, fmt.Errorf("endpoint %s is not reachable: %w", endpointID, lastErr)
+			}
+			return ep, ctx.Err()
+		case <-time.After(interval):
+		}
+
+		if interval *= 2; interval > opts.MaxInterval {
+			interval = opts.MaxInterval
+		}
+	}
+}
+
+// endpointInState defines if the endpoint reached the state and is not about to change it.
+func endpointInState(ep Endpoint, state EndpointState) bool {
+	return ep.CurrentState == state && (ep.PendingState == nil || *ep.PendingState == state)
+}
+
+// postgresSSLRequestCode the code of the Postgres protocol message requesting the TLS connection.
+const postgresSSLRequestCode = 80877103
+
+// checkEndpointReachability connects to the host and completes the TLS handshake if requested.
+func checkEndpointReachability(ctx context.Context, host string, opts WaitForEndpointStateOptions) error {
+	ctx, cancel := context.WithTimeout(ctx, opts.DialTimeout)
+	defer cancel()
+
+	var d net.Dialer
+	conn, err := d.DialContext(ctx, "tcp", net.JoinHostPort(host, strconv.Itoa(opts.Port)))
+	if err != nil {
+		return err
+	}
+	defer func() { _ = conn.Close() }()
+
+	if !opts.TLS {
+		return nil
+	}
+
+	if deadline, ok := ctx.Deadline(); ok {
+		if err := conn.SetDeadline(deadline); err != nil {
+			return err
+		}
+	}
+
+	req := make([]byte, 8)
+	binary.BigEndian.PutUint32(req[0:4], 8)
+	binary.BigEndian.PutUint32(req[4:8], postgresSSLRequestCode)
+	if _, err := conn.Write(req); err != nil {
+		return err
+	}
+
+	resp := make([]byte, 1)
+	if _, err := io.ReadFull(conn, resp); err != nil {
+		return err
+	}
+	if resp[0] != 'S' {
+		return errors.New("server does not support TLS")
+	}
+
+	return tls.Client(conn, &tls.Config{ServerName: host, MinVersion: tls.VersionTLS12}).HandshakeContext(ctx)
+}
+
+// MoveEndpointOptions defines how the endpoint is moved to another branch.
+type MoveEndpointOptions struct {
+	// InPlace moves the endpoint by updating its branch, hence the endpoint's ID and host are preserved.
+	// Note that the API deprecated the update of the endpoint's branch, the endpoint is recreated by default.
+	InPlace bool
+}
+
+// MoveEndpoint moves the compute endpoint to the target branch and returns the moved endpoint.
+// By default, the endpoint with the same configuration is created on the target branch, and the original
+// endpoint is deleted once the new one is provisioned. Note that the endpoint's ID and host change,
+// hence the connection strings must be updated. If the original endpoint cannot be deleted, the created
+// endpoint is returned along with the error. The target branch must not have the read-write endpoint
+// if the moved endpoint is read-write. It is noop if the endpoint already belongs to the target branch.
+func (c Client) MoveEndpoint(
+	ctx context.Context, projectID, endpointID, targetBranchID string, opts MoveEndpointOptions,
+) (Endpoint, error) {
+	if err := ctx.Err(); err != nil {
+		return Endpoint{}, err
+	}
+
+	resp, err := c.GetProjectEndpoint(projectID, endpointID)
+	if err != nil {
+		return Endpoint{}, fmt.Errorf("could not read endpoint %s: %w", endpointID, err)
+	}
+	ep := resp.Endpoint
+	if ep.BranchID == targetBranchID {
+		return ep, nil
+	}
+
+	if ep.Type == EndpointTypeReadWrite {
+		target, err := c.ListProjectBranchEndpoints(projectID, targetBranchID)
+		if err != nil {
+			return Endpoint{}, fmt.Errorf("could not list endpoints of branch %s: %w", targetBranchID, err)
+		}
+		for _, el := range target.Endpoints {
+			if el.Type == EndpointTypeReadWrite {
+				return Endpoint{}, fmt.Errorf(
+					"branch %s already has the read-write endpoint %s", targetBranchID, el.ID,
+				)
+			}
+		}
+	}
+
+	if opts.InPlace {
+		return c.moveEndpointInPlace(ctx, projectID, endpointID, targetBranchID)
+	}
+
+	created, err := callUnlessLocked(
+		ctx, func() (EndpointOperations, error) {
+			return c.CreateProjectEndpoint(
+				projectID, EndpointCreateRequest{
+					Endpoint: EndpointCreateRequestEndpoint{
+						AutoscalingLimitMaxCu: &ep.AutoscalingLimitMaxCu,
+						AutoscalingLimitMinCu: &ep.AutoscalingLimitMinCu,
+						BranchID:              targetBranchID,
+						Disabled:              &ep.Disabled,
+						PasswordlessAccess:    &ep.PasswordlessAccess,
+						PoolerEnabled:         &ep.PoolerEnabled,
+						PoolerMode:            &ep.PoolerMode,
+						Provisioner:           &ep.Provisioner,
+						Settings:              &ep.Settings,
+						SuspendTimeoutSeconds: &ep.SuspendTimeoutSeconds,
+						Type:                  ep.Type,
+					},
+				},
+			)
+		},
+	)
+	if err != nil {
+		return Endpoint{}, fmt.Errorf("could not create endpoint on branch %s: %w", targetBranchID, err)
+	}
+	if err := c.WaitForOperations(ctx, projectID, created.Operations); err != nil {
+		return created.Endpoint, fmt.Errorf("endpoint %s: %w", created.Endpoint.ID, err)
+	}
+
+	deleted, err := callUnlessLocked(
+		ctx, func() (EndpointOperations, error) { return c.DeleteProjectEndpoint(projectID, endpointID) },
+	)
+	if err != nil {
+		return created.Endpoint, fmt.Errorf("could not delete endpoint %s: %w", endpointID, err)
+	}
+	if err := c.WaitForOperations(ctx, projectID, deleted.Operations); err != nil {
+		return created.Endpoint, fmt.Errorf("endpoint %s: %w", endpointID, err)
+	}
+	return created.Endpoint, nil
+}
+
+func (c Client) moveEndpointInPlace(ctx context.Context, projectID, endpointID, targetBranchID string) (Endpoint, error) {
+	resp, err := callUnlessLocked(
+		ctx, func() (EndpointOperations, error) {
+			return c.UpdateProjectEndpoint(
+				projectID, endpointID, EndpointUpdateRequest{
+					Endpoint: EndpointUpdateRequestEndpoint{BranchID: &targetBranchID},
+				},
+			)
+		},
+	)
+	if err != nil {
+		return Endpoint{}, fmt.Errorf("could not move endpoint %s to branch %s: %w", endpointID, targetBranchID, err)
+	}
+	if err := c.WaitForOperations(ctx, projectID, resp.Operations); err != nil {
+		return resp.Endpoint, fmt.Errorf("endpoint %s: %w", endpointID, err)
+	}
+	return resp.Endpoint, nil
+}
@@ -0,0 +1,281 @@
+package sdk
+
+import (
+	"context"
+	"net"
+	"net/http"
+	"sync/atomic"
+	"testing"
+	"time"
+)
+
+func newEndpointsFakeAPI() *fakeAPI {
+	var lockedCalls int32
+	return newFakeAPI().
+		on(
+			http.MethodGet, "/projects/foo/endpoints", http.StatusOK, `{"endpoints":[
+{"id":"ep-active","current_state":"active"},
+{"id":"ep-idle","current_state":"idle"}
+]}`,
+		).
+		onFunc(
+			http.MethodPost, "/projects/foo/endpoints/ep-active/suspend", func(_ *http.Request) (int, string) {
+				if atomic.AddInt32(&lockedCalls, 1) == 1 {
+					return http.StatusLocked, `{"code":"","message":"project already has running operations"}`
+				}
+				return http.StatusOK, `{"endpoint":{"id":"ep-active"},"operations":[{"id":"op1","status":"running"}]}`
+			},
+		).
+		on(
+			http.MethodPost, "/projects/foo/endpoints/ep-idle/start", http.StatusOK,
+			`{"endpoint":{"id":"ep-idle"},"operations":[{"id":"op2","status":"failed"}]}`,
+		).
+		on(
+			http.MethodGet, "/projects/foo/operations/op1", http.StatusOK,
+			`{"operation":{"id":"op1","status":"finished"}}`,
+		)
+}
+
+func TestClient_SuspendAllEndpoints(t *testing.T) {
+	api := newEndpointsFakeAPI()
+	c, err := NewClient(Config{Key: "foo", HTTPClient: api})
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if err := c.SuspendAllEndpoints(context.TODO(), "foo", 0); err != nil {
+		t.Fatal(err)
+	}
+	if n := api.called(http.MethodPost, "/projects/foo/endpoints/ep-active/suspend"); n != 2 {
+		t.Errorf("unexpected number of suspend calls: %d, want the locked call to be repeated", n)
+	}
+	if n := api.called(http.MethodPost, "/projects/foo/endpoints/ep-idle/suspend"); n != 0 {
+		t.Errorf("idle endpoint shall not be suspended")
+	}
+	if n := api.called(http.MethodGet, "/projects/foo/operations/op1"); n == 0 {
+		t.Errorf("operation shall be awaited")
+	}
+}
+
+func TestClient_StartAllEndpoints(t *testing.T) {
+	api := newEndpointsFakeAPI()
+	c, err := NewClient(Config{Key: "foo", HTTPClient: api})
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if err := c.StartAllEndpoints(context.TODO(), "foo", 1); err == nil {
+		t.Fatal("error expected because the start operation failed")
+	}
+	if n := api.called(http.MethodPost, "/projects/foo/endpoints/ep-active/start"); n != 0 {
+		t.Errorf("active endpoint shall not be started")
+	}
+}
+
+func TestClient_MoveEndpoint(t *testing.T) {
+	newAPI := func(targetEndpoints string) *fakeAPI {
+		return newFakeAPI().
+			on(
+				http.MethodGet, "/projects/foo/endpoints/ep-old", http.StatusOK,
+				`{"endpoint":{"id":"ep-old","branch_id":"br-old","type":"read_write","pooler_mode":"transaction"}}`,
+			).
+			on(
+				http.MethodGet, "/projects/foo/branches/br-new/endpoints", http.StatusOK,
+				`{"endpoints":`+targetEndpoints+`}`,
+			).
+			on(
+				http.MethodPost, "/projects/foo/endpoints", http.StatusCreated,
+				`{"endpoint":{"id":"ep-new","branch_id":"br-new","type":"read_write"},
+"operations":[{"id":"op1","status":"finished"}]}`,
+			).
+			on(
+				http.MethodDelete, "/projects/foo/endpoints/ep-old", http.StatusOK,
+				`{"endpoint":{"id":"ep-old"},"operations":[{"id":"op2","status":"finished"}]}`,
+			).
+			on(
+				http.MethodPatch, "/projects/foo/endpoints/ep-old", http.StatusOK,
+				`{"endpoint":{"id":"ep-old","branch_id":"br-new","type":"read_write"},
+"operations":[{"id":"op3","status":"finished"}]}`,
+			)
+	}
+
+	tests := []struct {
+		name            string
+		targetBranchID  string
+		targetEndpoints string
+		opts            MoveEndpointOptions
+		wantID          string
+		wantErr         bool
+		wantCreated     bool
+		wantDeleted     bool
+		wantUpdated     bool
+	}{
+		{
+			name:            "recreated on target branch",
+			targetBranchID:  "br-new",
+			targetEndpoints: `[{"id":"ep-ro","type":"read_only"}]`,
+			wantID:          "ep-new",
+			wantCreated:     true,
+			wantDeleted:     true,
+		},
+		{
+			name:            "moved in place",
+			targetBranchID:  "br-new",
+			targetEndpoints: `[]`,
+			opts:            MoveEndpointOptions{InPlace: true},
+			wantID:          "ep-old",
+			wantUpdated:     true,
+		},
+		{
+			name:           "already on target branch",
+			targetBranchID: "br-old",
+			wantID:         "ep-old",
+		},
+		{
+			name:            "target branch has read-write endpoint",
+			targetBranchID:  "br-new",
+			targetEndpoints: `[{"id":"ep-rw","type":"read_write"}]`,
+			wantErr:         true,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(
+			tt.name, func(t *testing.T) {
+				api := newAPI(tt.targetEndpoints)
+				c, err := NewClient(Config{Key: "foo", HTTPClient: api})
+				if err != nil {
+					t.Fatal(err)
+				}
+
+				got, err := c.MoveEndpoint(context.TODO(), "foo", "ep-old", tt.targetBranchID, tt.opts)
+				if (err != nil) != tt.wantErr {
+					t.Fatalf("MoveEndpoint() error = %v, wantErr %v", err, tt.wantErr)
+				}
+				if got.ID != tt.wantID {
+					t.Errorf("MoveEndpoint() got = %s, want %s", got.ID, tt.wantID)
+				}
+				if n := api.called(http.MethodPost, "/projects/foo/endpoints"); (n > 0) != tt.wantCreated {
+					t.Errorf("endpoint created = %v, want %v", n > 0, tt.wantCreated)
+				}
+				if n := api.called(http.MethodDelete, "/projects/foo/endpoints/ep-old"); (n > 0) != tt.wantDeleted {
+					t.Errorf("endpoint deleted = %v, want %v", n > 0, tt.wantDeleted)
+				}
+				if n := api.called(http.MethodPatch, "/projects/foo/endpoints/ep-old"); (n > 0) != tt.wantUpdated {
+					t.Errorf("endpoint updated = %v, want %v", n > 0, tt.wantUpdated)
+				}
+			},
+		)
+	}
+}
+
+func TestClient_WaitForEndpointState(t *testing.T) {
+	const path = "/projects/foo/endpoints/ep-foo"
+
+	listener, err := net.Listen("tcp", "127.0.0.1:0")
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer func() { _ = listener.Close() }()
+	go func() {
+		for {
+			conn, err := listener.Accept()
+			if err != nil {
+				return
+			}
+			// the server rejects the TLS connection
+			_, _ = conn.Write([]byte("N"))
+			_ = conn.Close()
+		}
+	}()
+	port := listener.Addr().(*net.TCPAddr).Port
+
+	startingEndpoint := func() *fakeAPI {
+		var calls int32
+		return newFakeAPI().onFunc(
+			http.MethodGet, path, func(_ *http.Request) (int, string) {
+				switch atomic.AddInt32(&calls, 1) {
+				case 1:
+					return http.StatusOK, `{"endpoint":{"id":"ep-foo","host":"127.0.0.1","current_state":"idle","pending_state":"active"}}`
+				case 2:
+					return http.StatusOK, `{"endpoint":{"id":"ep-foo","host":"127.0.0.1","current_state":"init","pending_state":"active"}}`
+				default:
+					return http.StatusOK, `{"endpoint":{"id":"ep-foo","host":"127.0.0.1","current_state":"active"}}`
+				}
+			},
+		)
+	}
+
+	tests := []struct {
+		name      string
+		api       *fakeAPI
+		opts      WaitForEndpointStateOptions
+		wantCalls int
+		wantErr   bool
+	}{
+		{
+			name:      "state reached after polling",
+			api:       startingEndpoint(),
+			wantCalls: 3,
+		},
+		{
+			name:      "state reached and host reachable",
+			api:       startingEndpoint(),
+			opts:      WaitForEndpointStateOptions{CheckReachability: true, Port: port},
+			wantCalls: 3,
+		},
+		{
+			name:    "unhappy path: TLS not supported",
+			api:     startingEndpoint(),
+			opts:    WaitForEndpointStateOptions{CheckReachability: true, TLS: true, Port: port},
+			wantErr: true,
+		},
+		{
+			name:    "unhappy path: endpoint not found",
+			api:     newFakeAPI(),
+			wantErr: true,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(
+			tt.name, func(t *testing.T) {
+				c, err := NewClient(Config{Key: "foo", HTTPClient: tt.api})
+				if err != nil {
+					t.Fatal(err)
+				}
+
+				ctx, cancel := context.WithTimeout(context.TODO(), 100*time.Millisecond)
+				defer cancel()
+
+				got, err := c.WaitForEndpointState(ctx, "foo", "ep-foo", EndpointStateActive, tt.opts)
+				if (err != nil) != tt.wantErr {
+					t.Fatalf("WaitForEndpointState() error = %v, wantErr %v", err, tt.wantErr)
+				}
+				if tt.wantErr {
+					return
+				}
+				if got.CurrentState != EndpointStateActive {
+					t.Errorf("WaitForEndpointState() unexpected state: %v", got.CurrentState)
+				}
+				if n := tt.api.called(http.MethodGet, path); n != tt.wantCalls {
+					t.Errorf("unexpected number of calls: %d", n)
+				}
+			},
+		)
+	}
+}
+
+func Test_checkEndpointReachability(t *testing.T) {
+	listener, err := net.Listen("tcp", "127.0.0.1:0")
+	if err != nil {
+		t.Fatal(err)
+	}
+	port := listener.Addr().(*net.TCPAddr).Port
+	_ = listener.Close()
+
+	err = checkEndpointReachability(
+		context.TODO(), "127.0.0.1", WaitForEndpointStateOptions{Port: port}.withDefaults(),
+	)
+	if err == nil {
+		t.Errorf("closed port %d must not be reachable", port)
+	}
+}
@@ -0,0 +1,70 @@
+package sdk
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"net/http"
+)
+
+// EnsureDatabase creates the database in the branch unless it exists.
+// It returns the database and the flag indicating whether the database was created.
+func (c Client) EnsureDatabase(
+	ctx context.Context, projectID, branchID, name, ownerName string,
+) (Database, bool, error) {
+	resp, err := c.GetProjectBranchDatabase(projectID, branchID, name)
+	switch {
+	case err == nil:
+		return resp.Database, false, nil
+	case !isNotFound(err):
+		return Database{}, false, fmt.Errorf("could not read database %s: %w", name, err)
+	}
+
+	if err := ctx.Err(); err != nil {
+		return Database{}, false, err
+	}
+
+	created, err := c.CreateProjectBranchDatabase(
+		projectID, branchID, DatabaseCreateRequest{
+			Database: DatabaseCreateRequestDatabase{
+				Name:      name,
+				OwnerName: ownerName,
+			},
+		},
+	)
+	if err != nil {
+		return Database{}, false, fmt.Errorf("could not create database %s: %w", name, err)
+	}
+	return created.Database, true, nil
+}
+
+// EnsureRole creates the role in the branch unless it exists.
+// It returns the role and the flag indicating whether the role was created.
+// Note that the password is only returned when the role is created.
+func (c Client) EnsureRole(ctx context.Context, projectID, branchID, name string) (Role, bool, error) {
+	resp, err := c.GetProjectBranchRole(projectID, branchID, name)
+	switch {
+	case err == nil:
+		return resp.Role, false, nil
+	case !isNotFound(err):
+		return Role{}, false, fmt.Errorf("could not read role %s: %w", name, err)
+	}
+
+	if err := ctx.Err(); err != nil {
+		return Role{}, false, err
+	}
+
+	created, err := c.CreateProjectBranchRole(
+		projectID, branchID, RoleCreateRequest{Role: RoleCreateRequestRole{Name: name}},
+	)
+	if err != nil {
+		return Role{}, false, fmt.Errorf("could not create role %s: %w", name, err)
+	}
+	return created.Role, true, nil
+}
+
+// isNotFound defines if the error is the API error caused by the missing object.
+func isNotFound(err error) bool {
+	var e Error
+	return errors.As(err, &e) && e.HTTPCode == http.StatusNotFound
+}
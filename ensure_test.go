@@ -0,0 +1,123 @@
+package sdk
+
+import (
+	"context"
+	"net/http"
+	"testing"
+)
+
+func TestClient_EnsureDatabase(t *testing.T) {
+	const path = "/projects/foo/branches/br-foo/databases"
+
+	tests := []struct {
+		name        string
+		api         *fakeAPI
+		wantCreated bool
+		wantErr     bool
+	}{
+		{
+			name: "database exists",
+			api: newFakeAPI().on(
+				http.MethodGet, path+"/mydb", http.StatusOK, `{"database":{"id":1,"name":"mydb","owner_name":"bar"}}`,
+			),
+			wantCreated: false,
+		},
+		{
+			name: "database created",
+			api: newFakeAPI().on(
+				http.MethodPost, path, http.StatusCreated,
+				`{"database":{"id":1,"name":"mydb","owner_name":"bar"},"operations":[]}`,
+			),
+			wantCreated: true,
+		},
+		{
+			name: "unhappy path: unexpected error",
+			api: newFakeAPI().on(
+				http.MethodGet, path+"/mydb", http.StatusInternalServerError, `{"message":"internal"}`,
+			),
+			wantErr: true,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(
+			tt.name, func(t *testing.T) {
+				c, err := NewClient(Config{Key: "foo", HTTPClient: tt.api})
+				if err != nil {
+					t.Fatal(err)
+				}
+
+				got, created, err := c.EnsureDatabase(context.TODO(), "foo", "br-foo", "mydb", "bar")
+				if (err != nil) != tt.wantErr {
+					t.Fatalf("EnsureDatabase() error = %v, wantErr %v", err, tt.wantErr)
+				}
+				if tt.wantErr {
+					return
+				}
+				if created != tt.wantCreated {
+					t.Errorf("EnsureDatabase() created = %v, want %v", created, tt.wantCreated)
+				}
+				if got.Name != "mydb" {
+					t.Errorf("EnsureDatabase() unexpected database: %v", got)
+				}
+				if n := tt.api.called(http.MethodPost, path); (n == 1) != tt.wantCreated {
+					t.Errorf("unexpected number of create calls: %d", n)
+				}
+			},
+		)
+	}
+}
+
+func TestClient_EnsureRole(t *testing.T) {
+	const path = "/projects/foo/branches/br-foo/roles"
+
+	tests := []struct {
+		name        string
+		api         *fakeAPI
+		wantCreated bool
+		wantErr     bool
+	}{
+		{
+			name:        "role exists",
+			api:         newFakeAPI().on(http.MethodGet, path+"/bar", http.StatusOK, `{"role":{"name":"bar"}}`),
+			wantCreated: false,
+		},
+		{
+			name: "role created",
+			api: newFakeAPI().on(
+				http.MethodPost, path, http.StatusCreated, `{"role":{"name":"bar","password":"qux"},"operations":[]}`,
+			),
+			wantCreated: true,
+		},
+		{
+			name: "unhappy path: role cannot be created",
+			api: newFakeAPI().on(
+				http.MethodPost, path, http.StatusUnprocessableEntity, `{"message":"invalid name"}`,
+			),
+			wantErr: true,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(
+			tt.name, func(t *testing.T) {
+				c, err := NewClient(Config{Key: "foo", HTTPClient: tt.api})
+				if err != nil {
+					t.Fatal(err)
+				}
+
+				got, created, err := c.EnsureRole(context.TODO(), "foo", "br-foo", "bar")
+				if (err != nil) != tt.wantErr {
+					t.Fatalf("EnsureRole() error = %v, wantErr %v", err, tt.wantErr)
+				}
+				if tt.wantErr {
+					return
+				}
+				if created != tt.wantCreated {
+					t.Errorf("EnsureRole() created = %v, want %v", created, tt.wantCreated)
+				}
+				if got.Name != "bar" {
+					t.Errorf("EnsureRole() unexpected role: %v", got)
+				}
+			},
+		)
+	}
+}
@@ -5,17 +5,29 @@ import (
 	"encoding/json"
 	"io"
 	"net/http"
+	"sort"
 	"strconv"
+	"strings"
 )
 
 // Error API error.
+// It unwraps to the error of reading the response body, e.g. ErrResponseTooLarge.
 type Error struct {
 	HTTPCode int
 	errorResp
+	err error
 }
 
 func (e Error) Error() string {
-	return "[HTTP Code: " + strconv.Itoa(e.HTTPCode) + "][Error Code: " + e.Code + "] " + e.Message
+	msg := "[HTTP Code: " + strconv.Itoa(e.HTTPCode) + "][Error Code: " + e.Code + "] " + e.Message
+	if e.err != nil {
+		msg += ": " + e.err.Error()
+	}
+	return msg
+}
+
+func (e Error) Unwrap() error {
+	return e.err
 }
 
 func (e Error) httpResp() *http.Response {
@@ -28,6 +40,30 @@ func (e Error) httpResp() *http.Response {
 	}
 }
 
+// ValidationError the API error with the status code 422 Unprocessable Entity which carries
+// the errors of the request's fields, e.g. to highlight the invalid fields in the UI. It unwraps to Error.
+type ValidationError struct {
+	// Fields the errors keyed by the field's name.
+	Fields map[string][]string
+	err    Error
+}
+
+func (e *ValidationError) Error() string {
+	fields := make([]string, 0, len(e.Fields))
+	for k := range e.Fields {
+		fields = append(fields, k)
+	}
+	sort.Strings(fields)
+	for i, k := range fields {
+		fields[i] = k + ": " + strings.Join(e.Fields[k], ", ")
+	}
+	return e.err.Error() + " (" + strings.Join(fields, "; ") + ")"
+}
+
+func (e *ValidationError) Unwrap() error {
+	return e.err
+}
+
 type errorResp struct {
 	Code    string `json:"code"`
 	Message string `json:"message"`
@@ -43,6 +79,7 @@ func convertErrorResponse(res *http.Response) error {
 			errorResp: errorResp{
 				Message: "cannot read response bytes",
 			},
+			err: err,
 		}
 	}
 	if err := json.Unmarshal(buf, &v); err != nil {
@@ -53,8 +90,52 @@ func convertErrorResponse(res *http.Response) error {
 			},
 		}
 	}
-	return Error{
+	e := Error{
 		HTTPCode:  res.StatusCode,
 		errorResp: v,
 	}
+	if res.StatusCode == http.StatusUnprocessableEntity {
+		if fields := parseFieldErrors(buf); len(fields) > 0 {
+			return &ValidationError{Fields: fields, err: e}
+		}
+	}
+	return e
+}
+
+// parseFieldErrors extracts the errors of the request's fields defined either as the object keyed by the field,
+// e.g. {"errors":{"name":["too long"]}}, or as the list, e.g. {"errors":[{"field":"name","message":"too long"}]}.
+func parseFieldErrors(b []byte) map[string][]string {
+	var v struct {
+		Errors json.RawMessage `json:"errors"`
+	}
+	if err := json.Unmarshal(b, &v); err != nil || len(v.Errors) == 0 {
+		return nil
+	}
+
+	o := map[string][]string{}
+	var byField map[string]json.RawMessage
+	var list []struct {
+		Field   string `json:"field"`
+		Message string `json:"message"`
+	}
+	switch {
+	case json.Unmarshal(v.Errors, &byField) == nil:
+		for k, raw := range byField {
+			var messages []string
+			var message string
+			switch {
+			case json.Unmarshal(raw, &messages) == nil:
+				o[k] = append(o[k], messages...)
+			case json.Unmarshal(raw, &message) == nil:
+				o[k] = append(o[k], message)
+			}
+		}
+	case json.Unmarshal(v.Errors, &list) == nil:
+		for _, e := range list {
+			if e.Field != "" {
+				o[e.Field] = append(o[e.Field], e.Message)
+			}
+		}
+	}
+	return o
 }
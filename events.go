@@ -0,0 +1,157 @@
+package sdk
+
+import (
+	"encoding/json"
+	"net/http"
+	"net/url"
+	"strings"
+	"time"
+)
+
+// EventType the type of the lifecycle event.
+type EventType string
+
+const (
+	EventProjectCreated  EventType = "project_created"
+	EventProjectDeleted  EventType = "project_deleted"
+	EventBranchCreated   EventType = "branch_created"
+	EventBranchDeleted   EventType = "branch_deleted"
+	EventOperationFailed EventType = "operation_failed"
+)
+
+// Event the lifecycle event emitted after the successful API call.
+type Event struct {
+	Type EventType `json:"type"`
+	// Time the time the event was emitted.
+	Time        time.Time `json:"time"`
+	ProjectID   string    `json:"project_id,omitempty"`
+	BranchID    string    `json:"branch_id,omitempty"`
+	OperationID string    `json:"operation_id,omitempty"`
+	// Method the HTTP method of the call which caused the event.
+	Method string `json:"method"`
+	// URL the URL of the call which caused the event.
+	URL string `json:"url"`
+}
+
+// EventSink receives the lifecycle events, e.g. to publish the audit trail.
+// Emit is called synchronously after every successful API call causing the events,
+// hence the sink shall not block.
+type EventSink interface {
+	Emit(e Event)
+}
+
+// EventSinkFunc the function implementing EventSink.
+type EventSinkFunc func(e Event)
+
+// Emit calls f(e).
+func (f EventSinkFunc) Emit(e Event) {
+	f(e)
+}
+
+// eventsPayload the attributes of the response defining the lifecycle events.
+type eventsPayload struct {
+	Project *struct {
+		ID string `json:"id"`
+	} `json:"project"`
+	Branch *struct {
+		ID        string `json:"id"`
+		ProjectID string `json:"project_id"`
+	} `json:"branch"`
+	Operation  *eventsOperation  `json:"operation"`
+	Operations []eventsOperation `json:"operations"`
+}
+
+type eventsOperation struct {
+	ID        string `json:"id"`
+	ProjectID string `json:"project_id"`
+	BranchID  string `json:"branch_id"`
+	Status    string `json:"status"`
+}
+
+// eventRoute defines the event caused by the call of the route given the response and the route's path elements.
+type eventRoute func(p eventsPayload, route []string) (t EventType, projectID, branchID string, ok bool)
+
+// eventRoutes the routes causing the lifecycle events keyed by the method and the route, see eventsRouteKey.
+var eventRoutes = map[string]eventRoute{
+	http.MethodPost + " projects": func(p eventsPayload, _ []string) (EventType, string, string, bool) {
+		if p.Project == nil {
+			return "", "", "", false
+		}
+		return EventProjectCreated, p.Project.ID, "", true
+	},
+	http.MethodDelete + " projects/*": func(_ eventsPayload, route []string) (EventType, string, string, bool) {
+		return EventProjectDeleted, route[1], "", true
+	},
+	http.MethodPost + " projects/*/branches": func(p eventsPayload, route []string) (EventType, string, string, bool) {
+		if p.Branch == nil {
+			return "", "", "", false
+		}
+		return EventBranchCreated, route[1], p.Branch.ID, true
+	},
+	http.MethodDelete + " projects/*/branches/*": func(_ eventsPayload, route []string) (EventType, string, string, bool) {
+		return EventBranchDeleted, route[1], route[3], true
+	},
+}
+
+// emitEvents emits the events caused by the successful call to the sink.
+func emitEvents(sink EventSink, method, u string, body []byte) {
+	if sink == nil {
+		return
+	}
+
+	var p eventsPayload
+	if len(body) > 0 {
+		_ = json.Unmarshal(body, &p)
+	}
+
+	now := time.Now().UTC()
+	newEvent := func(t EventType, projectID, branchID string) Event {
+		return Event{Type: t, Time: now, ProjectID: projectID, BranchID: branchID, Method: method, URL: u}
+	}
+
+	route := eventsRoute(u)
+	if emit, ok := eventRoutes[eventsRouteKey(method, route)]; ok {
+		if t, projectID, branchID, ok := emit(p, route); ok {
+			sink.Emit(newEvent(t, projectID, branchID))
+		}
+	}
+
+	ops := p.Operations
+	if p.Operation != nil {
+		ops = append(ops, *p.Operation)
+	}
+	for _, op := range ops {
+		if op.Status == "failed" {
+			e := newEvent(EventOperationFailed, op.ProjectID, op.BranchID)
+			e.OperationID = op.ID
+			sink.Emit(e)
+		}
+	}
+}
+
+// eventsRouteKey returns the key of the route in eventRoutes: the method followed by the route
+// with the resources' IDs, i.e. every second element, replaced by "*", e.g. "DELETE projects/*".
+func eventsRouteKey(method string, route []string) string {
+	o := make([]string, len(route))
+	for i, el := range route {
+		if i%2 == 1 {
+			el = "*"
+		}
+		o[i] = el
+	}
+	return method + " " + strings.Join(o, "/")
+}
+
+// eventsRoute returns the elements of the URL's path relative to the API's base URL.
+func eventsRoute(u string) []string {
+	v, err := url.Parse(u)
+	if err != nil {
+		return nil
+	}
+	base, _ := url.Parse(baseURL)
+	p := strings.Trim(strings.TrimPrefix(v.Path, base.Path), "/")
+	if p == "" {
+		return nil
+	}
+	return strings.Split(p, "/")
+}
@@ -0,0 +1,99 @@
+package sdk
+
+import (
+	"io"
+	"net/http"
+	"reflect"
+	"strings"
+	"testing"
+	"time"
+)
+
+type eventsTestHTTPClient struct {
+	body string
+}
+
+func (c eventsTestHTTPClient) Do(req *http.Request) (*http.Response, error) {
+	return &http.Response{
+		StatusCode: http.StatusOK,
+		Body:       io.NopCloser(strings.NewReader(c.body)),
+		Request:    req,
+	}, nil
+}
+
+func TestClient_requestHandler_EventSink(t *testing.T) {
+	tests := []struct {
+		name       string
+		method     string
+		path       string
+		body       string
+		wantEvents []Event
+	}{
+		{
+			name:       "project created",
+			method:     http.MethodPost,
+			path:       "/projects",
+			body:       `{"project":{"id":"foo"},"operations":[{"id":"op1","project_id":"foo","status":"running"}]}`,
+			wantEvents: []Event{{Type: EventProjectCreated, ProjectID: "foo"}},
+		},
+		{
+			name:       "project deleted",
+			method:     http.MethodDelete,
+			path:       "/projects/foo",
+			body:       `{"project":{"id":"foo"}}`,
+			wantEvents: []Event{{Type: EventProjectDeleted, ProjectID: "foo"}},
+		},
+		{
+			name:       "branch created",
+			method:     http.MethodPost,
+			path:       "/projects/foo/branches",
+			body:       `{"branch":{"id":"br-foo","project_id":"foo"},"operations":[]}`,
+			wantEvents: []Event{{Type: EventBranchCreated, ProjectID: "foo", BranchID: "br-foo"}},
+		},
+		{
+			name:   "branch deleted with failed operation",
+			method: http.MethodDelete,
+			path:   "/projects/foo/branches/br-foo",
+			body: `{"branch":{"id":"br-foo","project_id":"foo"},
+"operations":[{"id":"op1","project_id":"foo","branch_id":"br-foo","status":"failed"}]}`,
+			wantEvents: []Event{
+				{Type: EventBranchDeleted, ProjectID: "foo", BranchID: "br-foo"},
+				{Type: EventOperationFailed, ProjectID: "foo", BranchID: "br-foo", OperationID: "op1"},
+			},
+		},
+		{
+			name:   "no events",
+			method: http.MethodGet,
+			path:   "/projects/foo",
+			body:   `{"project":{"id":"foo"}}`,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(
+			tt.name, func(t *testing.T) {
+				var got []Event
+				sink := EventSinkFunc(func(e Event) {
+					if e.Time.IsZero() || e.Method != tt.method || !strings.HasSuffix(e.URL, tt.path) {
+						t.Errorf("unexpected event's call details: %v", e)
+					}
+					e.Time, e.Method, e.URL = time.Time{}, "", ""
+					got = append(got, e)
+				})
+
+				c, err := NewClient(Config{Key: "foo", HTTPClient: eventsTestHTTPClient{body: tt.body}, EventSink: sink})
+				if err != nil {
+					t.Fatal(err)
+				}
+
+				var v map[string]interface{}
+				if err := c.requestHandler(c.baseURL+tt.path, tt.method, nil, &v); err != nil {
+					t.Fatal(err)
+				}
+
+				if !reflect.DeepEqual(got, tt.wantEvents) {
+					t.Errorf("unexpected events: %v, want %v", got, tt.wantEvents)
+				}
+			},
+		)
+	}
+}
@@ -0,0 +1,80 @@
+package sdk
+
+import (
+	"io"
+	"net/http"
+	"net/url"
+	"strings"
+	"sync"
+	"time"
+)
+
+func init() {
+	// the helpers awaiting the operations shall not slow down the tests
+	operationsPollInterval = time.Millisecond
+	maintenanceRetryInterval = time.Millisecond
+}
+
+// fakeAPI programmable HTTP client to test the helpers built on top of the generated methods.
+// Handlers are registered per request method and path, the query is not considered.
+type fakeAPI struct {
+	mu       sync.Mutex
+	handlers map[string]func(req *http.Request) (int, string)
+	calls    []string
+}
+
+func newFakeAPI() *fakeAPI {
+	return &fakeAPI{handlers: map[string]func(req *http.Request) (int, string){}}
+}
+
+// on registers the static response for the method and path.
+func (f *fakeAPI) on(method, path string, code int, body string) *fakeAPI {
+	return f.onFunc(
+		method, path, func(_ *http.Request) (int, string) {
+			return code, body
+		},
+	)
+}
+
+// onFunc registers the handler for the method and path.
+func (f *fakeAPI) onFunc(method, path string, fn func(req *http.Request) (int, string)) *fakeAPI {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+	f.handlers[method+" "+path] = fn
+	return f
+}
+
+// called returns the number of calls to the method and path.
+func (f *fakeAPI) called(method, path string) int {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+	var o int
+	for _, c := range f.calls {
+		if c == method+" "+path {
+			o++
+		}
+	}
+	return o
+}
+
+func (f *fakeAPI) Do(req *http.Request) (*http.Response, error) {
+	u, _ := url.Parse(baseURL)
+	k := req.Method + " " + strings.TrimPrefix(req.URL.Path, u.Path)
+
+	f.mu.Lock()
+	f.calls = append(f.calls, k)
+	fn, ok := f.handlers[k]
+	f.mu.Unlock()
+
+	code, body := http.StatusNotFound, `{"code":"","message":"not found"}`
+	if ok {
+		code, body = fn(req)
+	}
+
+	return &http.Response{
+		StatusCode:    code,
+		Body:          io.NopCloser(strings.NewReader(body)),
+		ContentLength: int64(len(body)),
+		Request:       req,
+	}, nil
+}
@@ -0,0 +1,157 @@
+package sdk
+
+import (
+	"bytes"
+	"errors"
+	"io"
+	"math/rand"
+	"net/http"
+	"sync"
+	"syscall"
+)
+
+// ErrInjectedFault the error matching the failures injected by the fault injection client.
+var ErrInjectedFault = errors.New("injected fault")
+
+// Fault the kind of the failure injected by the fault injection client.
+type Fault string
+
+const (
+	// FaultTimeout the request fails with the timeout error, it implements net.Error.
+	FaultTimeout Fault = "timeout"
+	// FaultReset the request fails with the connection reset error matching syscall.ECONNRESET.
+	FaultReset Fault = "reset"
+	// FaultMalformedJSON the request is sent and the response body is replaced with malformed JSON.
+	FaultMalformedJSON Fault = "malformed_json"
+	// FaultPartialBody the request is sent and the response body is truncated,
+	// reading it fails with io.ErrUnexpectedEOF.
+	FaultPartialBody Fault = "partial_body"
+)
+
+// FaultInjectionConfig defines the failures injected by the fault injection client.
+type FaultInjectionConfig struct {
+	// Probability the probability in [0, 1] of the request's failure.
+	Probability float64
+	// Faults the kinds of the failures selected at random with equal probability, all kinds are used if empty.
+	Faults []Fault
+	// Seed the seed of the random generator to reproduce the failures.
+	Seed int64
+}
+
+// NewFaultInjectionClient wraps the HTTP client to inject the failures at random for the chaos testing,
+// e.g. to verify the retries and waiters. The default HTTP client is wrapped if client is nil.
+func NewFaultInjectionClient(client HTTPClient, cfg FaultInjectionConfig) HTTPClient {
+	if client == nil {
+		client = &http.Client{Timeout: defaultTimeout}
+	}
+	faults := cfg.Faults
+	if len(faults) == 0 {
+		faults = []Fault{FaultTimeout, FaultReset, FaultMalformedJSON, FaultPartialBody}
+	}
+	return &faultInjectionClient{
+		client:      client,
+		probability: cfg.Probability,
+		faults:      faults,
+		rnd:         rand.New(rand.NewSource(cfg.Seed)),
+	}
+}
+
+type faultInjectionClient struct {
+	client      HTTPClient
+	probability float64
+	faults      []Fault
+
+	mu  sync.Mutex
+	rnd *rand.Rand
+}
+
+func (f *faultInjectionClient) Do(req *http.Request) (*http.Response, error) {
+	fault, ok := f.next()
+	if !ok {
+		return f.client.Do(req)
+	}
+
+	switch fault {
+	case FaultTimeout:
+		return nil, &faultError{fault: fault, timeout: true}
+	case FaultReset:
+		return nil, &faultError{fault: fault, err: syscall.ECONNRESET}
+	}
+
+	resp, err := f.client.Do(req)
+	if err != nil {
+		return nil, err
+	}
+
+	body, err := io.ReadAll(resp.Body)
+	_ = resp.Body.Close()
+	if err != nil {
+		return nil, err
+	}
+
+	switch fault {
+	case FaultMalformedJSON:
+		body = append([]byte(`{"malformed":`), body...)
+		resp.Body = io.NopCloser(bytes.NewReader(body))
+	case FaultPartialBody:
+		resp.Body = io.NopCloser(
+			io.MultiReader(
+				bytes.NewReader(body[:len(body)/2]),
+				&faultReader{err: &faultError{fault: fault, err: io.ErrUnexpectedEOF}},
+			),
+		)
+	}
+	resp.ContentLength = int64(len(body))
+	return resp, nil
+}
+
+// next defines if the request shall fail and selects the failure.
+func (f *faultInjectionClient) next() (Fault, bool) {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+	if f.rnd.Float64() >= f.probability {
+		return "", false
+	}
+	return f.faults[f.rnd.Intn(len(f.faults))], true
+}
+
+// faultError the injected failure, it matches ErrInjectedFault and the cause with errors.Is.
+type faultError struct {
+	fault   Fault
+	timeout bool
+	err     error
+}
+
+func (e *faultError) Error() string {
+	msg := ErrInjectedFault.Error() + ": " + string(e.fault)
+	if e.err != nil {
+		msg += ": " + e.err.Error()
+	}
+	return msg
+}
+
+func (e *faultError) Is(target error) bool {
+	return target == ErrInjectedFault
+}
+
+func (e *faultError) Unwrap() error {
+	return e.err
+}
+
+// Timeout implements net.Error.
+func (e *faultError) Timeout() bool {
+	return e.timeout
+}
+
+// Temporary implements net.Error.
+func (e *faultError) Temporary() bool {
+	return true
+}
+
+type faultReader struct {
+	err error
+}
+
+func (r *faultReader) Read(_ []byte) (int, error) {
+	return 0, r.err
+}
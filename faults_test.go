@@ -0,0 +1,73 @@
+package sdk
+
+import (
+	"errors"
+	"net"
+	"net/http"
+	"syscall"
+	"testing"
+)
+
+func TestNewFaultInjectionClient(t *testing.T) {
+	tests := []struct {
+		name    string
+		cfg     FaultInjectionConfig
+		wantErr func(err error) bool
+	}{
+		{
+			name:    "no faults injected",
+			cfg:     FaultInjectionConfig{Probability: 0},
+			wantErr: func(err error) bool { return err == nil },
+		},
+		{
+			name: "timeout",
+			cfg:  FaultInjectionConfig{Probability: 1, Faults: []Fault{FaultTimeout}},
+			wantErr: func(err error) bool {
+				var e net.Error
+				return errors.Is(err, ErrInjectedFault) && errors.As(err, &e) && e.Timeout()
+			},
+		},
+		{
+			name: "connection reset",
+			cfg:  FaultInjectionConfig{Probability: 1, Faults: []Fault{FaultReset}},
+			wantErr: func(err error) bool {
+				return errors.Is(err, ErrInjectedFault) && errors.Is(err, syscall.ECONNRESET)
+			},
+		},
+		{
+			name: "malformed JSON",
+			cfg:  FaultInjectionConfig{Probability: 1, Faults: []Fault{FaultMalformedJSON}},
+			wantErr: func(err error) bool {
+				var e Error
+				return err != nil && !errors.As(err, &e)
+			},
+		},
+		{
+			name: "partial body",
+			cfg:  FaultInjectionConfig{Probability: 1, Faults: []Fault{FaultPartialBody}},
+			wantErr: func(err error) bool {
+				return errors.Is(err, ErrInjectedFault)
+			},
+		},
+		{
+			name:    "any fault",
+			cfg:     FaultInjectionConfig{Probability: 1, Seed: 42},
+			wantErr: func(err error) bool { return err != nil },
+		},
+	}
+	for _, tt := range tests {
+		t.Run(
+			tt.name, func(t *testing.T) {
+				api := newFakeAPI().on(http.MethodGet, "/projects/foo", http.StatusOK, `{"project":{"id":"foo"}}`)
+				c, err := NewClient(Config{Key: "foo", HTTPClient: NewFaultInjectionClient(api, tt.cfg)})
+				if err != nil {
+					t.Fatal(err)
+				}
+
+				if _, err := c.GetProject("foo"); !tt.wantErr(err) {
+					t.Errorf("GetProject() unexpected error = %v", err)
+				}
+			},
+		)
+	}
+}
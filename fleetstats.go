@@ -0,0 +1,179 @@
+package sdk
+
+import (
+	"context"
+	"fmt"
+	"strings"
+	"sync"
+	"time"
+)
+
+// FleetCounts the number of resources of the projects.
+type FleetCounts struct {
+	Projects          int `json:"projects"`
+	Branches          int `json:"branches"`
+	ProtectedBranches int `json:"protected_branches"`
+	ArchivedBranches  int `json:"archived_branches"`
+	Endpoints         int `json:"endpoints"`
+}
+
+func (c *FleetCounts) add(v FleetCounts) {
+	c.Projects += v.Projects
+	c.Branches += v.Branches
+	c.ProtectedBranches += v.ProtectedBranches
+	c.ArchivedBranches += v.ArchivedBranches
+	c.Endpoints += v.Endpoints
+}
+
+// FleetStatsReport the resources' counts of the projects in scope.
+type FleetStatsReport struct {
+	GeneratedAt time.Time `json:"generated_at"`
+	// Totals the counts of all projects in scope.
+	Totals FleetCounts `json:"totals"`
+	// Organizations the counts per organization's ID, the projects which do not belong to an organization
+	// are counted under the empty key.
+	Organizations map[string]FleetCounts `json:"organizations"`
+}
+
+// FleetStats aggregates the counts of projects, branches and endpoints, and caches the reports.
+type FleetStats struct {
+	client Client
+	ttl    time.Duration
+	opts   BatchOptions
+
+	mu    sync.Mutex
+	cache map[string]FleetStatsReport
+}
+
+// NewFleetStats initialises the FleetStats. The reports are cached for the ttl, they are not cached
+// if the ttl is not positive. The options define the concurrency of the per-project calls.
+func NewFleetStats(c Client, ttl time.Duration, opts BatchOptions) *FleetStats {
+	return &FleetStats{client: c, ttl: ttl, opts: opts, cache: map[string]FleetStatsReport{}}
+}
+
+// Stats returns the counts of the projects in scope from the cache, or collects them. The branches
+// and the endpoints of the projects are listed concurrently. Note that the scope's sorting is ignored.
+func (s *FleetStats) Stats(ctx context.Context, scope ListAllProjectsOptions) (FleetStatsReport, error) {
+	k := fleetStatsKey(scope)
+
+	s.mu.Lock()
+	v, ok := s.cache[k]
+	s.mu.Unlock()
+	if ok && time.Since(v.GeneratedAt) < s.ttl {
+		return v, nil
+	}
+
+	o, err := s.collect(ctx, scope)
+	if err != nil {
+		return FleetStatsReport{}, err
+	}
+
+	if s.ttl > 0 {
+		s.mu.Lock()
+		s.cache[k] = o
+		s.mu.Unlock()
+	}
+	return o, nil
+}
+
+// Invalidate removes all reports from the cache.
+func (s *FleetStats) Invalidate() {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	s.cache = map[string]FleetStatsReport{}
+}
+
+func (s *FleetStats) collect(ctx context.Context, scope ListAllProjectsOptions) (FleetStatsReport, error) {
+	o := FleetStatsReport{
+		GeneratedAt:   time.Now(),
+		Organizations: map[string]FleetCounts{},
+	}
+
+	var mu sync.Mutex
+	err := s.client.ForEachProject(
+		ctx, scope, s.opts, func(ctx context.Context, p ProjectListItem) error {
+			v, err := s.client.projectCounts(ctx, p.ID)
+			if err != nil {
+				return err
+			}
+
+			var orgID string
+			if p.OrgID != nil {
+				orgID = *p.OrgID
+			}
+
+			mu.Lock()
+			defer mu.Unlock()
+			o.Totals.add(v)
+			org := o.Organizations[orgID]
+			org.add(v)
+			o.Organizations[orgID] = org
+			return nil
+		},
+	)
+	if err != nil {
+		return FleetStatsReport{}, err
+	}
+	return o, nil
+}
+
+// projectCounts lists the project's branches and endpoints concurrently and counts them.
+func (c Client) projectCounts(ctx context.Context, projectID string) (FleetCounts, error) {
+	o := FleetCounts{Projects: 1}
+	err := forEach(
+		ctx, 2, 2, func(ctx context.Context, i int) error {
+			if err := ctx.Err(); err != nil {
+				return err
+			}
+			switch i {
+			case 0:
+				resp, err := c.ListProjectBranches(projectID, nil)
+				if err != nil {
+					return fmt.Errorf("could not list branches of project %s: %w", projectID, err)
+				}
+				o.Branches = len(resp.Branches)
+				for _, b := range resp.Branches {
+					if b.Protected {
+						o.ProtectedBranches++
+					}
+					if b.CurrentState == BranchStateArchived {
+						o.ArchivedBranches++
+					}
+				}
+			default:
+				resp, err := c.ListProjectEndpoints(projectID)
+				if err != nil {
+					return fmt.Errorf("could not list endpoints of project %s: %w", projectID, err)
+				}
+				o.Endpoints = len(resp.Endpoints)
+			}
+			return nil
+		},
+	)
+	return o, err
+}
+
+// fleetStatsKey the cache key of the scope, the sorting does not affect the counts, hence it is not considered.
+func fleetStatsKey(scope ListAllProjectsOptions) string {
+	var o strings.Builder
+	if scope.Search != nil {
+		o.WriteString("search=" + *scope.Search)
+	}
+	if scope.OrgID != nil {
+		o.WriteString("|org_id=" + *scope.OrgID)
+	}
+	f := scope.Filter
+	if f.Name != nil {
+		o.WriteString("|name=" + f.Name.String())
+	}
+	if len(f.RegionIDs) > 0 {
+		o.WriteString("|regions=" + strings.Join(f.RegionIDs, ","))
+	}
+	if !f.CreatedFrom.IsZero() {
+		o.WriteString("|from=" + f.CreatedFrom.UTC().Format(time.RFC3339Nano))
+	}
+	if !f.CreatedTo.IsZero() {
+		o.WriteString("|to=" + f.CreatedTo.UTC().Format(time.RFC3339Nano))
+	}
+	return o.String()
+}
@@ -0,0 +1,94 @@
+package sdk
+
+import (
+	"context"
+	"net/http"
+	"reflect"
+	"testing"
+	"time"
+)
+
+func TestFleetStats_Stats(t *testing.T) {
+	api := newFakeAPI().
+		on(
+			http.MethodGet, "/projects", http.StatusOK,
+			`{"projects":[{"id":"foo","org_id":"org-foo"},{"id":"bar","org_id":"org-foo"},{"id":"baz"}]}`,
+		).
+		on(
+			http.MethodGet, "/projects/foo/branches", http.StatusOK, `{"branches":[
+{"id":"br-main","protected":true,"current_state":"ready"},
+{"id":"br-old","current_state":"archived"}
+]}`,
+		).
+		on(http.MethodGet, "/projects/foo/endpoints", http.StatusOK, `{"endpoints":[{"id":"ep-foo"}]}`).
+		on(
+			http.MethodGet, "/projects/bar/branches", http.StatusOK,
+			`{"branches":[{"id":"br-main","protected":true,"current_state":"ready"}]}`,
+		).
+		on(http.MethodGet, "/projects/bar/endpoints", http.StatusOK, `{"endpoints":[{"id":"ep-bar"},{"id":"ep-ro"}]}`).
+		on(
+			http.MethodGet, "/projects/baz/branches", http.StatusOK,
+			`{"branches":[{"id":"br-main","current_state":"ready"}]}`,
+		).
+		on(http.MethodGet, "/projects/baz/endpoints", http.StatusOK, `{"endpoints":[]}`)
+
+	c, err := NewClient(Config{Key: "foo", HTTPClient: api})
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	s := NewFleetStats(*c, time.Hour, BatchOptions{Parallelism: 2})
+	got, err := s.Stats(context.TODO(), ListAllProjectsOptions{})
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	wantTotals := FleetCounts{Projects: 3, Branches: 4, ProtectedBranches: 2, ArchivedBranches: 1, Endpoints: 3}
+	if got.Totals != wantTotals {
+		t.Errorf("unexpected totals: %+v, want %+v", got.Totals, wantTotals)
+	}
+	wantOrgs := map[string]FleetCounts{
+		"org-foo": {Projects: 2, Branches: 3, ProtectedBranches: 2, ArchivedBranches: 1, Endpoints: 3},
+		"":        {Projects: 1, Branches: 1},
+	}
+	if !reflect.DeepEqual(got.Organizations, wantOrgs) {
+		t.Errorf("unexpected organizations' counts: %+v, want %+v", got.Organizations, wantOrgs)
+	}
+
+	if _, err := s.Stats(context.TODO(), ListAllProjectsOptions{SortBy: ProjectsSortByName}); err != nil {
+		t.Fatal(err)
+	}
+	if n := api.called(http.MethodGet, "/projects"); n != 1 {
+		t.Errorf("cached report is expected to be returned, projects listed %d times", n)
+	}
+
+	s.Invalidate()
+	if _, err := s.Stats(context.TODO(), ListAllProjectsOptions{}); err != nil {
+		t.Fatal(err)
+	}
+	if n := api.called(http.MethodGet, "/projects"); n != 2 {
+		t.Errorf("report is expected to be collected after invalidation, projects listed %d times", n)
+	}
+}
+
+func TestFleetStats_Stats_error(t *testing.T) {
+	api := newFakeAPI().
+		on(http.MethodGet, "/projects", http.StatusOK, `{"projects":[{"id":"foo"}]}`).
+		on(http.MethodGet, "/projects/foo/branches", http.StatusOK, `{"branches":[]}`).
+		on(http.MethodGet, "/projects/foo/endpoints", http.StatusInternalServerError, `{"code":"","message":"foo"}`)
+
+	c, err := NewClient(Config{Key: "foo", HTTPClient: api})
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	s := NewFleetStats(*c, time.Hour, BatchOptions{})
+	for i := 0; i < 2; i++ {
+		if _, err := s.Stats(context.TODO(), ListAllProjectsOptions{}); err == nil {
+			t.Fatal("error is expected")
+		}
+	}
+	if n := api.called(http.MethodGet, "/projects"); n != 2 {
+		t.Errorf("failed collection is not expected to be cached, projects listed %d times", n)
+	}
+}
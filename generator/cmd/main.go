@@ -1,20 +1,72 @@
 package main
 
 import (
+	"context"
+	"encoding/json"
 	"flag"
 	"log"
 	"os"
+	"strconv"
+	"strings"
+	"time"
 
 	"github.com/kislerdm/neon-sdk-go/generator"
 )
 
 func main() {
-	var outputDir, inputPath string
-	flag.StringVar(&inputPath, "input", "", "path to the input openAPI spec JSON file [required].")
+	var (
+		outputDir, inputPath, includeTags, excludePaths, regionsPath, apiURL string
+		mockExamplesPath, recordParams                                       string
+		embedTimestamp, refreshRegions, recordMockExamples, typeCheckOnly    bool
+		optionalFields                                                       bool
+	)
+	flag.StringVar(&inputPath, "input", "", "path to the input openAPI spec JSON, or YAML file [required].")
 	flag.StringVar(&outputDir, "output", "", "directory to store the output [required].")
+	flag.StringVar(
+		&includeTags, "include-tags", "", "comma-separated tags of the endpoints to generate, all if not set.",
+	)
+	flag.StringVar(
+		&excludePaths, "exclude-paths", "",
+		"comma-separated routes of the endpoints to skip, the route ending with '*' defines the prefix.",
+	)
+	flag.BoolVar(
+		&embedTimestamp, "embed-timestamp", false,
+		"embed the generation timestamp, SOURCE_DATE_EPOCH is used if set, the current time otherwise.",
+	)
+	flag.StringVar(
+		&regionsPath, "regions", "",
+		"path to the JSON response of the active regions' endpoint to generate the regions subpackage from.",
+	)
+	flag.BoolVar(
+		&refreshRegions, "refresh-regions", false,
+		"fetch the active regions using NEON_API_KEY and overwrite the file defined by --regions before generation.",
+	)
+	flag.StringVar(&apiURL, "api-url", "https://console.neon.tech/api/v2", "base URL of the Neon API.")
+	flag.StringVar(
+		&mockExamplesPath, "mock-examples", "",
+		"path to the JSON file with the recorded responses to be served by the mock instead of the spec's examples.",
+	)
+	flag.BoolVar(
+		&recordMockExamples, "record-mock-examples", false,
+		"record the responses of the read-only endpoints using NEON_API_KEY and overwrite the file defined by "+
+			"--mock-examples before generation. The sandbox account's key is recommended.",
+	)
+	flag.StringVar(
+		&recordParams, "record-path-params", "",
+		"comma-separated path parameters to record the responses with, e.g. 'project_id=foo,branch_id=br-bar'.",
+	)
+	flag.BoolVar(
+		&typeCheckOnly, "typecheck-only", false,
+		"type-check the generated code including its tests instead of running the tests.",
+	)
+	flag.BoolVar(
+		&optionalFields, "optional-fields", false,
+		"generate the optional fields of the requests' payloads as Optional instead of the pointers.",
+	)
 	flag.Parse()
 
-	if inputPath == "" || outputDir == "" {
+	if inputPath == "" || outputDir == "" || refreshRegions && regionsPath == "" ||
+		recordMockExamples && mockExamplesPath == "" {
 		flag.PrintDefaults()
 		os.Exit(1)
 	}
@@ -24,12 +76,108 @@ func main() {
 		log.Fatalln("cannot open input file " + inputPath)
 	}
 
-	if err := generator.Run(
-		generator.Config{
-			OpenAPIReader: f,
-			PathOutput:    outputDir,
-		},
-	); err != nil {
+	var generatedAt time.Time
+	if embedTimestamp {
+		generatedAt = time.Now()
+		if v := os.Getenv("SOURCE_DATE_EPOCH"); v != "" {
+			sec, err := strconv.ParseInt(v, 10, 64)
+			if err != nil {
+				log.Fatalln("cannot parse SOURCE_DATE_EPOCH " + v)
+			}
+			generatedAt = time.Unix(sec, 0)
+		}
+	}
+
+	var regions *os.File
+	if regionsPath != "" {
+		if refreshRegions {
+			b, err := generator.FetchRegions(context.Background(), apiURL, os.Getenv("NEON_API_KEY"))
+			if err != nil {
+				log.Fatalln("cannot fetch regions: " + err.Error())
+			}
+			if err := os.WriteFile(regionsPath, b, 0644); err != nil {
+				log.Fatalln("cannot write regions file " + regionsPath)
+			}
+		}
+
+		regions, err = os.Open(regionsPath)
+		if err != nil {
+			log.Fatalln("cannot open regions file " + regionsPath)
+		}
+	}
+
+	cfg := generator.Config{
+		OpenAPIReader:  f,
+		PathOutput:     outputDir,
+		InputFormat:    generator.InputFormatFromPath(inputPath),
+		IncludeTags:    splitList(includeTags),
+		ExcludePaths:   splitList(excludePaths),
+		GeneratedAt:    generatedAt,
+		TypeCheckOnly:  typeCheckOnly,
+		OptionalFields: optionalFields,
+	}
+	if regions != nil {
+		cfg.RegionsReader = regions
+	}
+
+	if mockExamplesPath != "" {
+		if recordMockExamples {
+			if err := recordMockExamplesFile(inputPath, mockExamplesPath, apiURL, recordParams); err != nil {
+				log.Fatalln("cannot record mock examples: " + err.Error())
+			}
+		}
+
+		mockExamples, err := os.Open(mockExamplesPath)
+		if err != nil {
+			log.Fatalln("cannot open mock examples file " + mockExamplesPath)
+		}
+		cfg.MockExamplesReader = mockExamples
+	}
+
+	if err := generator.Run(cfg); err != nil {
 		log.Fatalln(err)
 	}
 }
+
+func recordMockExamplesFile(inputPath, outputPath, serverURL, params string) error {
+	f, err := os.Open(inputPath)
+	if err != nil {
+		return err
+	}
+	defer func() { _ = f.Close() }()
+
+	pathParams := map[string]string{}
+	for _, el := range splitList(params) {
+		k, v, _ := strings.Cut(el, "=")
+		pathParams[k] = v
+	}
+
+	examples, err := generator.RecordMockExamples(
+		context.Background(), generator.RecordConfig{
+			OpenAPIReader:  f,
+			InputFormat:    generator.InputFormatFromPath(inputPath),
+			APIKey:         os.Getenv("NEON_API_KEY"),
+			ServerURL:      serverURL,
+			PathParameters: pathParams,
+		},
+	)
+	if err != nil {
+		return err
+	}
+
+	b, err := json.MarshalIndent(examples, "", "  ")
+	if err != nil {
+		return err
+	}
+	return os.WriteFile(outputPath, append(b, '\n'), 0644)
+}
+
+func splitList(s string) []string {
+	var o []string
+	for _, el := range strings.Split(s, ",") {
+		if el = strings.TrimSpace(el); el != "" {
+			o = append(o, el)
+		}
+	}
+	return o
+}
@@ -0,0 +1,59 @@
+package generator
+
+import (
+	"strings"
+)
+
+// filterEndpoints removes the operations which do not have any of the includeTags,
+// and the paths matching any of the excludePaths. The operations are kept if includeTags is empty.
+// The path ending with "*" matches the routes by prefix, otherwise the exact match is required.
+func filterEndpoints(spec *openAPISpec, includeTags, excludePaths []string) {
+	if len(includeTags) == 0 && len(excludePaths) == 0 {
+		return
+	}
+
+	for route, p := range spec.Paths {
+		if routeMatchesAny(route, excludePaths) {
+			delete(spec.Paths, route)
+			continue
+		}
+
+		if len(includeTags) > 0 {
+			for method, op := range p.Operations() {
+				if !hasAnyTag(op.Tags, includeTags) {
+					p.SetOperation(method, nil)
+				}
+			}
+		}
+
+		if len(p.Operations()) == 0 {
+			delete(spec.Paths, route)
+		}
+	}
+}
+
+func routeMatchesAny(route string, patterns []string) bool {
+	for _, p := range patterns {
+		if prefix, ok := strings.CutSuffix(p, "*"); ok {
+			if strings.HasPrefix(route, prefix) {
+				return true
+			}
+			continue
+		}
+		if route == p {
+			return true
+		}
+	}
+	return false
+}
+
+func hasAnyTag(tags, want []string) bool {
+	for _, tag := range tags {
+		for _, w := range want {
+			if strings.EqualFold(tag, w) {
+				return true
+			}
+		}
+	}
+	return false
+}
@@ -0,0 +1,85 @@
+package generator
+
+import (
+	"testing"
+
+	"github.com/getkin/kin-openapi/openapi3"
+	"github.com/stretchr/testify/assert"
+)
+
+func Test_filterEndpoints(t *testing.T) {
+	newSpec := func() *openAPISpec {
+		return &openAPISpec{
+			T: openapi3.T{
+				Paths: openapi3.Paths{
+					"/projects": &openapi3.PathItem{
+						Get:  &openapi3.Operation{Tags: []string{"Project"}},
+						Post: &openapi3.Operation{Tags: []string{"Project"}},
+					},
+					"/projects/{project_id}/branches": &openapi3.PathItem{
+						Get: &openapi3.Operation{Tags: []string{"Branch"}},
+					},
+					"/organizations/{org_id}": &openapi3.PathItem{
+						Get: &openapi3.Operation{Tags: []string{"Organizations"}},
+					},
+					"/organizations/{org_id}/members": &openapi3.PathItem{
+						Get: &openapi3.Operation{Tags: []string{"Organizations"}},
+					},
+				},
+			},
+		}
+	}
+
+	tests := []struct {
+		name         string
+		includeTags  []string
+		excludePaths []string
+		want         []string
+	}{
+		{
+			name: "no filters",
+			want: []string{
+				"/organizations/{org_id}", "/organizations/{org_id}/members", "/projects",
+				"/projects/{project_id}/branches",
+			},
+		},
+		{
+			name:        "include tags",
+			includeTags: []string{"project", "Branch"},
+			want:        []string{"/projects", "/projects/{project_id}/branches"},
+		},
+		{
+			name:         "exclude paths by prefix",
+			excludePaths: []string{"/organizations*"},
+			want:         []string{"/projects", "/projects/{project_id}/branches"},
+		},
+		{
+			name:         "exclude exact path",
+			excludePaths: []string{"/organizations/{org_id}"},
+			want:         []string{"/organizations/{org_id}/members", "/projects", "/projects/{project_id}/branches"},
+		},
+		{
+			name:         "include tags and exclude paths",
+			includeTags:  []string{"Project", "Branch"},
+			excludePaths: []string{"/projects"},
+			want:         []string{"/projects/{project_id}/branches"},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(
+			tt.name, func(t *testing.T) {
+				spec := newSpec()
+				filterEndpoints(spec, tt.includeTags, tt.excludePaths)
+				assert.Equal(t, tt.want, sortedKeys(spec.Paths))
+			},
+		)
+	}
+}
+
+func Test_endpointsImports(t *testing.T) {
+	assert.Nil(t, endpointsImports([]string{`c.requestHandler(c.baseURL+"/projects", "GET", nil, &v)`}))
+	assert.Equal(
+		t, []string{"strconv", "strings"},
+		endpointsImports([]string{`strings.Join(q, "&")`, `strconv.FormatInt(int64(*limit), 10)`}),
+	)
+}
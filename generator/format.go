@@ -0,0 +1,84 @@
+package generator
+
+import (
+	"bytes"
+	"errors"
+	"fmt"
+	"go/scanner"
+	"os"
+	"path/filepath"
+	"sort"
+	"strings"
+
+	"golang.org/x/tools/imports"
+)
+
+// formatGeneratedCode formats the Go files in the directory and fixes their imports in place.
+// The files which cannot be formatted are left unchanged, and reported with the diagnostics.
+func formatGeneratedCode(p string) error {
+	files, err := filepath.Glob(filepath.Join(p, "*.go"))
+	if err != nil {
+		return err
+	}
+	sort.Strings(files)
+
+	var errs []error
+	for _, f := range files {
+		if err := formatFile(f); err != nil {
+			errs = append(errs, err)
+		}
+	}
+	return errors.Join(errs...)
+}
+
+func formatFile(filePath string) error {
+	src, err := os.ReadFile(filePath)
+	if err != nil {
+		return fmt.Errorf("could not read file %s: %w", filePath, err)
+	}
+
+	o, err := formatCode(filePath, src)
+	if err != nil {
+		return err
+	}
+	if bytes.Equal(o, src) {
+		return nil
+	}
+
+	fi, err := os.Stat(filePath)
+	if err != nil {
+		return err
+	}
+	if err := os.WriteFile(filePath, o, fi.Mode().Perm()); err != nil {
+		return fmt.Errorf("could not write file %s: %w", filePath, err)
+	}
+	return nil
+}
+
+// formatCode formats the Go code as gofmt and goimports do.
+func formatCode(fileName string, src []byte) ([]byte, error) {
+	o, err := imports.Process(fileName, src, &imports.Options{Comments: true, TabIndent: true, TabWidth: 8})
+	if err != nil {
+		return nil, formatDiagnostics(fileName, src, err)
+	}
+	return o, nil
+}
+
+// formatDiagnostics reports the syntax errors with the file name, line and the offending source line.
+func formatDiagnostics(fileName string, src []byte, err error) error {
+	var list scanner.ErrorList
+	if !errors.As(err, &list) || len(list) == 0 {
+		return fmt.Errorf("could not format %s: %w", fileName, err)
+	}
+
+	lines := strings.Split(string(src), "\n")
+	var b strings.Builder
+	b.WriteString("could not format " + fileName + ":")
+	for _, e := range list {
+		b.WriteString(fmt.Sprintf("\n\t%s:%d:%d: %s", fileName, e.Pos.Line, e.Pos.Column, e.Msg))
+		if e.Pos.Line > 0 && e.Pos.Line <= len(lines) {
+			b.WriteString("\n\t\t" + strings.TrimSpace(lines[e.Pos.Line-1]))
+		}
+	}
+	return errors.New(b.String())
+}
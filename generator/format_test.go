@@ -0,0 +1,88 @@
+package generator
+
+import (
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func Test_formatCode(t *testing.T) {
+	tests := []struct {
+		name    string
+		src     string
+		want    string
+		wantErr string
+	}{
+		{
+			name: "formatted with imports fixed",
+			src: `package sdk
+import (
+"os"
+"strings"
+)
+func foo( ) string {
+return strings.TrimSpace( " foo " )
+}
+`,
+			want: `package sdk
+
+import (
+	"strings"
+)
+
+func foo() string {
+	return strings.TrimSpace(" foo ")
+}
+`,
+		},
+		{
+			name: "unhappy path: syntax error",
+			src: `package sdk
+
+func foo() string {
+	return "foo" +
+}
+`,
+			wantErr: "could not format sdk.go:\n\tsdk.go:5:1: expected operand, found '}'\n\t\t}",
+		},
+	}
+	for _, tt := range tests {
+		t.Run(
+			tt.name, func(t *testing.T) {
+				got, err := formatCode("sdk.go", []byte(tt.src))
+				if tt.wantErr != "" {
+					assert.EqualError(t, err, tt.wantErr)
+					return
+				}
+				assert.NoError(t, err)
+				assert.Equal(t, tt.want, string(got))
+			},
+		)
+	}
+}
+
+func Test_formatGeneratedCode(t *testing.T) {
+	p := t.TempDir()
+	write := func(name, content string) {
+		if err := os.WriteFile(filepath.Join(p, name), []byte(content), 0644); err != nil {
+			t.Fatal(err)
+		}
+	}
+	write("foo.go", "package sdk\nfunc foo( ) {}\n")
+	write("bar.go", "package sdk\nfunc bar( ) {\n")
+	write("README.md", "func foo( ) {}\n")
+
+	err := formatGeneratedCode(p)
+	assert.ErrorContains(t, err, "bar.go:2:15: expected '}', found 'EOF'")
+
+	got, _ := os.ReadFile(filepath.Join(p, "foo.go"))
+	assert.Equal(t, "package sdk\n\nfunc foo() {}\n", string(got))
+
+	got, _ = os.ReadFile(filepath.Join(p, "bar.go"))
+	assert.Equal(t, "package sdk\nfunc bar( ) {\n", string(got), "the faulty file must be left unchanged")
+
+	got, _ = os.ReadFile(filepath.Join(p, "README.md"))
+	assert.Equal(t, "func foo( ) {}\n", string(got))
+}
@@ -1,7 +1,9 @@
 package generator
 
 import (
+	"crypto/sha256"
 	"embed"
+	"encoding/hex"
 	"encoding/json"
 	"errors"
 	"fmt"
@@ -16,6 +18,7 @@ import (
 	"strconv"
 	"strings"
 	"text/template"
+	"time"
 
 	"github.com/getkin/kin-openapi/openapi3"
 )
@@ -24,9 +27,21 @@ import (
 var templatesFS embed.FS
 
 var (
-	templateNameSDK    = []string{"sdk.go.templ", "sdk_test.go.templ"}
+	templateNameSDK    = []string{"sdk.go.templ", "sdk_test.go.templ", "version.go.templ", "version_test.go.templ"}
 	templateNameMock   = []string{"mockhttp.go.templ", "mockhttp_test.go.templ"}
-	templateNameStatic = []string{"go.mod.templ", "doc.go.templ", "error.go.templ"}
+	templateNameStatic = []string{
+		"go.mod.templ", "doc.go.templ", "error.go.templ", "destructive.go.templ", "destructive_test.go.templ",
+		"codec.go.templ", "codec_test.go.templ", "debug.go.templ", "debug_test.go.templ",
+		"circuit.go.templ", "circuit_test.go.templ", "compression.go.templ", "compression_test.go.templ",
+		"transport.go.templ", "transport_test.go.templ", "events.go.templ", "events_test.go.templ",
+		"metadata.go.templ", "metadata_test.go.templ", "presign.go.templ", "presign_test.go.templ",
+		"request.go.templ", "request_test.go.templ",
+		"validation.go.templ", "validation_test.go.templ", "deprecation.go.templ", "deprecation_test.go.templ",
+		"inflight.go.templ", "inflight_test.go.templ", "auth.go.templ", "auth_test.go.templ",
+		"nullable.go.templ", "nullable_test.go.templ", "optional.go.templ", "optional_test.go.templ",
+		"audit.go.templ", "audit_test.go.templ", "outbox.go.templ", "outbox_test.go.templ",
+		"union.go.templ", "union_test.go.templ", "metacache.go.templ", "metacache_test.go.templ",
+	}
 )
 
 // Config generator configurations.
@@ -36,8 +51,43 @@ type Config struct {
 
 	// PathOutput defines the path to store generated files.
 	PathOutput string
+
+	// InputFormat defines the format of the OpenAPI spec, JSON or YAML.
+	// The format is detected by the spec's content if it is not set.
+	InputFormat InputFormat
+
+	// IncludeTags defines the tags of the endpoints to generate, e.g. "Branch".
+	// All endpoints are generated if it is not set.
+	IncludeTags []string
+
+	// ExcludePaths defines the routes of the endpoints to skip, e.g. "/projects/{project_id}/jwks".
+	// The route ending with "*" defines the prefix, e.g. "/organizations*" skips all organizations' endpoints.
+	ExcludePaths []string
+
+	// GeneratedAt defines the timestamp of generation embedded into the generated code.
+	// It is not embedded if not set, hence the output of consecutive runs is identical.
+	GeneratedAt time.Time
+
+	// RegionsReader defines the response of the active regions' endpoint to generate the regions subpackage from.
+	// The subpackage is not generated if not set.
+	RegionsReader io.Reader
+
+	// MockExamplesReader defines the responses recorded by RecordMockExamples to be served by the generated mock
+	// instead of the spec's examples. The spec's examples are used if not set.
+	MockExamplesReader io.Reader
+
+	// OptionalFields defines if the optional fields of the requests' payloads are generated as Optional
+	// instead of the pointers. Note that the hand-written helpers of the SDK rely on the pointers.
+	OptionalFields bool
+
+	// TypeCheckOnly defines if the generated code, including its tests, is type-checked instead of running its tests,
+	// e.g. to catch the spec's regressions in seconds. The check is skipped if SKIP_TEST is set.
+	TypeCheckOnly bool
 }
 
+// Version the version of the code generator embedded into the generated code.
+const Version = "v0.12.0"
+
 // Run executes code generation using the OpenAPI spec.
 func Run(cfg Config) error {
 	templates := template.Must(template.ParseFS(templatesFS, "templates/*"))
@@ -46,17 +96,47 @@ func Run(cfg Config) error {
 	if err != nil {
 		return errors.New("cannot read OpenAPI spec: " + err.Error())
 	}
+	specDigest := sha256.Sum256(specBytes)
+
+	specBytes, err = specToJSON(specBytes, cfg.InputFormat)
+	if err != nil {
+		return errors.New("cannot convert OpenAPI spec to JSON: " + err.Error())
+	}
 
 	var spec openAPISpec
 	if err := spec.UnmarshalJSON(specBytes); err != nil {
 		return errors.New("cannot parse OpenAPI spec: " + err.Error())
 	}
+	filterEndpoints(&spec, cfg.IncludeTags, cfg.ExcludePaths)
 
 	orderedEndpointRoutes, err := extractOrderedEndpointRoutes(specBytes)
 	if err != nil {
 		return errors.New("cannot extract ordered list of endpoints from the OpenAPI spec: " + err.Error())
 	}
-	tempInputSDK, tempInputMock := extractSpecs(spec, orderedEndpointRoutes)
+	tempInputSDK, tempInputMock := extractSpecs(spec, orderedEndpointRoutes, cfg.OptionalFields)
+	schemas, err := extractResponseSchemas(specBytes, spec)
+	if err != nil {
+		return errors.New("cannot extract responses' schemas from the OpenAPI spec: " + err.Error())
+	}
+	jsonSchema, err := extractJSONSchema(specBytes, spec)
+	if err != nil {
+		return errors.New("cannot extract JSON Schema from the OpenAPI spec: " + err.Error())
+	}
+	if cfg.MockExamplesReader != nil {
+		examples, err := readMockExamples(cfg.MockExamplesReader)
+		if err != nil {
+			return errors.New("cannot read mock examples: " + err.Error())
+		}
+		examples.apply(tempInputMock.EndpointsResponseExample)
+	}
+	if spec.Info != nil {
+		tempInputSDK.SpecVersion = spec.Info.Version
+	}
+	tempInputSDK.SpecDigest = "sha256:" + hex.EncodeToString(specDigest[:])
+	tempInputSDK.GeneratorVersion = Version
+	if !cfg.GeneratedAt.IsZero() {
+		tempInputSDK.GeneratedAt = cfg.GeneratedAt.UTC().Format(time.RFC3339)
+	}
 
 	if err := generateFiles(templates, templateNameSDK, tempInputSDK, cfg.PathOutput); err != nil {
 		return fmt.Errorf("could not generate sdk files: %w", err)
@@ -70,9 +150,31 @@ func Run(cfg Config) error {
 		return fmt.Errorf("could not generate static files: %w", err)
 	}
 
+	if err := generateResponseSchemas(schemas, cfg.PathOutput); err != nil {
+		return fmt.Errorf("could not generate responses' schemas: %w", err)
+	}
+
+	if err := generateJSONSchema(jsonSchema, cfg.PathOutput); err != nil {
+		return fmt.Errorf("could not generate JSON Schema: %w", err)
+	}
+
+	if cfg.RegionsReader != nil {
+		regions, err := readRegions(cfg.RegionsReader)
+		if err != nil {
+			return errors.New("cannot read regions: " + err.Error())
+		}
+		if err := generateRegions(templates, regions, path.Join(cfg.PathOutput, "regions")); err != nil {
+			return fmt.Errorf("could not generate regions files: %w", err)
+		}
+	}
+
 	var e error
 	if v, _ := strconv.ParseBool(os.Getenv("SKIP_TEST")); !v {
-		e = testGeneratedCode(cfg.PathOutput)
+		if cfg.TypeCheckOnly {
+			e = typeCheckGeneratedCode(cfg.PathOutput)
+		} else {
+			e = testGeneratedCode(cfg.PathOutput)
+		}
 	}
 
 	if v, _ := strconv.ParseBool(os.Getenv("SKIP_FORMATTING")); !v {
@@ -82,18 +184,6 @@ func Run(cfg Config) error {
 	return e
 }
 
-func formatGeneratedCode(p string) error {
-	cmd := exec.Command("go", "fmt", ".")
-	cmd.Dir = p
-	if err := cmd.Start(); err != nil {
-		panic(err)
-	}
-	if err := cmd.Wait(); err != nil {
-		return fmt.Errorf("failed to run go fmt: %w", err)
-	}
-	return nil
-}
-
 func generateFiles(t *template.Template, templateNames []string, data any, p string) error {
 	for _, templateName := range templateNames {
 		outputFileName := strings.TrimSuffix(templateName, ".templ")
@@ -111,7 +201,9 @@ func generateFiles(t *template.Template, templateNames []string, data any, p str
 	return nil
 }
 
-func extractSpecs(spec openAPISpec, orderedEndpointRoutes []string) (templateInputSDK, templateInputMock) {
+func extractSpecs(
+	spec openAPISpec, orderedEndpointRoutes []string, optionalFields bool,
+) (templateInputSDK, templateInputMock) {
 	if len(spec.Servers) < 1 {
 		panic("no server spec found")
 	}
@@ -127,6 +219,8 @@ func extractSpecs(spec openAPISpec, orderedEndpointRoutes []string) (templateInp
 
 	endpointsStr := make([]string, len(endpoints))
 	endpointsTestStr := make([]string, 0, len(endpoints))
+	// responseModels the models of the responses' payloads
+	responseModels := models{}
 	models := models{}
 
 	mockResponses := map[string]map[string]mockResponse{
@@ -464,9 +558,14 @@ func extractSpecs(spec openAPISpec, orderedEndpointRoutes []string) (templateInp
 			if _, ok := mockResponses[s.Route]; !ok {
 				mockResponses[s.Route] = map[string]mockResponse{}
 			}
-			if _, ok := mockResponses[s.Route][s.Method]; !ok {
-				mockResponses[s.Route][s.Method] = s.generateMockResponse()
+			resp, ok := mockResponses[s.Route][s.Method]
+			if !ok {
+				resp = s.generateMockResponse()
+			}
+			if s.ResponseStruct != nil {
+				resp.ResponseType = s.ResponseStruct.name
 			}
+			mockResponses[s.Route][s.Method] = resp
 		}
 
 		if s.ResponseStruct != nil {
@@ -474,6 +573,7 @@ func extractSpecs(spec openAPISpec, orderedEndpointRoutes []string) (templateInp
 				m[s.ResponseStruct.name] = *s.ResponseStruct
 			}
 			filterModels(m, models, *s.ResponseStruct)
+			filterModels(m, responseModels, *s.ResponseStruct)
 		}
 		if s.RequestBodyStruct != nil {
 			if s.RequestBodyStruct.generated {
@@ -491,11 +591,19 @@ func extractSpecs(spec openAPISpec, orderedEndpointRoutes []string) (templateInp
 		}
 	}
 
+	models.resetNullableFields(responseModels)
+	if optionalFields {
+		models.setOptionalFields(responseModels)
+	}
+
 	return templateInputSDK{
 			ServerURL:                   spec.Servers[0].URL,
 			EndpointsImplementation:     endpointsStr,
 			Types:                       models.generateCode(),
+			TypeNames:                   models.names(),
+			EndpointsImports:            endpointsImports(endpointsStr),
 			EndpointsImplementationTest: endpointsTestStr,
+			OptionalFields:              optionalFields,
 		}, templateInputMock{
 			EndpointsResponseExample: mockResponses,
 		}
@@ -510,6 +618,26 @@ func skipTest(route string) bool {
 	return found
 }
 
+// resetNullableFields disables Nullable for the fields of the response models, hence Nullable is only used
+// by the request payloads to clear the optional fields, and the response models keep the pointers.
+func (v models) resetNullableFields(responseModels models) {
+	for k := range responseModels {
+		for _, f := range v[k].fields {
+			f.nullable = false
+		}
+	}
+}
+
+// setOptionalFields makes the models, except the response models, use Optional for the optional fields.
+func (v models) setOptionalFields(responseModels models) {
+	for k, m := range v {
+		if _, ok := responseModels[k]; !ok {
+			m.optionalFields = true
+			v[k] = m
+		}
+	}
+}
+
 func filterModels(modelsSource models, output models, m model) {
 	name := strings.NewReplacer("[", "", "]", "").Replace(m.name)
 
@@ -550,6 +678,16 @@ type templateInputSDK struct {
 	EndpointsImplementation     []string
 	Types                       []string
 	EndpointsImplementationTest []string
+	// EndpointsImports the packages imported by the endpoints' implementation.
+	EndpointsImports []string
+	// TypeNames the names of the generated types.
+	TypeNames                   map[string]bool
+	SpecVersion                 string
+	SpecDigest                  string
+	GeneratorVersion            string
+	GeneratedAt                 string
+	// OptionalFields defines if the optional fields of the requests' payloads are generated as Optional.
+	OptionalFields bool
 }
 
 type templateInputMock struct {
@@ -601,6 +739,12 @@ func (e endpointImplementation) generateMethodImplementation() string {
 		reqObj = "cfg"
 	}
 
+	returnStatementUnhappyPath := "nil"
+	if e.ResponseStruct != nil && e.ResponseStruct.name != "" && e.ResponseStruct.name[0] != '[' {
+		returnStatementUnhappyPath = e.ResponseStruct.name + "{}"
+	}
+	o += e.generatePathParametersCheck(returnStatementUnhappyPath)
+
 	var query string
 	if len(e.RequestParametersQuery) > 0 {
 		query = " + query"
@@ -612,11 +756,6 @@ func (e endpointImplementation) generateMethodImplementation() string {
 }`
 	}
 
-	returnStatementUnhappyPath := e.ResponseStruct.name + "{}"
-	if e.ResponseStruct.name == "" || e.ResponseStruct.name[0] == '[' {
-		returnStatementUnhappyPath = "nil"
-	}
-
 	return o + "	var v " + e.ResponseStruct.name + `
 	if err := c.requestHandler(c.baseURL+` + e.route() + query + `, "` + e.Method + `", ` + reqObj + `, &v); err != nil {
 		return ` + returnStatementUnhappyPath + `, err
@@ -657,7 +796,11 @@ func (e endpointImplementation) route() string {
 				if o[len(o)-1] == '/' {
 					prefix = ""
 				}
-				o += prefix + `"+` + p.routeElement()
+				el := p.routeElement()
+				if p.isEscapedInPath() {
+					el = "url.PathEscape(" + el + ")"
+				}
+				o += prefix + `"+` + el
 				if i < len(els)-1 {
 					o += `+"/`
 				}
@@ -668,6 +811,23 @@ func (e endpointImplementation) route() string {
 	return o
 }
 
+// generatePathParametersCheck generates the check rejecting the empty string path parameters,
+// the zero value is returned alongside the error if the method returns the response.
+func (e endpointImplementation) generatePathParametersCheck(zero string) string {
+	var o string
+	for _, p := range e.RequestParametersPath {
+		if !p.isEscapedInPath() {
+			continue
+		}
+		ret := "newPathParameterError(\"" + p.name() + "\")"
+		if e.ResponseStruct != nil {
+			ret = zero + ", " + ret
+		}
+		o += "\tif " + p.canonicalName() + " == \"\" {\n\t\treturn " + ret + "\n\t}\n"
+	}
+	return o
+}
+
 func (e endpointImplementation) inputArgStr() string {
 	o := ""
 	parameters := e.RequestParametersPath
@@ -707,9 +867,15 @@ func (e endpointImplementation) generateMethodHeader() string {
 type mockResponse struct {
 	Code    string
 	Content string
+	// ResponseType the type the content is deserialized to, empty if the response is not deserialized.
+	ResponseType string
 }
 
 func (e endpointImplementation) generateMockResponse() mockResponse {
+	if e.ResponsePositivePathStatusCode == "204" {
+		return mockResponse{Code: e.ResponsePositivePathStatusCode}
+	}
+
 	o, err := json.Marshal(e.ResponsePositivePathExample)
 	if err != nil {
 		panic(err)
@@ -810,7 +976,7 @@ func (e endpointImplementation) generateMethodImplementationTest() string {
 	for _, tt := range tests {
 		t.Run(
 			tt.name, func(t *testing.T) {
-				c, err := NewClient(Config{tt.apiKey, NewMockHTTPClient()})
+				c, err := NewClient(Config{Key: tt.apiKey, HTTPClient: NewMockHTTPClient()})
 				if err != nil {
 					panic(err)
 				}
@@ -896,7 +1062,7 @@ func (e endpointImplementation) generateMethodImplementationTestNoResponseExpect
 	for _, tt := range tests {
 		t.Run(
 			tt.name, func(t *testing.T) {
-				c, err := NewClient(Config{tt.apiKey, NewMockHTTPClient()})
+				c, err := NewClient(Config{Key: tt.apiKey, HTTPClient: NewMockHTTPClient()})
 				if err != nil {
 					panic(err)
 				}
@@ -1056,6 +1222,8 @@ type field struct {
 	required     bool
 	isInPath     bool
 	isInQuery    bool
+	// nullable defines if the API distinguishes the explicit null from the absent value.
+	nullable bool
 }
 
 func (v *field) setRequired(b bool) {
@@ -1165,6 +1333,11 @@ func (v field) routeElement(withPointer ...bool) string {
 	return base()
 }
 
+// isEscapedInPath defines if the parameter is the free-form string which must be escaped in the path.
+func (v field) isEscapedInPath() bool {
+	return v.v == "string" && v.format != "date-time" && v.format != "date"
+}
+
 // see the ascii table https://www.asciitable.com/
 func startsWithCapitalLetter(v string) bool {
 	return v[0] > 64 && v[0] < 91
@@ -1245,6 +1418,28 @@ type model struct {
 	name, description string
 	generated         bool
 	isEnum            bool
+	// mapValueType defines the type of the map's values for the object
+	// which defines additionalProperties by reference instead of properties.
+	mapValueType string
+	// optionalFields defines if the optional fields are generated as Optional instead of the pointers.
+	optionalFields bool
+	// variants defines the types of the union generated for the oneOf, or anyOf schema.
+	variants []unionVariant
+	// discriminator defines the property selecting the union's variant,
+	// the variants are tried in order if the schema does not define the discriminator.
+	discriminator string
+	// enumDescriptions defines the descriptions of the enum's values set by the x-enum-descriptions extension.
+	enumDescriptions map[string]string
+}
+
+// unionVariant defines one of the types of the union.
+type unionVariant struct {
+	// name the suffix of the union's accessors, e.g. IsString.
+	name string
+	// typ the variant's Go type.
+	typ string
+	// discriminatorValues the values of the discriminator's property selecting the variant.
+	discriminatorValues []string
 }
 
 func (m *model) setPrimitiveType(t fieldType) {
@@ -1260,11 +1455,19 @@ func (m model) generateCode() string {
 		return m.generateCodeEnum()
 	}
 
+	if len(m.variants) > 0 {
+		return m.generateCodeUnion()
+	}
+
 	k := m.name
 	if m.primitive.name != "" {
 		return m.docString() + "type " + k + " " + m.primitive.argType()
 	}
 
+	if m.mapValueType != "" {
+		return m.docString() + "type " + k + " map[string]" + m.mapValueType
+	}
+
 	tmp := m.docString() + "type " + k
 
 	if len(m.fields) == 0 && len(m.children) == 0 {
@@ -1286,7 +1489,15 @@ func (m model) generateCode() string {
 			omitEmpty = ",omitempty"
 			pointerFlag = true
 		}
-		tmp += objNameGoConventionExport(fieldName) + " " + field.argType(pointerFlag) +
+		argType := field.argType(pointerFlag)
+		switch {
+		case field.required:
+		case field.nullable:
+			argType = "Nullable[" + field.argType() + "]"
+		case m.optionalFields && !field.isArray:
+			argType = "Optional[" + field.argType() + "]"
+		}
+		tmp += objNameGoConventionExport(fieldName) + " " + argType +
 			" `json:\"" + field.k + omitEmpty + "\"" +
 			// TODO: add pulumi tags (?)
 			// " pulumi:\"" + field.k + pulumiOptional + "\"`" +
@@ -1340,18 +1551,164 @@ func (m model) generateCodeEnum() string {
 	slices.Sort(children)
 
 	for _, child := range children {
-		enumOption := strings.ToUpper(child[:1]) + child[1:]
+		if d, ok := m.enumDescriptions[child]; ok {
+			tmp += docString(m.enumConstName(child), d)
+		}
+		tmp += m.enumConstName(child) + " " + m.name + " = \"" + child + "\"\n"
+	}
 
-		enumOption = removeSpecialCharAndMakeCamelCase(enumOption, "-")
-		enumOption = removeSpecialCharAndMakeCamelCase(enumOption, "_")
+	tmp += ")"
 
-		tmp += m.name + enumOption + " " + m.name + " = \"" + child + "\"\n"
+	if len(m.enumDescriptions) == 0 {
+		return tmp
 	}
 
-	tmp += ")"
+	tmp += "\n\n// " + m.name + "Descriptions the descriptions of the " + m.name + " values.\n"
+	tmp += "var " + m.name + "Descriptions = map[" + m.name + "]string{\n"
+	for _, child := range children {
+		if d, ok := m.enumDescriptions[child]; ok {
+			tmp += m.enumConstName(child) + ": " + strconv.Quote(strings.TrimSpace(d)) + ",\n"
+		}
+	}
+	tmp += "}\n\n"
+	tmp += "// Description returns the description of the value, or the empty string if the value is not documented.\n"
+	tmp += "func (v " + m.name + ") Description() string {\nreturn " + m.name + "Descriptions[v]\n}"
 	return tmp
 }
 
+// generateCodeUnion generates the struct holding the value of one of the variants,
+// the accessors of the variants, and the JSON (un)marshalers.
+func (m model) generateCodeUnion() string {
+	k := m.name
+
+	types := make([]string, len(m.variants))
+	for i, v := range m.variants {
+		types[i] = v.typ
+	}
+
+	tmp := m.docString()
+	if tmp != "" {
+		tmp += "//\n"
+	}
+	tmp += "// " + k + " holds the value of one of the types: " + strings.Join(types, ", ") + ".\n"
+	tmp += "type " + k + " struct {\n"
+	for _, v := range m.variants {
+		tmp += v.fieldName() + " *" + v.typ + "\n"
+	}
+	tmp += "}\n"
+
+	fields := make([]string, len(m.variants))
+	for i, v := range m.variants {
+		fields[i] = "v." + v.fieldName()
+
+		tmp += "\n// New" + k + "From" + v.name + " returns " + k + " holding the " + v.typ + " value.\n" +
+			"func New" + k + "From" + v.name + "(v " + v.typ + ") " + k + " {\n" +
+			"return " + k + "{" + v.fieldName() + ": &v}\n}\n"
+
+		tmp += "\n// Is" + v.name + " defines if " + k + " holds the " + v.typ + " value.\n" +
+			"func (v " + k + ") Is" + v.name + "() bool {\n" +
+			"return v." + v.fieldName() + " != nil\n}\n"
+
+		tmp += "\n// As" + v.name + " returns the " + v.typ + " value, and true if " + k + " holds it.\n" +
+			"func (v " + k + ") As" + v.name + "() (" + v.typ + ", bool) {\n" +
+			"if v." + v.fieldName() + " == nil {\n" +
+			"var o " + v.typ + "\n" +
+			"return o, false\n}\n" +
+			"return *v." + v.fieldName() + ", true\n}\n"
+	}
+
+	tmp += "\n// MarshalJSON encodes the value " + k + " holds, or null if it holds none.\n" +
+		"func (v " + k + ") MarshalJSON() ([]byte, error) {\n" +
+		"return marshalUnion(" + strings.Join(fields, ", ") + ")\n}\n"
+
+	tmp += "\n// UnmarshalJSON decodes the value into the first type which matches the value's fields exactly,\n" +
+		"// or into the first type which the value can be decoded to otherwise.\n"
+	if m.discriminator != "" {
+		tmp += "// The type is selected by the \"" + m.discriminator + "\" property if the property defines it.\n"
+	}
+	tmp += "func (v *" + k + ") UnmarshalJSON(b []byte) error {\n*v = " + k + "{}\n"
+
+	if m.discriminator != "" {
+		tmp += "switch unionDiscriminator(b, \"" + m.discriminator + "\") {\n"
+		for _, v := range m.variants {
+			if len(v.discriminatorValues) == 0 {
+				continue
+			}
+			tmp += "case \"" + strings.Join(v.discriminatorValues, "\", \"") + "\":\n" +
+				"return decodeUnionVariant(b, &v." + v.fieldName() + ", false)\n"
+		}
+		tmp += "}\n"
+	}
+
+	tmp += "for _, strict := range []bool{true, false} {\n"
+	for _, v := range m.variants {
+		tmp += "if decodeUnionVariant(b, &v." + v.fieldName() + ", strict) == nil {\nreturn nil\n}\n"
+	}
+	tmp += "}\n"
+
+	return tmp + "return unionNoMatchError(\"" + k + "\")\n}"
+}
+
+// fieldName returns the name of the union's field holding the variant's value.
+func (v unionVariant) fieldName() string {
+	return strings.ToLower(v.name[:1]) + v.name[1:]
+}
+
+// enumConstName returns the name of the constant defining the enum's value.
+func (m model) enumConstName(value string) string {
+	enumOption := strings.ToUpper(value[:1]) + value[1:]
+
+	enumOption = removeSpecialCharAndMakeCamelCase(enumOption, "-")
+	enumOption = removeSpecialCharAndMakeCamelCase(enumOption, "_")
+
+	return m.name + enumOption
+}
+
+// enumDescriptionsExtension the extension defining the descriptions of the enum's values,
+// either as the list following the order of the enum's values, or as the object keyed by the values.
+const enumDescriptionsExtension = "x-enum-descriptions"
+
+// enumDescriptions returns the descriptions of the enum's values, or nil if the schema does not define them.
+func enumDescriptions(v *openapi3.Schema) map[string]string {
+	ext, ok := v.Extensions[enumDescriptionsExtension]
+	if !ok {
+		return nil
+	}
+	b, err := json.Marshal(ext)
+	if err != nil {
+		log.Printf("could not read %s: %v\n", enumDescriptionsExtension, err)
+		return nil
+	}
+
+	var o map[string]string
+	var list []string
+	switch {
+	case json.Unmarshal(b, &o) == nil:
+	case json.Unmarshal(b, &list) == nil:
+		if len(list) != len(v.Enum) {
+			log.Printf("%s defines %d descriptions for %d enum values\n", enumDescriptionsExtension, len(list), len(v.Enum))
+			return nil
+		}
+		o = make(map[string]string, len(list))
+		for i, d := range list {
+			o[fmt.Sprintf("%v", v.Enum[i])] = d
+		}
+	default:
+		log.Printf("%s is expected to be the list, or the object of strings\n", enumDescriptionsExtension)
+		return nil
+	}
+
+	for k, d := range o {
+		if d == "" {
+			delete(o, k)
+		}
+	}
+	if len(o) == 0 {
+		return nil
+	}
+	return o
+}
+
 func removeSpecialCharAndMakeCamelCase(s string, specialChar string) string {
 	els := strings.Split(s, specialChar)
 	s = els[0]
@@ -1390,7 +1747,7 @@ func generateEndpointsImplementationMethods(
 	const suffixResponseObject = "RespObj"
 	const suffixRequestObject = "ReqObj"
 
-	httpCodes := []string{"200", "201"}
+	httpCodes := []string{"200", "201", "204"}
 	httpMethods := []string{
 		http.MethodGet,
 		http.MethodPost,
@@ -1408,7 +1765,8 @@ func generateEndpointsImplementationMethods(
 
 		operations := p.Operations()
 
-		for httpMethod, ops := range operations {
+		for _, httpMethod := range sortedKeys(operations) {
+			ops := operations[httpMethod]
 			if !slices.Contains(httpMethods, httpMethod) {
 				continue
 			}
@@ -1563,19 +1921,54 @@ func (v models) orderedNames() []string {
 func generateModels(spec openAPISpec) models {
 	m := models{}
 
-	for k, v := range spec.Components.Responses {
+	// the components are iterated in the sorted order to generate the same output on every run
+	for _, k := range sortedKeys(spec.Components.Responses) {
 		m.add(k)
-		modelsFromSchema(m, k, v.Value.Content["application/json"].Schema)
+		modelsFromSchema(m, k, spec.Components.Responses[k].Value.Content["application/json"].Schema)
 	}
 
-	for k, v := range spec.Components.Schemas {
+	for _, k := range sortedKeys(spec.Components.Schemas) {
 		m.add(k)
-		modelsFromSchema(m, k, v)
+		modelsFromSchema(m, k, spec.Components.Schemas[k])
 	}
 
 	return m
 }
 
+// endpointsImports returns the packages imported by the endpoints' implementation in addition
+// to the packages imported by the client.
+func endpointsImports(endpoints []string) []string {
+	var o []string
+	for _, pkg := range []string{"strconv", "strings"} {
+		for _, e := range endpoints {
+			if strings.Contains(e, pkg+".") {
+				o = append(o, pkg)
+				break
+			}
+		}
+	}
+	return o
+}
+
+// names returns the set of the models' names.
+func (v models) names() map[string]bool {
+	o := make(map[string]bool, len(v))
+	for k := range v {
+		o[k] = true
+	}
+	return o
+}
+
+// sortedKeys returns the map's keys in the ascending order.
+func sortedKeys[V any](m map[string]V) []string {
+	o := make([]string, 0, len(m))
+	for k := range m {
+		o = append(o, k)
+	}
+	slices.Sort(o)
+	return o
+}
+
 func modelsFromSchema(m models, k string, s *openapi3.SchemaRef) {
 	if s.Ref != "" {
 		m.addChild(k, s.Ref)
@@ -1590,14 +1983,94 @@ func modelsFromSchema(m models, k string, s *openapi3.SchemaRef) {
 	}
 }
 
+// unionOf returns the schemas of the oneOf, or anyOf definition.
+func unionOf(v *openapi3.Schema) openapi3.SchemaRefs {
+	if len(v.OneOf) > 0 {
+		return v.OneOf
+	}
+	return v.AnyOf
+}
+
+// addUnion defines the model as the union of the oneOf, or anyOf schemas.
+// The inline objects are extracted to the models named after the union, e.g. FooVariant1.
+func addUnion(m models, k string, v *openapi3.Schema) {
+	schemas := unionOf(v)
+	variants := make([]unionVariant, len(schemas))
+	for i, s := range schemas {
+		switch {
+		case s.Ref != "":
+			variants[i].typ = modelNameFromRef(s.Ref)
+			variants[i].name = variants[i].typ
+			m.addChild(k, s.Ref)
+		case s.Value.Type == openapi3.TypeArray:
+			variants[i].typ = extractStructFromSchemaRef(s).name
+			variants[i].name = unionVariantName(strings.TrimPrefix(variants[i].typ, "[]")) + "List"
+			m.addChild(k, variants[i].typ)
+		case s.Value.Type == openapi3.TypeObject || s.Value.Type == "":
+			variants[i].name = "Variant" + strconv.Itoa(i+1)
+			variants[i].typ = k + variants[i].name
+			m.add(variants[i].typ)
+			modelsFromSchema(m, variants[i].typ, s)
+			m.addChild(k, variants[i].typ)
+		default:
+			variants[i].typ = fieldType{name: s.Value.Type, format: s.Value.Format}.argType()
+			variants[i].name = unionVariantName(variants[i].typ)
+		}
+	}
+
+	tmp := m[k]
+	if d := v.Discriminator; d != nil && d.PropertyName != "" {
+		tmp.discriminator = d.PropertyName
+		for i, s := range schemas {
+			for _, value := range sortedKeys(d.Mapping) {
+				if modelNameFromRef(d.Mapping[value]) == variants[i].typ {
+					variants[i].discriminatorValues = append(variants[i].discriminatorValues, value)
+				}
+			}
+			// the schema's name is the discriminator's value unless the mapping is defined
+			if len(d.Mapping) == 0 && s.Ref != "" {
+				variants[i].discriminatorValues = []string{variants[i].typ}
+			}
+		}
+	}
+	tmp.variants = variants
+	m[k] = tmp
+}
+
+// unionVariantName returns the name of the union's variant of the primitive type, e.g. Time for time.Time.
+func unionVariantName(t string) string {
+	return objNameGoConventionExport(t[strings.LastIndex(t, ".")+1:])
+}
+
 func addFromValue(m models, k string, v *openapi3.Schema) {
+	if v.Type == "" && len(unionOf(v)) > 0 {
+		addUnion(m, k, v)
+		return
+	}
+
 	switch v.Type {
 	case "":
 		for _, c := range v.AllOf {
 			m.addChild(k, c.Ref)
 		}
 	case openapi3.TypeObject:
-		for propertyName, property := range v.Properties {
+		if len(v.Properties) == 0 && v.AdditionalProperties != nil && v.AdditionalProperties.Ref != "" {
+			tmp := m[k]
+			tmp.mapValueType = modelNameFromRef(v.AdditionalProperties.Ref)
+			m[k] = tmp
+			m.addChild(k, v.AdditionalProperties.Ref)
+		}
+		if len(v.Properties) == 0 && v.AdditionalProperties != nil && v.AdditionalProperties.Value != nil &&
+			v.AdditionalProperties.Ref == "" && len(unionOf(v.AdditionalProperties.Value)) > 0 {
+			tmp := m[k]
+			tmp.mapValueType = k + "Value"
+			m[k] = tmp
+			m.add(tmp.mapValueType)
+			modelsFromSchema(m, tmp.mapValueType, v.AdditionalProperties)
+			m.addChild(k, tmp.mapValueType)
+		}
+		for _, propertyName := range sortedKeys(v.Properties) {
+			property := v.Properties[propertyName]
 			field := field{
 				k:        propertyName,
 				v:        extractStructFromSchemaRef(property).name,
@@ -1606,23 +2079,26 @@ func addFromValue(m models, k string, v *openapi3.Schema) {
 			}
 
 			if field.v == "" {
-				switch property.Value.Type {
-				case openapi3.TypeObject:
+				switch {
+				case property.Value.Type == "" && len(unionOf(property.Value)) > 0,
+					property.Value.Type == openapi3.TypeObject:
 					field.v = k + objNameGoConventionExport(propertyName)
 					m.addChild(k, field.v)
 					m.add(field.v)
 					modelsFromSchema(m, field.v, property)
-				case openapi3.TypeArray:
+				case property.Value.Type == openapi3.TypeArray:
 					m.addChild(k, extractStructFromSchemaRef(property).name)
 				default:
 					field.v = property.Value.Type
 					field.format = property.Value.Format
 					field.description = property.Value.Description
 				}
+				field.nullable = property.Value.Nullable
 			} else {
 				if property.Value != nil {
 					field.format = property.Value.Format
 					field.description = property.Value.Description
+					field.nullable = property.Value.Nullable
 				} else {
 					m.addChild(k, field.v)
 				}
@@ -1649,6 +2125,7 @@ func addFromValue(m models, k string, v *openapi3.Schema) {
 
 		if len(v.Enum) > 0 {
 			tmp.isEnum = true
+			tmp.enumDescriptions = enumDescriptions(v)
 
 			tmp.children = make(map[string]struct{}, len(v.Enum))
 			for _, el := range v.Enum {
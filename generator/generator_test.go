@@ -2,7 +2,10 @@ package generator
 
 import (
 	"bytes"
+	"crypto/sha256"
 	_ "embed"
+	"encoding/hex"
+	"encoding/json"
 	"io/fs"
 	"os"
 	"strings"
@@ -73,13 +76,122 @@ func TestRun(t *testing.T) {
 			},
 			wantErr: false,
 			files: map[string]struct{}{
-				"go.mod":           {},
-				"doc.go":           {},
-				"sdk.go":           {},
-				"sdk_test.go":      {},
-				"error.go":         {},
-				"mockhttp.go":      {},
-				"mockhttp_test.go": {},
+				"go.mod":               {},
+				"doc.go":               {},
+				"sdk.go":               {},
+				"sdk_test.go":          {},
+				"error.go":             {},
+				"destructive.go":       {},
+				"destructive_test.go":  {},
+				"codec.go":             {},
+				"codec_test.go":        {},
+				"debug.go":             {},
+				"debug_test.go":        {},
+				"circuit.go":           {},
+				"circuit_test.go":      {},
+				"compression.go":       {},
+				"compression_test.go":  {},
+				"transport.go":         {},
+				"transport_test.go":    {},
+				"events.go":            {},
+				"events_test.go":       {},
+				"metadata.go":          {},
+				"metadata_test.go":     {},
+				"presign.go":           {},
+				"presign_test.go":      {},
+				"request.go":           {},
+				"request_test.go":      {},
+				"validation.go":        {},
+				"validation_test.go":   {},
+				"deprecation.go":       {},
+				"deprecation_test.go":  {},
+				"inflight.go":          {},
+				"inflight_test.go":     {},
+				"auth.go":              {},
+				"auth_test.go":         {},
+				"nullable.go":          {},
+				"nullable_test.go":     {},
+				"optional.go":          {},
+				"optional_test.go":     {},
+				"audit.go":             {},
+				"audit_test.go":        {},
+				"outbox.go":            {},
+				"outbox_test.go":       {},
+				"union.go":             {},
+				"union_test.go":        {},
+				"metacache.go":         {},
+				"metacache_test.go":    {},
+				"responseschemas.json": {},
+				"schemas.json":         {},
+				"version.go":           {},
+				"version_test.go":      {},
+				"mockhttp.go":          {},
+				"mockhttp_test.go":     {},
+			},
+		},
+		{
+			name: "happy path: endpoints filtered",
+			args: args{
+				cfg: Config{
+					OpenAPIReader: bytes.NewReader(openAPIFixture),
+					PathOutput:    createTempDir(),
+					IncludeTags:   []string{"Branch"},
+					ExcludePaths:  []string{"/projects/{project_id}/branches/{branch_id}/roles*"},
+				},
+			},
+			wantErr: false,
+			files: map[string]struct{}{
+				"go.mod":               {},
+				"doc.go":               {},
+				"sdk.go":               {},
+				"sdk_test.go":          {},
+				"error.go":             {},
+				"destructive.go":       {},
+				"destructive_test.go":  {},
+				"codec.go":             {},
+				"codec_test.go":        {},
+				"debug.go":             {},
+				"debug_test.go":        {},
+				"circuit.go":           {},
+				"circuit_test.go":      {},
+				"compression.go":       {},
+				"compression_test.go":  {},
+				"transport.go":         {},
+				"transport_test.go":    {},
+				"events.go":            {},
+				"events_test.go":       {},
+				"metadata.go":          {},
+				"metadata_test.go":     {},
+				"presign.go":           {},
+				"presign_test.go":      {},
+				"request.go":           {},
+				"request_test.go":      {},
+				"validation.go":        {},
+				"validation_test.go":   {},
+				"deprecation.go":       {},
+				"deprecation_test.go":  {},
+				"inflight.go":          {},
+				"inflight_test.go":     {},
+				"auth.go":              {},
+				"auth_test.go":         {},
+				"nullable.go":          {},
+				"nullable_test.go":     {},
+				"optional.go":          {},
+				"optional_test.go":     {},
+				"audit.go":             {},
+				"audit_test.go":        {},
+				"outbox.go":            {},
+				"outbox_test.go":       {},
+				"union.go":             {},
+				"union_test.go":        {},
+				"metacache.go":         {},
+				"metacache_test.go":    {},
+				"responseschemas.json": {},
+				"schemas.json":         {},
+				"version.go":           {},
+				"version_test.go":      {},
+				"mockhttp.go":          {},
+				"mockhttp_test.go":     {},
 			},
 		},
 	}
@@ -127,6 +239,156 @@ func TestRun(t *testing.T) {
 	}
 }
 
+func TestRun_deterministic(t *testing.T) {
+	t.Setenv("SKIP_TEST", "true")
+
+	generate := func() map[string][]byte {
+		dir := t.TempDir()
+		if err := Run(Config{OpenAPIReader: bytes.NewReader(openAPIFixture), PathOutput: dir}); err != nil {
+			t.Fatal(err)
+		}
+
+		o := map[string][]byte{}
+		if err := fs.WalkDir(
+			os.DirFS(dir), ".", func(path string, d fs.DirEntry, err error) error {
+				if err != nil || d.IsDir() {
+					return err
+				}
+				o[path], err = os.ReadFile(dir + "/" + path)
+				return err
+			},
+		); err != nil {
+			t.Fatal(err)
+		}
+		return o
+	}
+
+	first := generate()
+	second := generate()
+
+	assert.Equal(t, len(first), len(second), "number of generated files")
+	for path, want := range first {
+		if !bytes.Equal(want, second[path]) {
+			t.Errorf("%s differs between consecutive runs", path)
+		}
+	}
+}
+
+func TestRun_specVersion(t *testing.T) {
+	t.Setenv("SKIP_TEST", "true")
+	dir := t.TempDir()
+
+	if err := Run(
+		Config{
+			OpenAPIReader: bytes.NewReader(openAPIFixture),
+			PathOutput:    dir,
+			GeneratedAt:   time.Date(2024, 12, 8, 10, 35, 0, 0, time.UTC),
+		},
+	); err != nil {
+		t.Fatal(err)
+	}
+
+	got, err := os.ReadFile(dir + "/version.go")
+	if err != nil {
+		t.Fatal(err)
+	}
+	digest := sha256.Sum256(openAPIFixture)
+	for _, want := range []string{
+		`SpecVersion = "v2"`,
+		`SpecDigest = "sha256:` + hex.EncodeToString(digest[:]) + `"`,
+		`GeneratorVersion = "` + Version + `"`,
+		`GeneratedAt = "2024-12-08T10:35:00Z"`,
+	} {
+		assert.Contains(t, string(got), want)
+	}
+}
+
+func TestRun_responseExamplesTest(t *testing.T) {
+	t.Setenv("SKIP_TEST", "true")
+	dir := t.TempDir()
+
+	if err := Run(Config{OpenAPIReader: bytes.NewReader(openAPIFixture), PathOutput: dir}); err != nil {
+		t.Fatal(err)
+	}
+
+	got, err := os.ReadFile(dir + "/mockhttp_test.go")
+	if err != nil {
+		t.Fatal(err)
+	}
+	for _, want := range []string{
+		"func Test_endpointResponseExamples(t *testing.T) {",
+		`{"/projects", "POST", &CreatedProject{}},`,
+		`{"/projects/{project_id}/branches", "GET", &ListProjectBranchesRespObj{}},`,
+	} {
+		assert.Contains(t, string(got), want)
+	}
+}
+
+func TestRun_regions(t *testing.T) {
+	t.Setenv("SKIP_TEST", "true")
+	dir := t.TempDir()
+
+	regions := `{"regions": [
+		{"region_id": "aws-us-east-2", "name": "AWS US East (Ohio)", "default": true},
+		{"region_id": "azure-eastus2", "name": "Azure East US 2 (Virginia)", "default": false}
+	]}`
+	if err := Run(
+		Config{
+			OpenAPIReader: bytes.NewReader(openAPIFixture), PathOutput: dir, RegionsReader: strings.NewReader(regions),
+		},
+	); err != nil {
+		t.Fatal(err)
+	}
+
+	got, err := os.ReadFile(dir + "/regions/regions.go")
+	if err != nil {
+		t.Fatal(err)
+	}
+	for _, want := range []string{
+		`RegionAWSUSEast2 = "aws-us-east-2"`,
+		`RegionAzureEastus2 = "azure-eastus2"`,
+		"const Default = RegionAWSUSEast2",
+	} {
+		assert.Contains(t, string(got), want)
+	}
+
+	if _, err := os.Stat(dir + "/regions/regions_test.go"); err != nil {
+		t.Fatal(err)
+	}
+
+	if err := Run(
+		Config{
+			OpenAPIReader: bytes.NewReader(openAPIFixture), PathOutput: t.TempDir(),
+			RegionsReader: strings.NewReader(`{"regions": []}`),
+		},
+	); err == nil {
+		t.Fatal("error expected for empty regions")
+	}
+}
+
+func TestRegion_ConstName(t *testing.T) {
+	tests := []struct {
+		id   string
+		want string
+	}{
+		{id: "aws-us-east-1", want: "RegionAWSUSEast1"},
+		{id: "aws-ap-southeast-2", want: "RegionAWSAPSoutheast2"},
+		{id: "azure-eastus2", want: "RegionAzureEastus2"},
+		{id: "azure-gwc", want: "RegionAzureGwc"},
+		{id: "gcp-us-central1", want: "RegionGCPUSCentral1"},
+	}
+	for _, tt := range tests {
+		t.Run(tt.id, func(t *testing.T) {
+			assert.Equal(t, tt.want, Region{ID: tt.id}.ConstName())
+		})
+	}
+}
+
+func Test_sortedKeys(t *testing.T) {
+	assert.Equal(t, []string{"bar", "foo", "qux"}, sortedKeys(map[string]int{"qux": 1, "foo": 2, "bar": 3}))
+	assert.Equal(t, []string{}, sortedKeys(map[string]int{}))
+}
+
 func Test_endpointImplementation_generateMethodImplementation(t *testing.T) {
 	type fields struct {
 		Name                   string
@@ -218,14 +480,17 @@ qux`,
 				ResponseStruct:    &model{name: "ProjectsResponse"},
 				RequestParametersPath: []field{{"project_id", "string",
 					"", "",
-					false, true, true, false}},
+					false, true, true, false, false}},
 			},
 			want: `// GetProject Retrieves information about the specified project.
 // foo bar
 // qux
 func (c Client) GetProject(projectID string) (ProjectsResponse, error) {
+	if projectID == "" {
+		return ProjectsResponse{}, newPathParameterError("project_id")
+	}
 	var v ProjectsResponse
-	if err := c.requestHandler(c.baseURL+"/projects/"+projectID, "GET", nil, &v); err != nil {
+	if err := c.requestHandler(c.baseURL+"/projects/"+url.PathEscape(projectID), "GET", nil, &v); err != nil {
 		return ProjectsResponse{}, err
 	}
 	return v, nil
@@ -241,14 +506,20 @@ func (c Client) GetProject(projectID string) (ProjectsResponse, error) {
 				RequestBodyStruct: nil,
 				ResponseStruct:    &model{name: "DatabasesResponse"},
 				RequestParametersPath: []field{
-					{"project_id", "string", "", "", false, true, true, false},
-					{"branch_id", "string", "", "", false, true, true, false},
+					{"project_id", "string", "", "", false, true, true, false, false},
+					{"branch_id", "string", "", "", false, true, true, false, false},
 				},
 			},
 			want: `// ListProjectBranchDatabases Retrieves a list of databases for the specified branch
 func (c Client) ListProjectBranchDatabases(projectID string, branchID string) (DatabasesResponse, error) {
+	if projectID == "" {
+		return DatabasesResponse{}, newPathParameterError("project_id")
+	}
+	if branchID == "" {
+		return DatabasesResponse{}, newPathParameterError("branch_id")
+	}
 	var v DatabasesResponse
-	if err := c.requestHandler(c.baseURL+"/projects/"+projectID+"/branches/"+branchID+"/databases", "GET", nil, &v); err != nil {
+	if err := c.requestHandler(c.baseURL+"/projects/"+url.PathEscape(projectID)+"/branches/"+url.PathEscape(branchID)+"/databases", "GET", nil, &v); err != nil {
 		return DatabasesResponse{}, err
 	}
 	return v, nil
@@ -263,7 +534,7 @@ func (c Client) ListProjectBranchDatabases(projectID string, branchID string) (D
 				Description:           "Revokes the specified API key",
 				RequestBodyStruct:     nil,
 				ResponseStruct:        &model{name: "ApiKeyRevokeResponse"},
-				RequestParametersPath: []field{{"key_id", "integer", "int64", "", false, true, true, false}},
+				RequestParametersPath: []field{{"key_id", "integer", "int64", "", false, true, true, false, false}},
 			},
 			want: `// RevokeApiKey Revokes the specified API key
 func (c Client) RevokeApiKey(keyID int64) (ApiKeyRevokeResponse, error) {
@@ -1227,6 +1498,89 @@ EndpointResponse
 				},
 			},
 		},
+		{
+			name: "shall extract the union",
+			args: args{
+				spec: openAPISpec{
+					T: openapi3.T{
+						OpenAPI: "3.0.3",
+						Components: openapi3.Components{
+							Schemas: openapi3.Schemas{
+								"Foo": &openapi3.SchemaRef{
+									Value: &openapi3.Schema{
+										OneOf: openapi3.SchemaRefs{
+											{Ref: "#/components/schemas/Bar"},
+											{Value: &openapi3.Schema{Type: "string", Format: "date-time"}},
+											{
+												Value: &openapi3.Schema{
+													Type: "object",
+													Properties: openapi3.Schemas{
+														"qux": {Value: &openapi3.Schema{Type: "boolean"}},
+													},
+												},
+											},
+										},
+										Discriminator: &openapi3.Discriminator{PropertyName: "kind"},
+									},
+								},
+							},
+						},
+					},
+				},
+			},
+			want: map[string]model{
+				"Foo": {
+					name:     "Foo",
+					children: map[string]struct{}{"Bar": {}, "FooVariant3": {}},
+					variants: []unionVariant{
+						{name: "Bar", typ: "Bar", discriminatorValues: []string{"Bar"}},
+						{name: "Time", typ: "time.Time"},
+						{name: "Variant3", typ: "FooVariant3"},
+					},
+					discriminator: "kind",
+				},
+				"FooVariant3": {
+					name: "FooVariant3",
+					fields: map[string]*field{
+						"qux": {k: "qux", v: "boolean"},
+					},
+				},
+			},
+		},
+		{
+			name: "shall extract enum with the values' descriptions",
+			args: args{
+				spec: openAPISpec{
+					T: openapi3.T{
+						OpenAPI: "3.0.3",
+						Components: openapi3.Components{
+							Schemas: openapi3.Schemas{
+								"Foo": &openapi3.SchemaRef{
+									Value: &openapi3.Schema{
+										ExtensionProps: openapi3.ExtensionProps{
+											Extensions: map[string]interface{}{
+												"x-enum-descriptions": json.RawMessage(`["Bar state.",""]`),
+											},
+										},
+										Type: "string",
+										Enum: []interface{}{"bar", "baz"},
+									},
+								},
+							},
+						},
+					},
+				},
+			},
+			want: map[string]model{
+				"Foo": {
+					children:         map[string]struct{}{"bar": {}, "baz": {}},
+					primitive:        fieldType{name: "string"},
+					name:             "Foo",
+					isEnum:           true,
+					enumDescriptions: map[string]string{"bar": "Bar state."},
+				},
+			},
+		},
 	}
 	for _, tt := range tests {
 		t.Run(
@@ -1283,6 +1637,39 @@ FooResponse
 				"type FooResponse struct {\nFoo Foo `json:\"foo\"`\n}",
 			},
 		},
+		{
+			name: "nullable fields",
+			v: models{
+				"FooRequest": model{
+					name: "FooRequest",
+					fields: map[string]*field{
+						"bar": {k: "bar", v: "string", nullable: true},
+						"baz": {k: "baz", v: "string", nullable: true, required: true},
+					},
+				},
+			},
+			want: []string{
+				"type FooRequest struct {\nBar Nullable[string] `json:\"bar,omitempty\"`\nBaz string `json:\"baz\"`\n}",
+			},
+		},
+		{
+			name: "optional fields",
+			v: models{
+				"FooRequest": model{
+					name: "FooRequest",
+					fields: map[string]*field{
+						"bar": {k: "bar", v: "integer"},
+						"baz": {k: "baz", v: "string", nullable: true},
+						"qux": {k: "qux", v: "string", isArray: true},
+					},
+					optionalFields: true,
+				},
+			},
+			want: []string{
+				"type FooRequest struct {\nBar Optional[int] `json:\"bar,omitempty\"`\n" +
+					"Baz Nullable[string] `json:\"baz,omitempty\"`\nQux []string `json:\"qux,omitempty\"`\n}",
+			},
+		},
 		{
 			name: "primitive type",
 			v: models{
@@ -1295,6 +1682,17 @@ FooResponse
 			},
 			want: []string{"type EndpointPoolerMode string"},
 		},
+		{
+			name: "map of refs",
+			v: models{
+				"AnnotationsMapResponseAnnotations": model{
+					name:         "AnnotationsMapResponseAnnotations",
+					mapValueType: "AnnotationData",
+					children:     map[string]struct{}{"AnnotationData": {}},
+				},
+			},
+			want: []string{"type AnnotationsMapResponseAnnotations map[string]AnnotationData"},
+		},
 		{
 			name: "primitive type with docstring",
 			v: models{
@@ -1379,6 +1777,120 @@ FooAwsV2 Foo = "aws_v2"
 )`,
 			},
 		},
+		{
+			name: "shall generate the union",
+			v: models{
+				"Foo": {
+					name: "Foo",
+					variants: []unionVariant{
+						{name: "Bar", typ: "Bar", discriminatorValues: []string{"bar"}},
+						{name: "String", typ: "string"},
+					},
+					discriminator: "kind",
+				},
+			},
+			want: []string{
+				`// Foo holds the value of one of the types: Bar, string.
+type Foo struct {
+bar *Bar
+string *string
+}
+
+// NewFooFromBar returns Foo holding the Bar value.
+func NewFooFromBar(v Bar) Foo {
+return Foo{bar: &v}
+}
+
+// IsBar defines if Foo holds the Bar value.
+func (v Foo) IsBar() bool {
+return v.bar != nil
+}
+
+// AsBar returns the Bar value, and true if Foo holds it.
+func (v Foo) AsBar() (Bar, bool) {
+if v.bar == nil {
+var o Bar
+return o, false
+}
+return *v.bar, true
+}
+
+// NewFooFromString returns Foo holding the string value.
+func NewFooFromString(v string) Foo {
+return Foo{string: &v}
+}
+
+// IsString defines if Foo holds the string value.
+func (v Foo) IsString() bool {
+return v.string != nil
+}
+
+// AsString returns the string value, and true if Foo holds it.
+func (v Foo) AsString() (string, bool) {
+if v.string == nil {
+var o string
+return o, false
+}
+return *v.string, true
+}
+
+// MarshalJSON encodes the value Foo holds, or null if it holds none.
+func (v Foo) MarshalJSON() ([]byte, error) {
+return marshalUnion(v.bar, v.string)
+}
+
+// UnmarshalJSON decodes the value into the first type which matches the value's fields exactly,
+// or into the first type which the value can be decoded to otherwise.
+// The type is selected by the "kind" property if the property defines it.
+func (v *Foo) UnmarshalJSON(b []byte) error {
+*v = Foo{}
+switch unionDiscriminator(b, "kind") {
+case "bar":
+return decodeUnionVariant(b, &v.bar, false)
+}
+for _, strict := range []bool{true, false} {
+if decodeUnionVariant(b, &v.bar, strict) == nil {
+return nil
+}
+if decodeUnionVariant(b, &v.string, strict) == nil {
+return nil
+}
+}
+return unionNoMatchError("Foo")
+}`,
+			},
+		},
+		{
+			name: "shall generate an enum with the values' descriptions",
+			v: models{
+				"Foo": {
+					children:         map[string]struct{}{"bar": {}, "baz": {}},
+					primitive:        fieldType{name: "string"},
+					name:             "Foo",
+					isEnum:           true,
+					enumDescriptions: map[string]string{"bar": "The \"bar\" state.\n"},
+				},
+			},
+			want: []string{
+				`type Foo string
+
+const (
+// FooBar The "bar" state.
+FooBar Foo = "bar"
+FooBaz Foo = "baz"
+)
+
+// FooDescriptions the descriptions of the Foo values.
+var FooDescriptions = map[Foo]string{
+FooBar: "The \"bar\" state.",
+}
+
+// Description returns the description of the value, or the empty string if the value is not documented.
+func (v Foo) Description() string {
+return FooDescriptions[v]
+}`,
+			},
+		},
 	}
 	for _, tt := range tests {
 		t.Run(
@@ -1443,6 +1955,28 @@ func Test_objNameGoConventionExport(t *testing.T) {
 	}
 }
 
+func Test_models_resetNullableFields(t *testing.T) {
+	v := models{
+		"FooRequest":  model{name: "FooRequest", fields: map[string]*field{"foo": {k: "foo", nullable: true}}},
+		"FooResponse": model{name: "FooResponse", fields: map[string]*field{"foo": {k: "foo", nullable: true}}},
+	}
+	v.resetNullableFields(models{"FooResponse": v["FooResponse"]})
+
+	assert.True(t, v["FooRequest"].fields["foo"].nullable)
+	assert.False(t, v["FooResponse"].fields["foo"].nullable)
+}
+
+func Test_models_setOptionalFields(t *testing.T) {
+	v := models{
+		"FooRequest":  model{name: "FooRequest"},
+		"FooResponse": model{name: "FooResponse"},
+	}
+	v.setOptionalFields(models{"FooResponse": v["FooResponse"]})
+
+	assert.True(t, v["FooRequest"].optionalFields)
+	assert.False(t, v["FooResponse"].optionalFields)
+}
+
 func Test_endpointImplementation_generateMethodDefinition(t *testing.T) {
 	type fields struct {
 		Name                        string
@@ -1594,6 +2128,38 @@ func Test_extractStructFromSchemaRef(t *testing.T) {
 	}
 }
 
+func Test_endpointImplementation_generateMockResponse(t *testing.T) {
+	tests := []struct {
+		name string
+		e    endpointImplementation
+		want mockResponse
+	}{
+		{
+			name: "response with content",
+			e: endpointImplementation{
+				ResponsePositivePathExample:    map[string]interface{}{"foo": "bar"},
+				ResponsePositivePathStatusCode: "200",
+			},
+			want: mockResponse{Code: "200", Content: `{"foo":"bar"}`},
+		},
+		{
+			name: "no content",
+			e: endpointImplementation{
+				ResponsePositivePathExample:    map[string]interface{}{"foo": "bar"},
+				ResponsePositivePathStatusCode: "204",
+			},
+			want: mockResponse{Code: "204"},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(
+			tt.name, func(t *testing.T) {
+				assert.Equal(t, tt.want, tt.e.generateMockResponse())
+			},
+		)
+	}
+}
+
 func Test_endpointImplementation_generateMethodImplementationTest(t *testing.T) {
 	type fields struct {
 		Name                           string
@@ -1679,7 +2245,7 @@ func Test_endpointImplementation_generateMethodImplementationTest(t *testing.T)
 	for _, tt := range tests {
 		t.Run(
 			tt.name, func(t *testing.T) {
-				c, err := NewClient(Config{tt.apiKey, NewMockHTTPClient()})
+				c, err := NewClient(Config{Key: tt.apiKey, HTTPClient: NewMockHTTPClient()})
 				if err != nil {
 					panic(err)
 				}
@@ -1776,7 +2342,7 @@ func Test_endpointImplementation_generateMethodImplementationTest(t *testing.T)
 	for _, tt := range tests {
 		t.Run(
 			tt.name, func(t *testing.T) {
-				c, err := NewClient(Config{tt.apiKey, NewMockHTTPClient()})
+				c, err := NewClient(Config{Key: tt.apiKey, HTTPClient: NewMockHTTPClient()})
 				if err != nil {
 					panic(err)
 				}
@@ -2029,3 +2595,43 @@ func Test_field_generateDummy(t *testing.T) {
 		})
 	}
 }
+
+func Test_enumDescriptions(t *testing.T) {
+	tests := []struct {
+		name string
+		ext  interface{}
+		want map[string]string
+	}{
+		{
+			name: "list following the enum's order",
+			ext:  json.RawMessage(`["Bar state.","Baz state."]`),
+			want: map[string]string{"bar": "Bar state.", "baz": "Baz state."},
+		},
+		{
+			name: "object keyed by the values",
+			ext:  map[string]interface{}{"baz": "Baz state."},
+			want: map[string]string{"baz": "Baz state."},
+		},
+		{
+			name: "unhappy path: list length does not match the enum",
+			ext:  []interface{}{"Bar state."},
+		},
+		{
+			name: "unhappy path: not the strings",
+			ext:  json.RawMessage(`[1,2]`),
+		},
+	}
+	for _, tt := range tests {
+		t.Run(
+			tt.name, func(t *testing.T) {
+				v := &openapi3.Schema{
+					ExtensionProps: openapi3.ExtensionProps{
+						Extensions: map[string]interface{}{enumDescriptionsExtension: tt.ext},
+					},
+					Enum: []interface{}{"bar", "baz"},
+				}
+				assert.Equal(t, tt.want, enumDescriptions(v))
+			},
+		)
+	}
+}
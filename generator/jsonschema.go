@@ -0,0 +1,150 @@
+package generator
+
+import (
+	"encoding/json"
+	"errors"
+	"os"
+	"path"
+	"slices"
+	"strings"
+)
+
+// fileNameJSONSchema the name of the generated file with the JSON Schema of the request and response types.
+const fileNameJSONSchema = "schemas.json"
+
+// jsonSchemaDialect the JSON Schema dialect of the generated schemas.
+const jsonSchemaDialect = "https://json-schema.org/draft/2020-12/schema"
+
+// jsonSchemaKeywords the OpenAPI schemas' keywords kept in the JSON Schema, other keywords,
+// e.g. the extensions "x-tags", are dropped. The keywords "nullable" and "example" are converted.
+var jsonSchemaKeywords = []string{
+	"$ref", "type", "format", "title", "description", "default", "deprecated", "readOnly", "writeOnly", "enum",
+	"required", "properties", "additionalProperties", "items", "allOf", "oneOf", "anyOf", "not",
+	"pattern", "minimum", "maximum", "minLength", "maxLength", "minItems", "maxItems", "uniqueItems",
+	"minProperties", "maxProperties",
+}
+
+// extractJSONSchema converts the components' schemas used by the spec's endpoints to the JSON Schema document
+// with the types defined in "$defs", e.g. "schemas.json#/$defs/ProjectCreateRequest".
+func extractJSONSchema(specBytes []byte, spec openAPISpec) (map[string]interface{}, error) {
+	var raw struct {
+		Paths      map[string]map[string]interface{} `json:"paths"`
+		Components map[string]map[string]interface{} `json:"components"`
+	}
+	if err := json.Unmarshal(specBytes, &raw); err != nil {
+		return nil, err
+	}
+
+	defs := map[string]interface{}{}
+	for route, p := range spec.Paths {
+		for method := range p.Operations() {
+			if err := collectJSONSchemaDefs(raw.Paths[route][strings.ToLower(method)], raw.Components, defs); err != nil {
+				return nil, err
+			}
+		}
+	}
+
+	o := map[string]interface{}{"$schema": jsonSchemaDialect, "$defs": defs}
+	if spec.Info != nil {
+		o["title"] = spec.Info.Title + " " + spec.Info.Version
+	}
+	return o, nil
+}
+
+// collectJSONSchemaDefs adds the schemas referenced by the spec's element to defs recursively.
+// The references to other components, e.g. the responses, are followed.
+func collectJSONSchemaDefs(v interface{}, components map[string]map[string]interface{}, defs map[string]interface{}) error {
+	switch vv := v.(type) {
+	case map[string]interface{}:
+		if ref, ok := vv["$ref"].(string); ok {
+			kind, name, ok := strings.Cut(strings.TrimPrefix(ref, "#/components/"), "/")
+			if !ok {
+				return errors.New("unsupported reference " + ref)
+			}
+			if kind == "schemas" {
+				if _, ok := defs[name]; ok {
+					return nil
+				}
+			}
+			el, ok := components[kind][name]
+			if !ok {
+				return errors.New("unknown component " + ref)
+			}
+			if kind == "schemas" {
+				defs[name] = toJSONSchema(el)
+			}
+			return collectJSONSchemaDefs(el, components, defs)
+		}
+		for _, el := range vv {
+			if err := collectJSONSchemaDefs(el, components, defs); err != nil {
+				return err
+			}
+		}
+	case []interface{}:
+		for _, el := range vv {
+			if err := collectJSONSchemaDefs(el, components, defs); err != nil {
+				return err
+			}
+		}
+	}
+	return nil
+}
+
+// toJSONSchema converts the OpenAPI 3.0 schema to JSON Schema.
+func toJSONSchema(v interface{}) interface{} {
+	m, ok := v.(map[string]interface{})
+	if !ok {
+		return v
+	}
+
+	o := map[string]interface{}{}
+	for k, el := range m {
+		if !slices.Contains(jsonSchemaKeywords, k) {
+			continue
+		}
+		switch k {
+		case "$ref":
+			s, _ := el.(string)
+			el = "#/$defs/" + strings.TrimPrefix(s, "#/components/schemas/")
+		case "properties":
+			props, _ := el.(map[string]interface{})
+			converted := make(map[string]interface{}, len(props))
+			for name, p := range props {
+				converted[name] = toJSONSchema(p)
+			}
+			el = converted
+		case "additionalProperties", "items", "not":
+			el = toJSONSchema(el)
+		case "allOf", "oneOf", "anyOf":
+			list, _ := el.([]interface{})
+			converted := make([]interface{}, len(list))
+			for i, s := range list {
+				converted[i] = toJSONSchema(s)
+			}
+			el = converted
+		}
+		o[k] = el
+	}
+
+	if example, ok := m["example"]; ok {
+		o["examples"] = []interface{}{example}
+	}
+
+	if nullable, _ := m["nullable"].(bool); nullable {
+		switch t, ok := o["type"].(string); {
+		case ok:
+			o["type"] = []interface{}{t, "null"}
+		default:
+			o = map[string]interface{}{"anyOf": []interface{}{o, map[string]interface{}{"type": "null"}}}
+		}
+	}
+	return o
+}
+
+func generateJSONSchema(v map[string]interface{}, p string) error {
+	b, err := json.MarshalIndent(v, "", "  ")
+	if err != nil {
+		return err
+	}
+	return os.WriteFile(path.Join(p, fileNameJSONSchema), append(b, '\n'), 0644)
+}
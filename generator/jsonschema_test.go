@@ -0,0 +1,122 @@
+package generator
+
+import (
+	"encoding/json"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func Test_toJSONSchema(t *testing.T) {
+	tests := []struct {
+		name   string
+		schema string
+		want   string
+	}{
+		{
+			name: "object",
+			schema: `{
+	"type": "object",
+	"x-tags": ["Project"],
+	"description": "The project",
+	"required": ["id"],
+	"properties": {
+		"id": {"type": "string", "example": "foo", "pattern": "^[a-z0-9-]{1,60}$"},
+		"owner": {"$ref": "#/components/schemas/Owner"},
+		"tags": {"type": "array", "items": {"$ref": "#/components/schemas/Tag"}}
+	}
+}`,
+			want: `{
+	"type": "object",
+	"description": "The project",
+	"required": ["id"],
+	"properties": {
+		"id": {"type": "string", "examples": ["foo"], "pattern": "^[a-z0-9-]{1,60}$"},
+		"owner": {"$ref": "#/$defs/Owner"},
+		"tags": {"type": "array", "items": {"$ref": "#/$defs/Tag"}}
+	}
+}`,
+		},
+		{
+			name:   "nullable type",
+			schema: `{"type": "string", "format": "date-time", "nullable": true}`,
+			want:   `{"type": ["string", "null"], "format": "date-time"}`,
+		},
+		{
+			name:   "nullable reference",
+			schema: `{"$ref": "#/components/schemas/Owner", "nullable": true}`,
+			want:   `{"anyOf": [{"$ref": "#/$defs/Owner"}, {"type": "null"}]}`,
+		},
+		{
+			name:   "composition",
+			schema: `{"allOf": [{"$ref": "#/components/schemas/Owner"}, {"type": "object", "additionalProperties": {"type": "integer"}}]}`,
+			want:   `{"allOf": [{"$ref": "#/$defs/Owner"}, {"type": "object", "additionalProperties": {"type": "integer"}}]}`,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(
+			tt.name, func(t *testing.T) {
+				var v interface{}
+				if err := json.Unmarshal([]byte(tt.schema), &v); err != nil {
+					t.Fatal(err)
+				}
+				got, err := json.Marshal(toJSONSchema(v))
+				assert.NoError(t, err)
+				assert.JSONEq(t, tt.want, string(got))
+			},
+		)
+	}
+}
+
+func Test_extractJSONSchema(t *testing.T) {
+	specBytes := []byte(`{
+	"openapi": "3.0.3",
+	"info": {"title": "Neon API", "version": "v2"},
+	"paths": {
+		"/projects": {
+			"post": {
+				"requestBody": {
+					"content": {"application/json": {"schema": {"$ref": "#/components/schemas/ProjectCreateRequest"}}}
+				},
+				"responses": {"201": {"$ref": "#/components/responses/CreatedProject"}}
+			}
+		}
+	},
+	"components": {
+		"responses": {
+			"CreatedProject": {
+				"description": "Created project",
+				"content": {"application/json": {"schema": {"$ref": "#/components/schemas/Project"}}}
+			}
+		},
+		"schemas": {
+			"ProjectCreateRequest": {"type": "object", "properties": {"name": {"type": "string"}}},
+			"Project": {"type": "object", "properties": {"settings": {"$ref": "#/components/schemas/Settings"}}},
+			"Settings": {"type": "object"},
+			"Unused": {"type": "object"}
+		}
+	}
+}`)
+
+	var spec openAPISpec
+	if err := spec.UnmarshalJSON(specBytes); err != nil {
+		t.Fatal(err)
+	}
+
+	got, err := extractJSONSchema(specBytes, spec)
+	assert.NoError(t, err)
+
+	b, err := json.Marshal(got)
+	assert.NoError(t, err)
+	assert.JSONEq(
+		t, `{
+	"$schema": "https://json-schema.org/draft/2020-12/schema",
+	"title": "Neon API v2",
+	"$defs": {
+		"ProjectCreateRequest": {"type": "object", "properties": {"name": {"type": "string"}}},
+		"Project": {"type": "object", "properties": {"settings": {"$ref": "#/$defs/Settings"}}},
+		"Settings": {"type": "object"}
+	}
+}`, string(b),
+	)
+}
@@ -0,0 +1,216 @@
+package generator
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"net/http"
+	"slices"
+	"strconv"
+	"strings"
+
+	"github.com/getkin/kin-openapi/openapi3"
+)
+
+// MockExample the response recorded from the Neon API to be served by the generated mock.
+type MockExample struct {
+	Code    int             `json:"code"`
+	Content json.RawMessage `json:"content"`
+}
+
+// MockExamples the recorded responses keyed by the route and the request method.
+type MockExamples map[string]map[string]MockExample
+
+// RecordConfig defines how the responses of the Neon API are recorded.
+type RecordConfig struct {
+	// OpenAPIReader defines the OpenAPI specs input.
+	OpenAPIReader io.Reader
+	// InputFormat defines the format of the OpenAPI spec, JSON or YAML.
+	InputFormat InputFormat
+	// APIKey the key to authenticate against the API, the sandbox account's key is recommended.
+	APIKey string
+	// ServerURL the API's base URL, the spec's server URL is used if not set.
+	ServerURL string
+	// PathParameters the values of the routes' path parameters, e.g. "project_id" of the sandbox project.
+	PathParameters map[string]string
+	// HTTPClient the client to call the API, http.DefaultClient is used if not set.
+	HTTPClient *http.Client
+}
+
+// redactedKeys the keys of the response's attributes which values are redacted upon recording.
+var redactedKeys = []string{"password", "connection_uri", "uri", "token", "secret", "key"}
+
+// RecordMockExamples calls the spec's GET endpoints against the API and records the successful responses.
+// Only the read-only endpoints are called, hence recording does not change the account.
+// The endpoints with the path parameters missing in the config, and with the required query parameters are skipped.
+// The secrets, e.g. passwords and connection URIs, are redacted.
+func RecordMockExamples(ctx context.Context, cfg RecordConfig) (MockExamples, error) {
+	specBytes, err := io.ReadAll(cfg.OpenAPIReader)
+	if err != nil {
+		return nil, errors.New("cannot read OpenAPI spec: " + err.Error())
+	}
+	specBytes, err = specToJSON(specBytes, cfg.InputFormat)
+	if err != nil {
+		return nil, errors.New("cannot convert OpenAPI spec to JSON: " + err.Error())
+	}
+	var spec openAPISpec
+	if err := spec.UnmarshalJSON(specBytes); err != nil {
+		return nil, errors.New("cannot parse OpenAPI spec: " + err.Error())
+	}
+
+	serverURL := cfg.ServerURL
+	if serverURL == "" {
+		if len(spec.Servers) == 0 {
+			return nil, errors.New("no server URL found")
+		}
+		serverURL = spec.Servers[0].URL
+	}
+	client := cfg.HTTPClient
+	if client == nil {
+		client = http.DefaultClient
+	}
+
+	o := MockExamples{}
+	for _, route := range sortedKeys(spec.Paths) {
+		p := spec.Paths[route]
+		if p.Get == nil || hasRequiredQueryParameter(slices.Concat(p.Parameters, p.Get.Parameters)) {
+			continue
+		}
+		u, ok := resolveRoute(route, cfg.PathParameters)
+		if !ok {
+			continue
+		}
+
+		example, err := recordMockExample(ctx, client, serverURL+u, cfg.APIKey)
+		if err != nil {
+			return nil, fmt.Errorf("could not record response of %s: %w", route, err)
+		}
+		if example.Code >= http.StatusOK && example.Code < http.StatusMultipleChoices {
+			o[route] = map[string]MockExample{http.MethodGet: example}
+		}
+	}
+	return o, nil
+}
+
+func hasRequiredQueryParameter(params openapi3.Parameters) bool {
+	for _, p := range params {
+		if p.Value != nil && p.Value.In == openapi3.ParameterInQuery && p.Value.Required {
+			return true
+		}
+	}
+	return false
+}
+
+// resolveRoute substitutes the route's path parameters, false is returned if any parameter is missing.
+func resolveRoute(route string, params map[string]string) (string, bool) {
+	var o strings.Builder
+	for {
+		before, after, ok := strings.Cut(route, "{")
+		o.WriteString(before)
+		if !ok {
+			return o.String(), true
+		}
+		name, rest, ok := strings.Cut(after, "}")
+		if !ok {
+			return "", false
+		}
+		v, ok := params[name]
+		if !ok || v == "" {
+			return "", false
+		}
+		o.WriteString(v)
+		route = rest
+	}
+}
+
+func recordMockExample(ctx context.Context, client *http.Client, u, apiKey string) (MockExample, error) {
+	req, err := http.NewRequestWithContext(ctx, http.MethodGet, u, nil)
+	if err != nil {
+		return MockExample{}, err
+	}
+	req.Header.Set("Accept", "application/json")
+	req.Header.Set("Authorization", "Bearer "+apiKey)
+
+	resp, err := client.Do(req)
+	if err != nil {
+		return MockExample{}, err
+	}
+	defer func() { _ = resp.Body.Close() }()
+
+	body, err := io.ReadAll(resp.Body)
+	if err != nil {
+		return MockExample{}, err
+	}
+
+	o := MockExample{Code: resp.StatusCode}
+	if len(bytes.TrimSpace(body)) == 0 {
+		return o, nil
+	}
+
+	var v interface{}
+	if err := json.Unmarshal(body, &v); err != nil {
+		return MockExample{}, fmt.Errorf("could not parse response: %w", err)
+	}
+	o.Content, err = json.Marshal(redact(v))
+	return o, err
+}
+
+// redact replaces the values of the secret attributes.
+func redact(v interface{}) interface{} {
+	switch vv := v.(type) {
+	case map[string]interface{}:
+		for k, el := range vv {
+			if _, ok := el.(string); ok && slices.Contains(redactedKeys, k) {
+				vv[k] = "REDACTED"
+				continue
+			}
+			vv[k] = redact(el)
+		}
+	case []interface{}:
+		for i, el := range vv {
+			vv[i] = redact(el)
+		}
+	}
+	return v
+}
+
+// readMockExamples reads the recorded responses.
+func readMockExamples(r io.Reader) (MockExamples, error) {
+	var o MockExamples
+	if err := json.NewDecoder(r).Decode(&o); err != nil {
+		return nil, err
+	}
+	for route, methods := range o {
+		for method, example := range methods {
+			if bytes.ContainsRune(example.Content, '`') {
+				return nil, fmt.Errorf("%s %s: response must not contain backticks", method, route)
+			}
+			var buf bytes.Buffer
+			if err := json.Compact(&buf, example.Content); err != nil {
+				return nil, fmt.Errorf("%s %s: %w", method, route, err)
+			}
+			example.Content = buf.Bytes()
+			methods[method] = example
+		}
+	}
+	return o, nil
+}
+
+// apply overrides the mock responses of the spec's endpoints with the recorded responses.
+// The recorded responses of the endpoints missing in the spec are ignored.
+func (e MockExamples) apply(mock map[string]map[string]mockResponse) {
+	for route, methods := range e {
+		for method, example := range methods {
+			resp, ok := mock[route][method]
+			if !ok {
+				continue
+			}
+			resp.Code = strconv.Itoa(example.Code)
+			resp.Content = string(example.Content)
+			mock[route][method] = resp
+		}
+	}
+}
@@ -0,0 +1,98 @@
+package generator
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"net/http"
+	"os"
+	"path"
+	"strings"
+	"text/template"
+	"unicode"
+)
+
+// templateNameRegions the templates of the regions subpackage.
+var templateNameRegions = []string{"regions.go.templ", "regions_test.go.templ"}
+
+// Region the Neon region as returned by the active regions' endpoint.
+type Region struct {
+	ID      string `json:"region_id"`
+	Name    string `json:"name"`
+	Default bool   `json:"default"`
+}
+
+// ConstName returns the name of the region's constant, e.g. RegionAWSUSEast1 for "aws-us-east-1".
+func (r Region) ConstName() string {
+	o := "Region"
+	for _, el := range strings.Split(r.ID, "-") {
+		switch {
+		case el == "":
+		case el == "aws" || el == "gcp" || len(el) == 2 && !unicode.IsDigit(rune(el[1])):
+			o += strings.ToUpper(el)
+		default:
+			o += strings.ToUpper(el[:1]) + el[1:]
+		}
+	}
+	return o
+}
+
+// readRegions reads the regions from the active regions' endpoint response.
+func readRegions(r io.Reader) ([]Region, error) {
+	var v struct {
+		Regions []Region `json:"regions"`
+	}
+	if err := json.NewDecoder(r).Decode(&v); err != nil {
+		return nil, err
+	}
+	if len(v.Regions) == 0 {
+		return nil, errors.New("no regions found")
+	}
+
+	names := map[string]string{}
+	for _, r := range v.Regions {
+		if r.ID == "" {
+			return nil, errors.New("region ID must be set")
+		}
+		if id, ok := names[r.ConstName()]; ok {
+			return nil, fmt.Errorf("regions %s and %s map to the same constant %s", id, r.ID, r.ConstName())
+		}
+		names[r.ConstName()] = r.ID
+	}
+	return v.Regions, nil
+}
+
+// FetchRegions reads the active regions from the Neon API, the response is returned as is.
+func FetchRegions(ctx context.Context, serverURL, apiKey string) ([]byte, error) {
+	req, err := http.NewRequestWithContext(ctx, http.MethodGet, strings.TrimSuffix(serverURL, "/")+"/regions", nil)
+	if err != nil {
+		return nil, err
+	}
+	req.Header.Set("Accept", "application/json")
+	req.Header.Set("Authorization", "Bearer "+apiKey)
+
+	resp, err := http.DefaultClient.Do(req)
+	if err != nil {
+		return nil, err
+	}
+	defer func() { _ = resp.Body.Close() }()
+
+	body, err := io.ReadAll(resp.Body)
+	if err != nil {
+		return nil, err
+	}
+	if resp.StatusCode != http.StatusOK {
+		return nil, fmt.Errorf("unexpected status code %d: %s", resp.StatusCode, body)
+	}
+	return body, nil
+}
+
+// generateRegions generates the regions subpackage in the directory.
+func generateRegions(t *template.Template, regions []Region, p string) error {
+	if err := os.MkdirAll(p, 0755); err != nil {
+		return err
+	}
+	return generateFiles(t, templateNameRegions, regions, path.Clean(p))
+}
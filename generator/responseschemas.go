@@ -0,0 +1,163 @@
+package generator
+
+import (
+	"encoding/json"
+	"errors"
+	"os"
+	"path"
+	"strings"
+)
+
+// fileNameResponseSchemas the name of the generated file with the responses' schemas embedded into the SDK.
+const fileNameResponseSchemas = "responseschemas.json"
+
+// schemaKeywords the schemas' keywords used to validate the responses, other keywords are dropped.
+var schemaKeywords = []string{
+	"$ref", "type", "format", "nullable", "enum", "required", "properties", "additionalProperties", "items",
+	"allOf", "oneOf", "anyOf",
+}
+
+// responseSchemas the subset of the OpenAPI spec to validate the responses.
+type responseSchemas struct {
+	// Responses the schemas of the successful JSON responses keyed by the method and the route,
+	// e.g. "GET /projects/{project_id}", and the status code.
+	Responses map[string]map[string]interface{} `json:"responses"`
+	// Schemas the components' schemas referenced by the responses' schemas.
+	Schemas map[string]interface{} `json:"schemas"`
+}
+
+// extractResponseSchemas extracts the schemas of the successful JSON responses of the spec's endpoints.
+// The raw spec is used to keep the references, the parsed spec defines the endpoints to include.
+func extractResponseSchemas(specBytes []byte, spec openAPISpec) (responseSchemas, error) {
+	var raw struct {
+		Paths      map[string]map[string]json.RawMessage `json:"paths"`
+		Components struct {
+			Schemas   map[string]interface{} `json:"schemas"`
+			Responses map[string]interface{} `json:"responses"`
+		} `json:"components"`
+	}
+	if err := json.Unmarshal(specBytes, &raw); err != nil {
+		return responseSchemas{}, err
+	}
+
+	o := responseSchemas{Responses: map[string]map[string]interface{}{}, Schemas: map[string]interface{}{}}
+	for route, p := range spec.Paths {
+		for method := range p.Operations() {
+			var op struct {
+				Responses map[string]interface{} `json:"responses"`
+			}
+			if err := json.Unmarshal(raw.Paths[route][strings.ToLower(method)], &op); err != nil {
+				return responseSchemas{}, err
+			}
+
+			for code, resp := range op.Responses {
+				if !strings.HasPrefix(code, "2") {
+					continue
+				}
+				if ref, ok := refName(resp, "#/components/responses/"); ok {
+					resp = raw.Components.Responses[ref]
+				}
+				schema, ok := jsonContentSchema(resp)
+				if !ok {
+					continue
+				}
+				if o.Responses[method+" "+route] == nil {
+					o.Responses[method+" "+route] = map[string]interface{}{}
+				}
+				o.Responses[method+" "+route][code] = compactSchema(schema)
+				if err := collectSchemas(schema, raw.Components.Schemas, o.Schemas); err != nil {
+					return responseSchemas{}, err
+				}
+			}
+		}
+	}
+	return o, nil
+}
+
+func jsonContentSchema(resp interface{}) (interface{}, bool) {
+	r, _ := resp.(map[string]interface{})
+	content, _ := r["content"].(map[string]interface{})
+	media, _ := content["application/json"].(map[string]interface{})
+	schema, ok := media["schema"]
+	return schema, ok
+}
+
+func refName(v interface{}, prefix string) (string, bool) {
+	m, _ := v.(map[string]interface{})
+	ref, _ := m["$ref"].(string)
+	return strings.CutPrefix(ref, prefix)
+}
+
+// collectSchemas adds the components' schemas referenced by the schema to the output recursively.
+func collectSchemas(schema interface{}, components, o map[string]interface{}) error {
+	switch v := schema.(type) {
+	case map[string]interface{}:
+		if name, ok := refName(v, "#/components/schemas/"); ok {
+			if _, ok := o[name]; ok {
+				return nil
+			}
+			s, ok := components[name]
+			if !ok {
+				return errors.New("unknown schema " + name)
+			}
+			o[name] = compactSchema(s)
+			return collectSchemas(s, components, o)
+		}
+		for _, el := range v {
+			if err := collectSchemas(el, components, o); err != nil {
+				return err
+			}
+		}
+	case []interface{}:
+		for _, el := range v {
+			if err := collectSchemas(el, components, o); err != nil {
+				return err
+			}
+		}
+	}
+	return nil
+}
+
+// compactSchema drops the keywords not used to validate the responses, e.g. the descriptions and examples.
+func compactSchema(schema interface{}) interface{} {
+	m, ok := schema.(map[string]interface{})
+	if !ok {
+		return schema
+	}
+
+	o := map[string]interface{}{}
+	for _, k := range schemaKeywords {
+		v, ok := m[k]
+		if !ok {
+			continue
+		}
+		switch k {
+		case "properties":
+			props, _ := v.(map[string]interface{})
+			compacted := make(map[string]interface{}, len(props))
+			for name, p := range props {
+				compacted[name] = compactSchema(p)
+			}
+			v = compacted
+		case "additionalProperties", "items":
+			v = compactSchema(v)
+		case "allOf", "oneOf", "anyOf":
+			list, _ := v.([]interface{})
+			compacted := make([]interface{}, len(list))
+			for i, el := range list {
+				compacted[i] = compactSchema(el)
+			}
+			v = compacted
+		}
+		o[k] = v
+	}
+	return o
+}
+
+func generateResponseSchemas(v responseSchemas, p string) error {
+	b, err := json.Marshal(v)
+	if err != nil {
+		return err
+	}
+	return os.WriteFile(path.Join(p, fileNameResponseSchemas), b, 0644)
+}
@@ -0,0 +1,121 @@
+package generator
+
+import (
+	"encoding/json"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func Test_extractResponseSchemas(t *testing.T) {
+	specBytes := []byte(`{
+	"openapi": "3.0.3",
+	"info": {"title": "foo", "version": "v2"},
+	"paths": {
+		"/projects/{project_id}": {
+			"get": {
+				"responses": {
+					"200": {
+						"description": "Returned the project",
+						"content": {"application/json": {"schema": {"$ref": "#/components/schemas/ProjectResponse"}}}
+					},
+					"default": {"$ref": "#/components/responses/GeneralError"}
+				}
+			},
+			"delete": {
+				"responses": {"204": {"description": "Deleted"}}
+			}
+		},
+		"/projects": {
+			"post": {
+				"responses": {"201": {"$ref": "#/components/responses/CreatedProject"}}
+			}
+		}
+	},
+	"components": {
+		"responses": {
+			"GeneralError": {
+				"description": "General error",
+				"content": {"application/json": {"schema": {"$ref": "#/components/schemas/GeneralError"}}}
+			},
+			"CreatedProject": {
+				"description": "Created project",
+				"content": {"application/json": {"schema": {"$ref": "#/components/schemas/ProjectResponse"}}}
+			}
+		},
+		"schemas": {
+			"ProjectResponse": {
+				"type": "object",
+				"required": ["project"],
+				"properties": {"project": {"$ref": "#/components/schemas/Project"}}
+			},
+			"Project": {
+				"type": "object",
+				"description": "The project",
+				"required": ["id"],
+				"properties": {"id": {"type": "string", "description": "The project ID", "example": "foo"}}
+			},
+			"GeneralError": {"type": "object", "properties": {"message": {"type": "string"}}}
+		}
+	}
+}`)
+
+	var spec openAPISpec
+	if err := spec.UnmarshalJSON(specBytes); err != nil {
+		t.Fatal(err)
+	}
+
+	got, err := extractResponseSchemas(specBytes, spec)
+	assert.NoError(t, err)
+
+	b, err := json.Marshal(got)
+	assert.NoError(t, err)
+	assert.JSONEq(
+		t, `{
+	"responses": {
+		"GET /projects/{project_id}": {"200": {"$ref": "#/components/schemas/ProjectResponse"}},
+		"POST /projects": {"201": {"$ref": "#/components/schemas/ProjectResponse"}}
+	},
+	"schemas": {
+		"ProjectResponse": {
+			"type": "object",
+			"required": ["project"],
+			"properties": {"project": {"$ref": "#/components/schemas/Project"}}
+		},
+		"Project": {
+			"type": "object",
+			"required": ["id"],
+			"properties": {"id": {"type": "string"}}
+		}
+	}
+}`, string(b),
+	)
+}
+
+func Test_extractResponseSchemas_unknownSchema(t *testing.T) {
+	specBytes := []byte(`{
+	"openapi": "3.0.3",
+	"info": {"title": "foo", "version": "v2"},
+	"paths": {
+		"/projects": {
+			"get": {
+				"responses": {
+					"200": {
+						"description": "Returned the projects",
+						"content": {"application/json": {"schema": {"type": "array", "items": {"$ref": "#/components/schemas/Project"}}}}
+					}
+				}
+			}
+		}
+	},
+	"components": {"schemas": {"Project": {"type": "object", "properties": {"owner": {"$ref": "#/components/schemas/Owner"}}}}}
+}`)
+
+	var spec openAPISpec
+	if err := spec.UnmarshalJSON(specBytes); err != nil {
+		t.Fatal(err)
+	}
+
+	_, err := extractResponseSchemas(specBytes, spec)
+	assert.EqualError(t, err, "unknown schema Owner")
+}
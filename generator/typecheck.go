@@ -0,0 +1,45 @@
+package generator
+
+import (
+	"errors"
+	"fmt"
+	"strings"
+
+	"golang.org/x/tools/go/packages"
+)
+
+// typeCheckGeneratedCode type-checks the generated package including its tests without running them,
+// hence the spec's regressions breaking the generated code are caught faster than by testGeneratedCode.
+func typeCheckGeneratedCode(p string) error {
+	pkgs, err := packages.Load(
+		&packages.Config{
+			Mode: packages.NeedName | packages.NeedFiles | packages.NeedImports | packages.NeedDeps | packages.NeedTypes |
+				packages.NeedSyntax,
+			Dir:   p,
+			Tests: true,
+		}, ".",
+	)
+	if err != nil {
+		return fmt.Errorf("could not load generated package: %w", err)
+	}
+
+	var (
+		msgs []string
+		seen = map[string]bool{}
+	)
+	packages.Visit(
+		pkgs, nil, func(pkg *packages.Package) {
+			for _, e := range pkg.Errors {
+				// the package's files are type-checked twice: as the package, and as the package under test
+				if msg := e.Error(); !seen[msg] {
+					seen[msg] = true
+					msgs = append(msgs, msg)
+				}
+			}
+		},
+	)
+	if len(msgs) > 0 {
+		return errors.New("failed type check:\n\t" + strings.Join(msgs, "\n\t"))
+	}
+	return nil
+}
@@ -0,0 +1,60 @@
+package generator
+
+import (
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func Test_typeCheckGeneratedCode(t *testing.T) {
+	tests := []struct {
+		name    string
+		files   map[string]string
+		wantErr string
+	}{
+		{
+			name: "happy path",
+			files: map[string]string{
+				"sdk.go":      "package sdk\n\nfunc Foo() string { return \"foo\" }\n",
+				"sdk_test.go": "package sdk\n\nimport \"testing\"\n\nfunc TestFoo(t *testing.T) { _ = Foo() }\n",
+			},
+		},
+		{
+			name: "unhappy path: type error",
+			files: map[string]string{
+				"sdk.go": "package sdk\n\nfunc Foo() string { return 1 }\n",
+			},
+			wantErr: "sdk.go:3:28: cannot use 1",
+		},
+		{
+			name: "unhappy path: type error in tests",
+			files: map[string]string{
+				"sdk.go":      "package sdk\n\nfunc Foo() string { return \"foo\" }\n",
+				"sdk_test.go": "package sdk\n\nimport \"testing\"\n\nfunc TestFoo(t *testing.T) { var _ int = Foo() }\n",
+			},
+			wantErr: "sdk_test.go:5:",
+		},
+	}
+	for _, tt := range tests {
+		t.Run(
+			tt.name, func(t *testing.T) {
+				p := t.TempDir()
+				tt.files["go.mod"] = "module github.com/kislerdm/neon-sdk-go\n\ngo 1.18\n"
+				for k, v := range tt.files {
+					if err := os.WriteFile(filepath.Join(p, k), []byte(v), 0644); err != nil {
+						t.Fatal(err)
+					}
+				}
+
+				err := typeCheckGeneratedCode(p)
+				if tt.wantErr != "" {
+					assert.ErrorContains(t, err, tt.wantErr)
+					return
+				}
+				assert.NoError(t, err)
+			},
+		)
+	}
+}
@@ -0,0 +1,130 @@
+package generator
+
+import (
+	"bytes"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"path/filepath"
+	"strings"
+
+	"gopkg.in/yaml.v3"
+)
+
+// InputFormat defines the format of the OpenAPI spec.
+type InputFormat string
+
+const (
+	// InputFormatAuto detects the format by the spec's content.
+	InputFormatAuto InputFormat = ""
+	InputFormatJSON InputFormat = "json"
+	InputFormatYAML InputFormat = "yaml"
+)
+
+// InputFormatFromPath defines the format of the OpenAPI spec by the file extension.
+// InputFormatAuto is returned for unknown extensions.
+func InputFormatFromPath(p string) InputFormat {
+	switch strings.ToLower(filepath.Ext(p)) {
+	case ".json":
+		return InputFormatJSON
+	case ".yaml", ".yml":
+		return InputFormatYAML
+	default:
+		return InputFormatAuto
+	}
+}
+
+// specToJSON converts the spec to JSON unless it is already JSON.
+func specToJSON(specBytes []byte, format InputFormat) ([]byte, error) {
+	switch format {
+	case InputFormatJSON:
+		return specBytes, nil
+	case InputFormatYAML:
+		return yamlToJSON(specBytes)
+	case InputFormatAuto:
+		if bytes.HasPrefix(bytes.TrimSpace(specBytes), []byte("{")) {
+			return specBytes, nil
+		}
+		return yamlToJSON(specBytes)
+	default:
+		return nil, errors.New("unknown input format " + string(format))
+	}
+}
+
+// yamlToJSON converts YAML to JSON preserving the order of the objects' keys,
+// hence the endpoints are generated in the order of the spec.
+func yamlToJSON(in []byte) ([]byte, error) {
+	var n yaml.Node
+	if err := yaml.Unmarshal(in, &n); err != nil {
+		return nil, err
+	}
+
+	var buf bytes.Buffer
+	if err := writeYAMLNodeAsJSON(&buf, &n); err != nil {
+		return nil, err
+	}
+	return buf.Bytes(), nil
+}
+
+func writeYAMLNodeAsJSON(buf *bytes.Buffer, n *yaml.Node) error {
+	switch n.Kind {
+	case yaml.DocumentNode:
+		if len(n.Content) == 0 {
+			return errors.New("empty document")
+		}
+		return writeYAMLNodeAsJSON(buf, n.Content[0])
+
+	case yaml.AliasNode:
+		return writeYAMLNodeAsJSON(buf, n.Alias)
+
+	case yaml.MappingNode:
+		buf.WriteByte('{')
+		for i := 0; i+1 < len(n.Content); i += 2 {
+			if i > 0 {
+				buf.WriteByte(',')
+			}
+			k, err := json.Marshal(n.Content[i].Value)
+			if err != nil {
+				return err
+			}
+			buf.Write(k)
+			buf.WriteByte(':')
+			if err := writeYAMLNodeAsJSON(buf, n.Content[i+1]); err != nil {
+				return err
+			}
+		}
+		buf.WriteByte('}')
+		return nil
+
+	case yaml.SequenceNode:
+		buf.WriteByte('[')
+		for i, el := range n.Content {
+			if i > 0 {
+				buf.WriteByte(',')
+			}
+			if err := writeYAMLNodeAsJSON(buf, el); err != nil {
+				return err
+			}
+		}
+		buf.WriteByte(']')
+		return nil
+
+	case yaml.ScalarNode:
+		var v interface{} = n.Value
+		switch n.ShortTag() {
+		case "!!int", "!!float", "!!bool", "!!null":
+			if err := n.Decode(&v); err != nil {
+				return err
+			}
+		}
+		o, err := json.Marshal(v)
+		if err != nil {
+			return fmt.Errorf("line %d: %w", n.Line, err)
+		}
+		buf.Write(o)
+		return nil
+
+	default:
+		return fmt.Errorf("line %d: unsupported YAML node", n.Line)
+	}
+}
@@ -0,0 +1,146 @@
+package generator
+
+import (
+	"bytes"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"gopkg.in/yaml.v3"
+)
+
+func Test_yamlToJSON(t *testing.T) {
+	tests := []struct {
+		name    string
+		in      string
+		want    string
+		wantErr bool
+	}{
+		{
+			name: "keys order is preserved",
+			in: `paths:
+  /qux: {}
+  /foo: {}
+  /bar: {}
+`,
+			want: `{"paths":{"/qux":{},"/foo":{},"/bar":{}}}`,
+		},
+		{
+			name: "scalars",
+			in: `a: 1
+b: 1.5
+c: true
+d: null
+e: "1"
+f: 2022-11-23
+g: foo
+`,
+			want: `{"a":1,"b":1.5,"c":true,"d":null,"e":"1","f":"2022-11-23","g":"foo"}`,
+		},
+		{
+			name: "sequence and alias",
+			in: `a: &x [foo, bar]
+b: *x
+`,
+			want: `{"a":["foo","bar"],"b":["foo","bar"]}`,
+		},
+		{
+			name:    "invalid YAML",
+			in:      `a: [`,
+			wantErr: true,
+		},
+		{
+			name:    "empty document",
+			in:      ``,
+			wantErr: true,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(
+			tt.name, func(t *testing.T) {
+				got, err := yamlToJSON([]byte(tt.in))
+				if (err != nil) != tt.wantErr {
+					t.Fatalf("yamlToJSON() error = %v, wantErr %v", err, tt.wantErr)
+				}
+				if !tt.wantErr {
+					assert.JSONEq(t, tt.want, string(got))
+					assert.Equal(t, tt.want, string(got))
+				}
+			},
+		)
+	}
+}
+
+func Test_specToJSON(t *testing.T) {
+	var n yaml.Node
+	if err := yaml.Unmarshal(openAPIFixture, &n); err != nil {
+		t.Fatal(err)
+	}
+	resetYAMLStyle(&n)
+	specYAML, err := yaml.Marshal(&n)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if bytes.HasPrefix(specYAML, []byte("{")) {
+		t.Fatal("block style YAML expected")
+	}
+
+	wantRoutes, err := extractOrderedEndpointRoutes(openAPIFixture)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	tests := []struct {
+		name   string
+		in     []byte
+		format InputFormat
+	}{
+		{name: "JSON", in: openAPIFixture, format: InputFormatJSON},
+		{name: "YAML", in: specYAML, format: InputFormatYAML},
+		{name: "JSON detected", in: openAPIFixture, format: InputFormatAuto},
+		{name: "YAML detected", in: specYAML, format: InputFormatAuto},
+	}
+	for _, tt := range tests {
+		t.Run(
+			tt.name, func(t *testing.T) {
+				got, err := specToJSON(tt.in, tt.format)
+				if err != nil {
+					t.Fatal(err)
+				}
+				assert.JSONEq(t, string(openAPIFixture), string(got))
+
+				routes, err := extractOrderedEndpointRoutes(got)
+				if err != nil {
+					t.Fatal(err)
+				}
+				assert.Equal(t, wantRoutes, routes)
+			},
+		)
+	}
+}
+
+// resetYAMLStyle sets the block style to the nodes parsed from JSON.
+func resetYAMLStyle(n *yaml.Node) {
+	n.Style = 0
+	for _, el := range n.Content {
+		resetYAMLStyle(el)
+	}
+}
+
+func TestInputFormatFromPath(t *testing.T) {
+	tests := []struct {
+		path string
+		want InputFormat
+	}{
+		{path: "openAPIDefinition.json", want: InputFormatJSON},
+		{path: "/tmp/spec.YAML", want: InputFormatYAML},
+		{path: "spec.yml", want: InputFormatYAML},
+		{path: "spec", want: InputFormatAuto},
+	}
+	for _, tt := range tests {
+		t.Run(
+			tt.path, func(t *testing.T) {
+				assert.Equal(t, tt.want, InputFormatFromPath(tt.path))
+			},
+		)
+	}
+}
@@ -0,0 +1,66 @@
+package sdk
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"net/http"
+	"time"
+)
+
+// ErrUnauthenticated the error returned when the API rejects the client's credentials.
+var ErrUnauthenticated = errors.New("credentials rejected")
+
+// HealthReport the diagnostics of the client's connectivity and credentials.
+type HealthReport struct {
+	CheckedAt time.Time `json:"checked_at"`
+	// Latency the duration of the call to read the current user's details.
+	Latency time.Duration `json:"latency"`
+	// Reachable defines if the API responded.
+	Reachable bool `json:"reachable"`
+	// Authenticated defines if the API accepted the credentials.
+	Authenticated bool `json:"authenticated"`
+	// User the identity of the credentials' owner, nil if the check failed.
+	User *CurrentUserInfoResponse `json:"user,omitempty"`
+	// Organizations the organizations the user is the member of.
+	Organizations []Organization `json:"organizations,omitempty"`
+}
+
+// HealthCheck verifies that the API is reachable and accepts the client's credentials by reading the current user's
+// details and organizations, e.g. to check the configuration when the service starts. The report is returned along
+// with the error if the check fails. The error wraps ErrUnauthenticated if the credentials are rejected.
+func (c Client) HealthCheck(ctx context.Context) (HealthReport, error) {
+	o := HealthReport{CheckedAt: time.Now().UTC()}
+	if err := ctx.Err(); err != nil {
+		return o, err
+	}
+
+	start := time.Now()
+	user, err := c.GetCurrentUserInfo()
+	o.Latency = time.Since(start)
+
+	var e Error
+	switch {
+	case err == nil:
+		o.Reachable, o.Authenticated = true, true
+		o.User = &user
+	case errors.As(err, &e):
+		o.Reachable = true
+		if e.HTTPCode == http.StatusUnauthorized {
+			return o, fmt.Errorf("%w: %v", ErrUnauthenticated, err)
+		}
+		return o, fmt.Errorf("could not read current user: %w", err)
+	default:
+		return o, fmt.Errorf("could not reach API: %w", err)
+	}
+
+	if err := ctx.Err(); err != nil {
+		return o, err
+	}
+	orgs, err := c.GetCurrentUserOrganizations()
+	if err != nil {
+		return o, fmt.Errorf("could not list organizations of current user: %w", err)
+	}
+	o.Organizations = orgs.Organizations
+	return o, nil
+}
@@ -0,0 +1,60 @@
+package sdk
+
+import (
+	"context"
+	"errors"
+	"net/http"
+	"testing"
+)
+
+func TestClient_HealthCheck(t *testing.T) {
+	tests := []struct {
+		name              string
+		api               *fakeAPI
+		wantReachable     bool
+		wantAuthenticated bool
+		wantErr           error
+	}{
+		{
+			name: "healthy",
+			api: newFakeAPI().
+				on(http.MethodGet, "/users/me", http.StatusOK, `{"id":"user-foo","email":"foo@bar.com"}`).
+				on(
+					http.MethodGet, "/users/me/organizations", http.StatusOK,
+					`{"organizations":[{"id":"org-foo","name":"Foo"}]}`,
+				),
+			wantReachable:     true,
+			wantAuthenticated: true,
+		},
+		{
+			name: "unhappy path: credentials rejected",
+			api: newFakeAPI().on(
+				http.MethodGet, "/users/me", http.StatusUnauthorized, `{"code":"","message":"authentication required"}`,
+			),
+			wantReachable: true,
+			wantErr:       ErrUnauthenticated,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(
+			tt.name, func(t *testing.T) {
+				c, err := NewClient(Config{Key: "foo", HTTPClient: tt.api})
+				if err != nil {
+					t.Fatal(err)
+				}
+
+				got, err := c.HealthCheck(context.TODO())
+				if !errors.Is(err, tt.wantErr) {
+					t.Fatalf("HealthCheck() error = %v, want %v", err, tt.wantErr)
+				}
+				if got.Reachable != tt.wantReachable || got.Authenticated != tt.wantAuthenticated {
+					t.Errorf("unexpected report: %+v", got)
+				}
+				if tt.wantAuthenticated && (got.User == nil || got.User.ID != "user-foo" ||
+					len(got.Organizations) != 1 || got.Organizations[0].ID != "org-foo") {
+					t.Errorf("unexpected identity: %+v", got)
+				}
+			},
+		)
+	}
+}
@@ -0,0 +1,116 @@
+package sdk
+
+import (
+	"net/http"
+	"strings"
+	"sync"
+)
+
+// ConcurrencyLimit defines the maximum number of in-flight requests.
+// The requests exceeding the limits wait until the slot is released, or the request's context is cancelled.
+type ConcurrencyLimit struct {
+	// PerProject the maximum number of in-flight requests per project ID, unlimited if it is not positive.
+	// It prevents a single busy project from starving the others, e.g. in the multi-tenant controller.
+	PerProject int
+	// Total the maximum number of in-flight requests overall, unlimited if it is not positive.
+	Total int
+}
+
+func (l ConcurrencyLimit) enabled() bool {
+	return l.PerProject > 0 || l.Total > 0
+}
+
+// NewConcurrencyLimitedTransport returns the transport limiting the in-flight requests sent via next,
+// or via http.DefaultTransport if next is nil. The project's requests are identified by the project ID
+// in the path, the requests without the project ID are only subject to the total limit.
+func NewConcurrencyLimitedTransport(next http.RoundTripper, limit ConcurrencyLimit) http.RoundTripper {
+	if next == nil {
+		next = http.DefaultTransport
+	}
+	o := &concurrencyLimitedTransport{
+		next:     next,
+		limit:    limit,
+		projects: map[string]*projectSlots{},
+	}
+	if limit.Total > 0 {
+		o.total = make(chan struct{}, limit.Total)
+	}
+	return o
+}
+
+type concurrencyLimitedTransport struct {
+	next  http.RoundTripper
+	limit ConcurrencyLimit
+	total chan struct{}
+
+	mu       sync.Mutex
+	projects map[string]*projectSlots
+}
+
+// projectSlots the project's semaphore, it is removed when no requests of the project are in-flight, or waiting.
+type projectSlots struct {
+	sem   chan struct{}
+	users int
+}
+
+func (t *concurrencyLimitedTransport) RoundTrip(req *http.Request) (*http.Response, error) {
+	if projectID := projectIDFromPath(req.URL.Path); projectID != "" && t.limit.PerProject > 0 {
+		slots := t.acquireProject(projectID)
+		defer t.releaseProject(projectID)
+
+		select {
+		case slots.sem <- struct{}{}:
+			defer func() { <-slots.sem }()
+		case <-req.Context().Done():
+			return nil, req.Context().Err()
+		}
+	}
+
+	if t.total != nil {
+		select {
+		case t.total <- struct{}{}:
+			defer func() { <-t.total }()
+		case <-req.Context().Done():
+			return nil, req.Context().Err()
+		}
+	}
+
+	return t.next.RoundTrip(req)
+}
+
+func (t *concurrencyLimitedTransport) acquireProject(projectID string) *projectSlots {
+	t.mu.Lock()
+	defer t.mu.Unlock()
+
+	o, ok := t.projects[projectID]
+	if !ok {
+		o = &projectSlots{sem: make(chan struct{}, t.limit.PerProject)}
+		t.projects[projectID] = o
+	}
+	o.users++
+	return o
+}
+
+func (t *concurrencyLimitedTransport) releaseProject(projectID string) {
+	t.mu.Lock()
+	defer t.mu.Unlock()
+
+	o := t.projects[projectID]
+	if o.users--; o.users == 0 {
+		delete(t.projects, projectID)
+	}
+}
+
+// projectIDFromPath returns the path element following "projects", empty if the path has no project ID.
+func projectIDFromPath(p string) string {
+	els := strings.Split(strings.Trim(p, "/"), "/")
+	for i := 0; i+1 < len(els); i++ {
+		if els[i] == "projects" {
+			if els[i+1] == "shared" {
+				return ""
+			}
+			return els[i+1]
+		}
+	}
+	return ""
+}
@@ -0,0 +1,164 @@
+package sdk
+
+import (
+	"context"
+	"errors"
+	"io"
+	"net/http"
+	"strings"
+	"sync"
+	"testing"
+	"time"
+)
+
+func Test_projectIDFromPath(t *testing.T) {
+	tests := []struct {
+		path string
+		want string
+	}{
+		{path: "/api/v2/projects", want: ""},
+		{path: "/api/v2/projects/shared", want: ""},
+		{path: "/api/v2/projects/foo", want: "foo"},
+		{path: "/api/v2/projects/foo/branches/bar", want: "foo"},
+		{path: "/api/v2/users/me", want: ""},
+	}
+	for _, tt := range tests {
+		t.Run(
+			tt.path, func(t *testing.T) {
+				if got := projectIDFromPath(tt.path); got != tt.want {
+					t.Errorf("projectIDFromPath() = %v, want %v", got, tt.want)
+				}
+			},
+		)
+	}
+}
+
+// blockingRoundTripper holds the requests until release is closed and tracks the in-flight requests per project.
+type blockingRoundTripper struct {
+	release chan struct{}
+
+	mu       sync.Mutex
+	inFlight map[string]int
+	max      map[string]int
+}
+
+func newBlockingRoundTripper() *blockingRoundTripper {
+	return &blockingRoundTripper{release: make(chan struct{}), inFlight: map[string]int{}, max: map[string]int{}}
+}
+
+func (rt *blockingRoundTripper) RoundTrip(req *http.Request) (*http.Response, error) {
+	projectID := projectIDFromPath(req.URL.Path)
+
+	rt.mu.Lock()
+	rt.inFlight[projectID]++
+	if rt.inFlight[projectID] > rt.max[projectID] {
+		rt.max[projectID] = rt.inFlight[projectID]
+	}
+	rt.mu.Unlock()
+
+	<-rt.release
+
+	rt.mu.Lock()
+	rt.inFlight[projectID]--
+	rt.mu.Unlock()
+
+	return &http.Response{
+		StatusCode: http.StatusOK, Body: io.NopCloser(strings.NewReader(`{}`)), Request: req,
+	}, nil
+}
+
+func (rt *blockingRoundTripper) total() int {
+	rt.mu.Lock()
+	defer rt.mu.Unlock()
+	var o int
+	for _, v := range rt.inFlight {
+		o += v
+	}
+	return o
+}
+
+func TestNewConcurrencyLimitedTransport(t *testing.T) {
+	rt := newBlockingRoundTripper()
+	transport := NewConcurrencyLimitedTransport(rt, ConcurrencyLimit{PerProject: 2, Total: 3})
+
+	send := func(wg *sync.WaitGroup, projectID string) {
+		defer wg.Done()
+		req, _ := http.NewRequest(http.MethodGet, baseURL+"/projects/"+projectID, nil)
+		res, err := transport.RoundTrip(req)
+		if err != nil {
+			t.Error(err)
+			return
+		}
+		_ = res.Body.Close()
+	}
+
+	var wg sync.WaitGroup
+	for i := 0; i < 5; i++ {
+		wg.Add(1)
+		go send(&wg, "foo")
+	}
+	waitFor(t, func() bool { return rt.total() == 2 })
+
+	// the busy project does not starve the other one
+	wg.Add(1)
+	go send(&wg, "bar")
+	waitFor(t, func() bool { return rt.total() == 3 })
+
+	close(rt.release)
+	wg.Wait()
+
+	if rt.max["foo"] != 2 || rt.max["bar"] != 1 {
+		t.Errorf("unexpected maximum of in-flight requests: %v", rt.max)
+	}
+
+	l := transport.(*concurrencyLimitedTransport)
+	if len(l.projects) != 0 {
+		t.Errorf("the projects' semaphores are expected to be released, got %v", l.projects)
+	}
+}
+
+func TestNewConcurrencyLimitedTransport_contextCancelled(t *testing.T) {
+	rt := newBlockingRoundTripper()
+	defer close(rt.release)
+	transport := NewConcurrencyLimitedTransport(rt, ConcurrencyLimit{PerProject: 1})
+
+	go func() {
+		req, _ := http.NewRequest(http.MethodGet, baseURL+"/projects/foo", nil)
+		_, _ = transport.RoundTrip(req)
+	}()
+	waitFor(t, func() bool { return rt.total() == 1 })
+
+	ctx, cancel := context.WithTimeout(context.Background(), 10*time.Millisecond)
+	defer cancel()
+	req, _ := http.NewRequestWithContext(ctx, http.MethodGet, baseURL+"/projects/foo/branches", nil)
+	if _, err := transport.RoundTrip(req); !errors.Is(err, context.DeadlineExceeded) {
+		t.Errorf("context.DeadlineExceeded is expected, got %v", err)
+	}
+}
+
+func TestNewClient_ConcurrencyLimit(t *testing.T) {
+	c, err := NewClient(Config{Key: "foo", ConcurrencyLimit: &ConcurrencyLimit{PerProject: 1}})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if _, ok := c.cfg.HTTPClient.(*http.Client).Transport.(*concurrencyLimitedTransport); !ok {
+		t.Error("concurrency limited transport is expected")
+	}
+
+	if _, err := NewClient(
+		Config{Key: "foo", HTTPClient: &http.Client{}, ConcurrencyLimit: &ConcurrencyLimit{PerProject: 1}},
+	); err == nil {
+		t.Error("error is expected for the custom HTTP client")
+	}
+}
+
+func waitFor(t *testing.T, cond func() bool) {
+	t.Helper()
+	deadline := time.Now().Add(time.Second)
+	for !cond() {
+		if time.Now().After(deadline) {
+			t.Fatal("condition is not met")
+		}
+		time.Sleep(time.Millisecond)
+	}
+}
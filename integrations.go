@@ -0,0 +1,61 @@
+package sdk
+
+// Map returns the installed applications keyed by the project ID.
+// The list of names is expected, the single name, and the objects with the attribute "name" are accepted too.
+// The values of other types are skipped.
+func (v ProjectsApplicationsMapResponseApplications) Map() map[string][]string {
+	return stringListsMap(v)
+}
+
+// Get returns the applications installed in the project, nil if the project has none.
+func (v ProjectsApplicationsMapResponseApplications) Get(projectID string) []string {
+	return stringList(v[projectID])
+}
+
+// Map returns the installed integrations keyed by the project ID.
+// The list of names is expected, the single name, and the objects with the attribute "name" are accepted too.
+// The values of other types are skipped.
+func (v ProjectsIntegrationsMapResponseIntegrations) Map() map[string][]string {
+	return stringListsMap(v)
+}
+
+// Get returns the integrations installed in the project, nil if the project has none.
+func (v ProjectsIntegrationsMapResponseIntegrations) Get(projectID string) []string {
+	return stringList(v[projectID])
+}
+
+func stringListsMap(v map[string]interface{}) map[string][]string {
+	o := make(map[string][]string, len(v))
+	for k, el := range v {
+		if names := stringList(el); len(names) > 0 {
+			o[k] = names
+		}
+	}
+	return o
+}
+
+// stringList converts the untyped JSON value to the list of names.
+func stringList(v interface{}) []string {
+	switch vv := v.(type) {
+	case []string:
+		return vv
+	case string:
+		if vv == "" {
+			return nil
+		}
+		return []string{vv}
+	case map[string]interface{}:
+		if name, ok := vv["name"].(string); ok && name != "" {
+			return []string{name}
+		}
+		return nil
+	case []interface{}:
+		var o []string
+		for _, el := range vv {
+			o = append(o, stringList(el)...)
+		}
+		return o
+	default:
+		return nil
+	}
+}
@@ -0,0 +1,58 @@
+package sdk
+
+import (
+	"encoding/json"
+	"reflect"
+	"testing"
+)
+
+func TestProjectsApplicationsMapResponseApplications_Map(t *testing.T) {
+	tests := []struct {
+		name string
+		resp string
+		want map[string][]string
+	}{
+		{
+			name: "list of names",
+			resp: `{"applications":{"foo":["vercel","github"],"bar":["datadog"]}}`,
+			want: map[string][]string{"foo": {"vercel", "github"}, "bar": {"datadog"}},
+		},
+		{
+			name: "fallbacks",
+			resp: `{"applications":{"foo":"vercel","bar":[{"name":"github"},"datadog",1,null],"baz":[],"qux":null}}`,
+			want: map[string][]string{"foo": {"vercel"}, "bar": {"github", "datadog"}},
+		},
+		{
+			name: "empty",
+			resp: `{"applications":{}}`,
+			want: map[string][]string{},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(
+			tt.name, func(t *testing.T) {
+				var v ProjectsApplicationsMapResponse
+				if err := json.Unmarshal([]byte(tt.resp), &v); err != nil {
+					t.Fatal(err)
+				}
+				if got := v.Applications.Map(); !reflect.DeepEqual(got, tt.want) {
+					t.Errorf("Map() = %v, want %v", got, tt.want)
+				}
+			},
+		)
+	}
+}
+
+func TestProjectsIntegrationsMapResponseIntegrations_Get(t *testing.T) {
+	var v ProjectsIntegrationsMapResponse
+	if err := json.Unmarshal([]byte(`{"integrations":{"foo":["github"]}}`), &v); err != nil {
+		t.Fatal(err)
+	}
+
+	if got := v.Integrations.Get("foo"); !reflect.DeepEqual(got, []string{"github"}) {
+		t.Errorf("Get() = %v, want [github]", got)
+	}
+	if got := v.Integrations.Get("bar"); got != nil {
+		t.Errorf("Get() = %v, want nil", got)
+	}
+}
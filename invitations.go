@@ -0,0 +1,54 @@
+package sdk
+
+import (
+	"context"
+	"fmt"
+	"time"
+)
+
+// StaleOrganizationInvitations returns the organization's pending invitations created earlier than maxAge ago.
+func (c Client) StaleOrganizationInvitations(
+	ctx context.Context, orgID string, maxAge time.Duration,
+) ([]Invitation, error) {
+	var (
+		o         []Invitation
+		threshold = time.Now().Add(-maxAge)
+	)
+	if _, err := c.ListAllOrganizationInvitations(
+		ctx, orgID, func(inv Invitation) error {
+			if inv.InvitedAt.Before(threshold) {
+				o = append(o, inv)
+			}
+			return nil
+		},
+	); err != nil {
+		return nil, err
+	}
+	return o, nil
+}
+
+// ResendOrganizationInvitations re-sends the pending invitations by creating them anew with the same emails
+// and roles, hence every invited user receives the email notification again. The created invitations are returned.
+// Note that the API does not define the endpoint to cancel the invitation, hence the stale invitations
+// cannot be revoked using the SDK; the invitation must be cancelled in the console.
+func (c Client) ResendOrganizationInvitations(
+	ctx context.Context, orgID string, invitations []Invitation,
+) ([]Invitation, error) {
+	if len(invitations) == 0 {
+		return nil, nil
+	}
+	if err := ctx.Err(); err != nil {
+		return nil, err
+	}
+
+	req := OrganizationInvitesCreateRequest{Invitations: make([]OrganizationInviteCreateRequest, len(invitations))}
+	for i, inv := range invitations {
+		req.Invitations[i] = OrganizationInviteCreateRequest{Email: inv.Email, Role: inv.Role}
+	}
+
+	resp, err := c.CreateOrganizationInvitations(orgID, req)
+	if err != nil {
+		return nil, fmt.Errorf("could not resend %d invitations of organization %s: %w", len(invitations), orgID, err)
+	}
+	return resp.Invitations, nil
+}
@@ -0,0 +1,69 @@
+package sdk
+
+import (
+	"context"
+	"encoding/json"
+	"io"
+	"net/http"
+	"reflect"
+	"testing"
+	"time"
+)
+
+func TestClient_StaleOrganizationInvitations(t *testing.T) {
+	fresh := time.Now().Add(-time.Hour).UTC().Format(time.RFC3339)
+	api := newFakeAPI().on(
+		http.MethodGet, "/organizations/org-foo/invitations", http.StatusOK, `{"invitations":[
+{"id":"inv1","email":"alice@example.com","role":"member","invited_at":"2024-01-01T00:00:00Z"},
+{"id":"inv2","email":"bob@example.com","role":"admin","invited_at":"`+fresh+`"}
+]}`,
+	)
+	c, err := NewClient(Config{Key: "foo", HTTPClient: api})
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	got, err := c.StaleOrganizationInvitations(context.TODO(), "org-foo", 7*24*time.Hour)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(got) != 1 || got[0].ID != "inv1" {
+		t.Errorf("unexpected stale invitations: %v", got)
+	}
+}
+
+func TestClient_ResendOrganizationInvitations(t *testing.T) {
+	var gotReq OrganizationInvitesCreateRequest
+	api := newFakeAPI().onFunc(
+		http.MethodPost, "/organizations/org-foo/invitations", func(req *http.Request) (int, string) {
+			b, _ := io.ReadAll(req.Body)
+			_ = json.Unmarshal(b, &gotReq)
+			return http.StatusOK, `{"invitations":[{"id":"inv3","email":"alice@example.com","role":"member"}]}`
+		},
+	)
+	c, err := NewClient(Config{Key: "foo", HTTPClient: api})
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	got, err := c.ResendOrganizationInvitations(
+		context.TODO(), "org-foo", []Invitation{{ID: "inv1", Email: "alice@example.com", Role: MemberRoleMember}},
+	)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(got) != 1 || got[0].ID != "inv3" {
+		t.Errorf("unexpected invitations: %v", got)
+	}
+
+	want := OrganizationInvitesCreateRequest{
+		Invitations: []OrganizationInviteCreateRequest{{Email: "alice@example.com", Role: MemberRoleMember}},
+	}
+	if !reflect.DeepEqual(gotReq, want) {
+		t.Errorf("unexpected request: %v, want %v", gotReq, want)
+	}
+
+	if got, err := c.ResendOrganizationInvitations(context.TODO(), "org-foo", nil); err != nil || got != nil {
+		t.Errorf("noop expected, got %v, %v", got, err)
+	}
+}
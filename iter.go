@@ -0,0 +1,89 @@
+//go:build go1.23
+
+package sdk
+
+import (
+	"context"
+	"iter"
+)
+
+// Projects iterates over the projects paging through ListProjects.
+// The iteration stops after the first error, which is yielded with the zero project.
+//
+//	for p, err := range client.Projects(ctx, nil, nil) {
+//		if err != nil {
+//			return err
+//		}
+//		fmt.Println(p.Name)
+//	}
+func (c Client) Projects(ctx context.Context, search *string, orgID *string) iter.Seq2[ProjectListItem, error] {
+	return pages(
+		ctx, func(cursor *string) (Page[ProjectListItem], error) {
+			return c.ListProjectsPage(cursor, listProjectsPageLimit, search, orgID)
+		},
+	)
+}
+
+// OrganizationProjects iterates over the organization's projects paging through ListProjects,
+// see ListOrganizationProjects. The error is yielded if the organization ID is empty.
+func (c Client) OrganizationProjects(ctx context.Context, orgID string) iter.Seq2[ProjectListItem, error] {
+	if orgID == "" {
+		return func(yield func(ProjectListItem, error) bool) {
+			yield(ProjectListItem{}, errOrgIDNotSet)
+		}
+	}
+	return c.Projects(ctx, nil, &orgID)
+}
+
+// SharedProjects iterates over the shared projects paging through ListSharedProjects.
+// The iteration stops after the first error, which is yielded with the zero project.
+func (c Client) SharedProjects(ctx context.Context, search *string) iter.Seq2[ProjectListItem, error] {
+	return pages(
+		ctx, func(cursor *string) (Page[ProjectListItem], error) {
+			return c.ListSharedProjectsPage(cursor, listProjectsPageLimit, search)
+		},
+	)
+}
+
+// ProjectOperations iterates over the project's operations paging through ListProjectOperations.
+// The iteration stops after the first error, which is yielded with the zero operation.
+func (c Client) ProjectOperations(ctx context.Context, projectID string) iter.Seq2[Operation, error] {
+	return pages(
+		ctx, func(cursor *string) (Page[Operation], error) {
+			return c.ListProjectOperationsPage(projectID, cursor, listOperationsPageLimit)
+		},
+	)
+}
+
+// pages iterates over the items of the pages fetched lazily, the next page is only fetched
+// once all items of the previous page were consumed.
+func pages[T any](ctx context.Context, fetch func(cursor *string) (Page[T], error)) iter.Seq2[T, error] {
+	return func(yield func(T, error) bool) {
+		var (
+			cursor *string
+			zero   T
+		)
+		for {
+			if err := ctx.Err(); err != nil {
+				yield(zero, err)
+				return
+			}
+
+			page, err := fetch(cursor)
+			if err != nil {
+				yield(zero, err)
+				return
+			}
+
+			for _, item := range page.Items {
+				if !yield(item, nil) {
+					return
+				}
+			}
+
+			if cursor = page.Next(); cursor == nil {
+				return
+			}
+		}
+	}
+}
@@ -0,0 +1,140 @@
+//go:build go1.23
+
+package sdk
+
+import (
+	"context"
+	"net/http"
+	"testing"
+)
+
+func TestClient_Projects(t *testing.T) {
+	pagedProjects := func() *fakeAPI {
+		return newFakeAPI().onFunc(
+			http.MethodGet, "/projects", func(req *http.Request) (int, string) {
+				if req.URL.Query().Get("cursor") == "" {
+					return http.StatusOK, `{"projects":[` + repeatJSON(`{"id":"p1"}`, listProjectsPageLimit) +
+						`],"pagination":{"cursor":"next"}}`
+				}
+				return http.StatusOK, `{"projects":[{"id":"p2"}],"pagination":{"cursor":"last"}}`
+			},
+		)
+	}
+
+	tests := []struct {
+		name      string
+		api       *fakeAPI
+		breakAt   int
+		wantItems int
+		wantCalls int
+		wantErr   bool
+	}{
+		{
+			name:      "all pages",
+			api:       pagedProjects(),
+			wantItems: listProjectsPageLimit + 1,
+			wantCalls: 2,
+		},
+		{
+			name:      "early break does not fetch the next page",
+			api:       pagedProjects(),
+			breakAt:   10,
+			wantItems: 10,
+			wantCalls: 1,
+		},
+		{
+			name:      "unhappy path: API error",
+			api:       newFakeAPI().on(http.MethodGet, "/projects", http.StatusInternalServerError, `{"message":"internal"}`),
+			wantCalls: 1,
+			wantErr:   true,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(
+			tt.name, func(t *testing.T) {
+				c, err := NewClient(Config{Key: "foo", HTTPClient: tt.api})
+				if err != nil {
+					t.Fatal(err)
+				}
+
+				var (
+					items  int
+					gotErr error
+				)
+				for _, err := range c.Projects(context.TODO(), nil, nil) {
+					if err != nil {
+						gotErr = err
+						break
+					}
+					if items++; items == tt.breakAt {
+						break
+					}
+				}
+
+				if (gotErr != nil) != tt.wantErr {
+					t.Fatalf("Projects() error = %v, wantErr %v", gotErr, tt.wantErr)
+				}
+				if items != tt.wantItems {
+					t.Errorf("unexpected number of projects: %d, want %d", items, tt.wantItems)
+				}
+				if n := tt.api.called(http.MethodGet, "/projects"); n != tt.wantCalls {
+					t.Errorf("unexpected number of calls: %d, want %d", n, tt.wantCalls)
+				}
+			},
+		)
+	}
+}
+
+func TestClient_ProjectOperations(t *testing.T) {
+	api := newFakeAPI().on(
+		http.MethodGet, "/projects/foo/operations", http.StatusOK,
+		`{"operations":[{"id":"op1"},{"id":"op2"}],"pagination":{"cursor":"op2"}}`,
+	)
+	c, err := NewClient(Config{Key: "foo", HTTPClient: api})
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	var got []string
+	for op, err := range c.ProjectOperations(context.TODO(), "foo") {
+		if err != nil {
+			t.Fatal(err)
+		}
+		got = append(got, op.ID)
+	}
+	if len(got) != 2 || got[0] != "op1" || got[1] != "op2" {
+		t.Errorf("unexpected operations: %v", got)
+	}
+}
+
+func TestClient_OrganizationProjects(t *testing.T) {
+	api := newFakeAPI().onFunc(
+		http.MethodGet, "/projects", func(req *http.Request) (int, string) {
+			if req.URL.Query().Get("org_id") != "org-foo" {
+				return http.StatusBadRequest, `{"code":"","message":"unexpected organization"}`
+			}
+			return http.StatusOK, `{"projects":[{"id":"p1"},{"id":"p2"}],"pagination":{"cursor":"p2"}}`
+		},
+	)
+	c, err := NewClient(Config{Key: "foo", HTTPClient: api})
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	var ids []string
+	for p, err := range c.OrganizationProjects(context.TODO(), "org-foo") {
+		if err != nil {
+			t.Fatal(err)
+		}
+		ids = append(ids, p.ID)
+	}
+	if len(ids) != 2 {
+		t.Errorf("unexpected projects: %v", ids)
+	}
+
+	for _, err := range c.OrganizationProjects(context.TODO(), "") {
+		if err == nil {
+			t.Error("error is expected for the empty organization ID")
+		}
+	}
+}
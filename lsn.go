@@ -0,0 +1,60 @@
+package sdk
+
+import (
+	"fmt"
+	"strconv"
+	"strings"
+)
+
+// Lsn the Postgres log sequence number, i.e. the position in the write-ahead log, e.g. "0/1DE2850".
+type Lsn uint64
+
+// ParseLsn parses the LSN in the Postgres textual format of two hexadecimal numbers separated by slash.
+func ParseLsn(s string) (Lsn, error) {
+	hi, lo, ok := strings.Cut(s, "/")
+	if !ok || hi == "" || lo == "" {
+		return 0, fmt.Errorf("invalid LSN %q: the format X/X is expected", s)
+	}
+
+	h, err := strconv.ParseUint(hi, 16, 32)
+	if err != nil {
+		return 0, fmt.Errorf("invalid LSN %q: %w", s, err)
+	}
+	l, err := strconv.ParseUint(lo, 16, 32)
+	if err != nil {
+		return 0, fmt.Errorf("invalid LSN %q: %w", s, err)
+	}
+	return Lsn(h<<32 | l), nil
+}
+
+// String returns the LSN in the Postgres textual format.
+func (l Lsn) String() string {
+	return fmt.Sprintf("%X/%X", uint64(l)>>32, uint64(l)&0xFFFFFFFF)
+}
+
+// Compare returns -1 if the LSN precedes the other, +1 if it follows the other, and 0 if they are equal.
+func (l Lsn) Compare(other Lsn) int {
+	switch {
+	case l < other:
+		return -1
+	case l > other:
+		return 1
+	default:
+		return 0
+	}
+}
+
+// MarshalText implements encoding.TextMarshaler.
+func (l Lsn) MarshalText() ([]byte, error) {
+	return []byte(l.String()), nil
+}
+
+// UnmarshalText implements encoding.TextUnmarshaler.
+func (l *Lsn) UnmarshalText(b []byte) error {
+	v, err := ParseLsn(string(b))
+	if err != nil {
+		return err
+	}
+	*l = v
+	return nil
+}
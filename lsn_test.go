@@ -0,0 +1,69 @@
+package sdk
+
+import (
+	"encoding/json"
+	"testing"
+)
+
+func TestParseLsn(t *testing.T) {
+	tests := []struct {
+		in      string
+		want    Lsn
+		wantErr bool
+	}{
+		{in: "0/1DE2850", want: 0x1DE2850},
+		{in: "16/B374D848", want: 0x16B374D848},
+		{in: "0/0", want: 0},
+		{in: "1de2850", wantErr: true},
+		{in: "0/", wantErr: true},
+		{in: "0/XYZ", wantErr: true},
+		{in: "100000000/0", wantErr: true},
+	}
+	for _, tt := range tests {
+		t.Run(
+			tt.in, func(t *testing.T) {
+				got, err := ParseLsn(tt.in)
+				if (err != nil) != tt.wantErr {
+					t.Fatalf("ParseLsn() error = %v, wantErr %v", err, tt.wantErr)
+				}
+				if got != tt.want {
+					t.Errorf("ParseLsn() got = %v, want %v", got, tt.want)
+				}
+				if !tt.wantErr && got.String() != tt.in {
+					t.Errorf("String() = %v, want %v", got.String(), tt.in)
+				}
+			},
+		)
+	}
+}
+
+func TestLsn_Compare(t *testing.T) {
+	a, b := Lsn(0x1DE2850), Lsn(0x16B374D848)
+	if a.Compare(b) != -1 || b.Compare(a) != 1 || a.Compare(a) != 0 {
+		t.Errorf("unexpected comparison of %s and %s", a, b)
+	}
+}
+
+func TestLsn_JSON(t *testing.T) {
+	var v struct {
+		Lsn Lsn `json:"lsn"`
+	}
+	if err := json.Unmarshal([]byte(`{"lsn":"0/1DE2850"}`), &v); err != nil {
+		t.Fatal(err)
+	}
+	if v.Lsn != 0x1DE2850 {
+		t.Errorf("unexpected LSN: %s", v.Lsn)
+	}
+
+	got, err := json.Marshal(v)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if string(got) != `{"lsn":"0/1DE2850"}` {
+		t.Errorf("unexpected JSON: %s", got)
+	}
+
+	if err := json.Unmarshal([]byte(`{"lsn":"foo"}`), &v); err == nil {
+		t.Error("error expected")
+	}
+}
@@ -0,0 +1,189 @@
+package sdk
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"net/http"
+	"strings"
+	"time"
+)
+
+// ErrProjectInMaintenance the error returned when the call is rejected because the project is in maintenance.
+var ErrProjectInMaintenance = errors.New("project is in maintenance")
+
+// maintenanceRetryInterval the interval between the calls retried during the maintenance
+// when the end of maintenance cannot be estimated.
+var maintenanceRetryInterval = 30 * time.Second
+
+// ProjectMaintenanceError the error carrying the project's maintenance details.
+// It matches ErrProjectInMaintenance with errors.Is, and unwraps to the API error if the call was rejected.
+type ProjectMaintenanceError struct {
+	ProjectID string
+	// StartsAt the beginning of the maintenance, nil if unknown.
+	StartsAt *time.Time
+	// Window the project's maintenance window, nil if unknown.
+	Window *MaintenanceWindow
+	err    error
+}
+
+func (e *ProjectMaintenanceError) Error() string {
+	msg := "project " + e.ProjectID + " is in maintenance"
+	if e.StartsAt != nil {
+		msg += " since " + e.StartsAt.Format(time.RFC3339)
+	}
+	if e.err != nil {
+		msg += ": " + e.err.Error()
+	}
+	return msg
+}
+
+func (e *ProjectMaintenanceError) Is(target error) bool {
+	return target == ErrProjectInMaintenance
+}
+
+func (e *ProjectMaintenanceError) Unwrap() error {
+	return e.err
+}
+
+// EndsAt estimates the end of the maintenance as the first end of the maintenance window after its beginning.
+// It returns false if the beginning or the window is unknown.
+func (e *ProjectMaintenanceError) EndsAt() (time.Time, bool) {
+	if e.StartsAt == nil || e.Window == nil {
+		return time.Time{}, false
+	}
+	end, err := time.Parse("15:04", e.Window.EndTime)
+	if err != nil {
+		return time.Time{}, false
+	}
+
+	start := e.StartsAt.UTC()
+	o := time.Date(start.Year(), start.Month(), start.Day(), end.Hour(), end.Minute(), 0, 0, time.UTC)
+	if !o.After(start) {
+		o = o.AddDate(0, 0, 1)
+	}
+	return o, true
+}
+
+// CheckProjectMaintenance returns the error matching ErrProjectInMaintenance if the project is in maintenance.
+func (c Client) CheckProjectMaintenance(ctx context.Context, projectID string) error {
+	if err := ctx.Err(); err != nil {
+		return err
+	}
+
+	resp, err := c.GetProject(projectID)
+	if err != nil {
+		return fmt.Errorf("could not read project %s: %w", projectID, err)
+	}
+
+	p := resp.Project
+	if p.MaintenanceStartsAt == nil || time.Now().Before(*p.MaintenanceStartsAt) {
+		return nil
+	}
+	return newProjectMaintenanceError(p, nil)
+}
+
+// HandleProjectMaintenance calls fn and converts the API error caused by the project's maintenance
+// to the error matching ErrProjectInMaintenance. If retry is set, the call is repeated after the estimated end
+// of the maintenance until it is not rejected because of the maintenance, or the context is cancelled.
+func (c Client) HandleProjectMaintenance(ctx context.Context, projectID string, retry bool, fn func() error) error {
+	for {
+		err := fn()
+		if !isMaintenance(err) {
+			return err
+		}
+
+		e := &ProjectMaintenanceError{ProjectID: projectID, err: err}
+		if resp, errProject := c.GetProject(projectID); errProject == nil {
+			e = newProjectMaintenanceError(resp.Project, err)
+		}
+		if !retry {
+			return e
+		}
+
+		wait := maintenanceRetryInterval
+		if end, ok := e.EndsAt(); ok && time.Until(end) > wait {
+			wait = time.Until(end)
+		}
+
+		select {
+		case <-ctx.Done():
+			return ctx.Err()
+		case <-time.After(wait):
+		}
+	}
+}
+
+func newProjectMaintenanceError(p Project, cause error) *ProjectMaintenanceError {
+	o := &ProjectMaintenanceError{ProjectID: p.ID, StartsAt: p.MaintenanceStartsAt, err: cause}
+	if p.Settings != nil {
+		o.Window = p.Settings.MaintenanceWindow
+	}
+	return o
+}
+
+// isMaintenance defines if the error is the API error caused by the project's maintenance.
+func isMaintenance(err error) bool {
+	var e Error
+	return errors.As(err, &e) && e.HTTPCode == http.StatusLocked &&
+		strings.Contains(strings.ToLower(e.Message), "maintenance")
+}
+
+// MaintenanceEvent the change of the project's scheduled maintenance.
+type MaintenanceEvent struct {
+	ProjectID string
+	// StartsAt the beginning of the maintenance, nil if the maintenance is no longer scheduled.
+	StartsAt *time.Time
+	// PreviousStartsAt the beginning of the maintenance before the change, nil if it was not scheduled.
+	PreviousStartsAt *time.Time
+	// Window the project's maintenance window, nil if unknown.
+	Window *MaintenanceWindow
+}
+
+// WatchMaintenance polls the project periodically until the context is cancelled, and calls fn when
+// the beginning of the maintenance is set, changed, or unset, e.g. to alert the operators before the maintenance.
+// The maintenance scheduled upon the first poll is reported too. The error of the poll is passed to fn
+// and does not stop the subsequent polls. The interval between the polls must be positive.
+func (c Client) WatchMaintenance(
+	ctx context.Context, projectID string, interval time.Duration, fn func(MaintenanceEvent, error),
+) error {
+	if interval <= 0 {
+		return errors.New("maintenance watch interval must be positive")
+	}
+	ticker := time.NewTicker(interval)
+	defer ticker.Stop()
+
+	var last *time.Time
+	for {
+		resp, err := c.GetProject(projectID)
+		switch {
+		case err != nil:
+			fn(MaintenanceEvent{}, fmt.Errorf("could not read project %s: %w", projectID, err))
+
+		case !equalTimePtr(last, resp.Project.MaintenanceStartsAt):
+			e := MaintenanceEvent{
+				ProjectID:        projectID,
+				StartsAt:         resp.Project.MaintenanceStartsAt,
+				PreviousStartsAt: last,
+			}
+			if resp.Project.Settings != nil {
+				e.Window = resp.Project.Settings.MaintenanceWindow
+			}
+			last = resp.Project.MaintenanceStartsAt
+			fn(e, nil)
+		}
+
+		select {
+		case <-ctx.Done():
+			return ctx.Err()
+		case <-ticker.C:
+		}
+	}
+}
+
+func equalTimePtr(a, b *time.Time) bool {
+	if a == nil || b == nil {
+		return a == b
+	}
+	return a.Equal(*b)
+}
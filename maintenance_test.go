@@ -0,0 +1,251 @@
+package sdk
+
+import (
+	"context"
+	"errors"
+	"net/http"
+	"testing"
+	"time"
+)
+
+func TestProjectMaintenanceError_EndsAt(t *testing.T) {
+	startsAt := time.Date(2024, 6, 1, 22, 0, 0, 0, time.UTC)
+
+	tests := []struct {
+		name   string
+		e      ProjectMaintenanceError
+		want   time.Time
+		wantOK bool
+	}{
+		{
+			name:   "same day",
+			e:      ProjectMaintenanceError{StartsAt: &startsAt, Window: &MaintenanceWindow{EndTime: "23:30"}},
+			want:   time.Date(2024, 6, 1, 23, 30, 0, 0, time.UTC),
+			wantOK: true,
+		},
+		{
+			name:   "window ends next day",
+			e:      ProjectMaintenanceError{StartsAt: &startsAt, Window: &MaintenanceWindow{EndTime: "01:00"}},
+			want:   time.Date(2024, 6, 2, 1, 0, 0, 0, time.UTC),
+			wantOK: true,
+		},
+		{
+			name: "unknown window",
+			e:    ProjectMaintenanceError{StartsAt: &startsAt},
+		},
+		{
+			name: "invalid window",
+			e:    ProjectMaintenanceError{StartsAt: &startsAt, Window: &MaintenanceWindow{EndTime: "foo"}},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(
+			tt.name, func(t *testing.T) {
+				got, ok := tt.e.EndsAt()
+				if !got.Equal(tt.want) || ok != tt.wantOK {
+					t.Errorf("EndsAt() = %v, %v, want %v, %v", got, ok, tt.want, tt.wantOK)
+				}
+			},
+		)
+	}
+}
+
+func TestClient_CheckProjectMaintenance(t *testing.T) {
+	tests := []struct {
+		name    string
+		body    string
+		wantErr bool
+	}{
+		{
+			name: "no maintenance",
+			body: `{"project":{"id":"foo"}}`,
+		},
+		{
+			name: "maintenance is scheduled",
+			body: `{"project":{"id":"foo","maintenance_starts_at":"2100-01-01T00:00:00Z"}}`,
+		},
+		{
+			name: "maintenance is ongoing",
+			body: `{"project":{"id":"foo","maintenance_starts_at":"2024-01-01T00:00:00Z",
+"settings":{"maintenance_window":{"weekdays":[1],"start_time":"00:00","end_time":"01:00"}}}}`,
+			wantErr: true,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(
+			tt.name, func(t *testing.T) {
+				api := newFakeAPI().on(http.MethodGet, "/projects/foo", http.StatusOK, tt.body)
+				c, err := NewClient(Config{Key: "foo", HTTPClient: api})
+				if err != nil {
+					t.Fatal(err)
+				}
+
+				err = c.CheckProjectMaintenance(context.TODO(), "foo")
+				if errors.Is(err, ErrProjectInMaintenance) != tt.wantErr {
+					t.Fatalf("CheckProjectMaintenance() error = %v, wantErr %v", err, tt.wantErr)
+				}
+
+				var e *ProjectMaintenanceError
+				if tt.wantErr && (!errors.As(err, &e) || e.Window == nil) {
+					t.Errorf("maintenance window is missing: %v", err)
+				}
+			},
+		)
+	}
+}
+
+func TestClient_HandleProjectMaintenance(t *testing.T) {
+	errMaintenance := Error{
+		HTTPCode:  http.StatusLocked,
+		errorResp: errorResp{Message: "Project is under maintenance"},
+	}
+
+	tests := []struct {
+		name      string
+		retry     bool
+		errs      []error
+		wantCalls int
+		wantErr   error
+	}{
+		{
+			name:      "no maintenance",
+			errs:      []error{nil},
+			wantCalls: 1,
+		},
+		{
+			name:      "maintenance without retry",
+			errs:      []error{errMaintenance},
+			wantCalls: 1,
+			wantErr:   ErrProjectInMaintenance,
+		},
+		{
+			name:      "maintenance with retry",
+			retry:     true,
+			errs:      []error{errMaintenance, errMaintenance, nil},
+			wantCalls: 3,
+		},
+		{
+			name:      "other error is not retried",
+			retry:     true,
+			errs:      []error{errOther},
+			wantCalls: 1,
+			wantErr:   errOther,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(
+			tt.name, func(t *testing.T) {
+				api := newFakeAPI().on(http.MethodGet, "/projects/foo", http.StatusOK, `{"project":{"id":"foo"}}`)
+				c, err := NewClient(Config{Key: "foo", HTTPClient: api})
+				if err != nil {
+					t.Fatal(err)
+				}
+
+				var calls int
+				err = c.HandleProjectMaintenance(
+					context.TODO(), "foo", tt.retry, func() error {
+						calls++
+						return tt.errs[calls-1]
+					},
+				)
+				if !errors.Is(err, tt.wantErr) || (tt.wantErr == nil && err != nil) {
+					t.Errorf("HandleProjectMaintenance() error = %v, wantErr %v", err, tt.wantErr)
+				}
+				if calls != tt.wantCalls {
+					t.Errorf("unexpected number of calls: %d, want %d", calls, tt.wantCalls)
+				}
+			},
+		)
+	}
+}
+
+var errOther = errors.New("foo")
+
+func TestClient_WatchMaintenance(t *testing.T) {
+	responses := []struct {
+		code int
+		body string
+	}{
+		{http.StatusOK, `{"project":{"id":"foo"}}`},
+		{http.StatusOK, `{"project":{"id":"foo","maintenance_starts_at":"2024-06-01T22:00:00Z",
+"settings":{"maintenance_window":{"weekdays":[6],"start_time":"22:00","end_time":"23:00"}}}}`},
+		{http.StatusOK, `{"project":{"id":"foo","maintenance_starts_at":"2024-06-01T22:00:00Z"}}`},
+		{http.StatusInternalServerError, `{"code":"","message":"internal error"}`},
+		{http.StatusOK, `{"project":{"id":"foo","maintenance_starts_at":"2024-06-02T22:00:00Z"}}`},
+		{http.StatusOK, `{"project":{"id":"foo"}}`},
+	}
+
+	var (
+		ctx, cancel = context.WithCancel(context.TODO())
+		polls       int
+	)
+	defer cancel()
+
+	api := newFakeAPI().onFunc(
+		http.MethodGet, "/projects/foo", func(_ *http.Request) (int, string) {
+			if polls == len(responses) {
+				return http.StatusOK, `{"project":{"id":"foo"}}`
+			}
+			r := responses[polls]
+			if polls++; polls == len(responses) {
+				cancel()
+			}
+			return r.code, r.body
+		},
+	)
+	c, err := NewClient(Config{Key: "foo", HTTPClient: api})
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	var (
+		events []MaintenanceEvent
+		errs   int
+	)
+	err = c.WatchMaintenance(
+		ctx, "foo", time.Millisecond, func(e MaintenanceEvent, err error) {
+			if err != nil {
+				errs++
+				return
+			}
+			events = append(events, e)
+		},
+	)
+	if !errors.Is(err, context.Canceled) {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if errs != 1 {
+		t.Errorf("unexpected number of errors: %d", errs)
+	}
+
+	first := time.Date(2024, 6, 1, 22, 0, 0, 0, time.UTC)
+	second := time.Date(2024, 6, 2, 22, 0, 0, 0, time.UTC)
+	if len(events) != 3 {
+		t.Fatalf("unexpected events: %+v", events)
+	}
+	if !events[0].StartsAt.Equal(first) || events[0].PreviousStartsAt != nil || events[0].Window == nil {
+		t.Errorf("unexpected scheduled event: %+v", events[0])
+	}
+	if !events[1].StartsAt.Equal(second) || !events[1].PreviousStartsAt.Equal(first) {
+		t.Errorf("unexpected rescheduled event: %+v", events[1])
+	}
+	if events[2].StartsAt != nil || !events[2].PreviousStartsAt.Equal(second) {
+		t.Errorf("unexpected unscheduled event: %+v", events[2])
+	}
+}
+
+func TestClient_WatchMaintenance_zeroInterval(t *testing.T) {
+	api := newFakeAPI().on(http.MethodGet, "/projects/foo", http.StatusOK, `{"project":{"id":"foo"}}`)
+	c, err := NewClient(Config{Key: "foo", HTTPClient: api})
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	err = c.WatchMaintenance(context.TODO(), "foo", 0, func(MaintenanceEvent, error) {})
+	if err == nil {
+		t.Fatal("expected error")
+	}
+	if n := api.called(http.MethodGet, "/projects/foo"); n != 0 {
+		t.Errorf("unexpected number of calls: %d", n)
+	}
+}
@@ -0,0 +1,37 @@
+package sdk
+
+import (
+	"context"
+	"fmt"
+)
+
+const metaRegionsKey = "regions"
+
+// Meta the API's metadata which rarely changes.
+// Note that the preload libraries are not listed because the API spec does not define their listing.
+type Meta struct {
+	// Regions the active regions the projects can be created in.
+	Regions []RegionResponse
+}
+
+// Meta returns the API's metadata. The metadata is cached by Config.MetaCache when set,
+// and it is read from the API on every call otherwise.
+func (c Client) Meta(ctx context.Context) (Meta, error) {
+	if err := ctx.Err(); err != nil {
+		return Meta{}, err
+	}
+
+	regions, err := c.cfg.MetaCache.get(
+		metaRegionsKey, func() (interface{}, error) {
+			resp, err := c.GetActiveRegions()
+			if err != nil {
+				return nil, fmt.Errorf("could not list regions: %w", err)
+			}
+			return resp.Regions, nil
+		},
+	)
+	if err != nil {
+		return Meta{}, err
+	}
+	return Meta{Regions: append([]RegionResponse(nil), regions.([]RegionResponse)...)}, nil
+}
@@ -0,0 +1,38 @@
+package sdk
+
+import (
+	"context"
+	"net/http"
+	"testing"
+)
+
+func TestClient_Meta(t *testing.T) {
+	api := newFakeAPI().on(
+		http.MethodGet, "/regions", http.StatusOK,
+		`{"regions":[{"region_id":"aws-us-east-2","name":"US East (Ohio)","default":true}]}`,
+	)
+	c, err := NewClient(Config{Key: "foo", HTTPClient: api, MetaCache: &MetaCache{}})
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	for i := 0; i < 2; i++ {
+		got, err := c.Meta(context.TODO())
+		if err != nil {
+			t.Fatal(err)
+		}
+		if len(got.Regions) != 1 || got.Regions[0].RegionID != "aws-us-east-2" {
+			t.Errorf("unexpected metadata: %+v", got)
+		}
+	}
+	if n := api.called(http.MethodGet, "/regions"); n != 1 {
+		t.Errorf("regions are expected to be read once, read %d times", n)
+	}
+
+	if err := c.cfg.MetaCache.Refresh(context.TODO()); err != nil {
+		t.Fatal(err)
+	}
+	if n := api.called(http.MethodGet, "/regions"); n != 2 {
+		t.Errorf("regions are expected to be read upon refresh, read %d times", n)
+	}
+}
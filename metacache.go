@@ -0,0 +1,162 @@
+package sdk
+
+import (
+	"context"
+	"fmt"
+	"math/rand"
+	"sort"
+	"sync"
+	"time"
+)
+
+const (
+	defaultMetaRefreshInterval = time.Hour
+	defaultMetaRefreshJitter   = 0.1
+)
+
+// MetaCache caches the API's metadata which rarely changes, e.g. the regions, for Client.Meta.
+// The metadata is read upon the first call, and it is refreshed in the background once Start is called.
+// The cache can be shared by the clients using the same credentials.
+type MetaCache struct {
+	// RefreshInterval the interval between the background refreshes, one hour if it is not positive.
+	RefreshInterval time.Duration
+	// Jitter the fraction of the interval the refresh is randomly shifted by to spread the calls
+	// of many processes, 0.1 if it is not positive.
+	Jitter float64
+	// OnError receives the errors of the background refresh when set. The stale metadata is kept upon errors.
+	OnError func(error)
+
+	mu       sync.Mutex
+	entries  map[string]*metaEntry
+	inflight map[string]*metaCall
+}
+
+type metaEntry struct {
+	value interface{}
+	load  func() (interface{}, error)
+}
+
+// metaCall the first load of the key's value awaited by the concurrent calls.
+type metaCall struct {
+	done  chan struct{}
+	value interface{}
+	err   error
+}
+
+// get returns the cached value of the key, the value is loaded upon the first call, or every call if the cache is nil.
+// The value is loaded without holding the lock, the concurrent calls for the same key await the first load.
+func (m *MetaCache) get(key string, load func() (interface{}, error)) (interface{}, error) {
+	if m == nil {
+		return load()
+	}
+
+	m.mu.Lock()
+	if e, ok := m.entries[key]; ok {
+		v := e.value
+		m.mu.Unlock()
+		return v, nil
+	}
+	if call, ok := m.inflight[key]; ok {
+		m.mu.Unlock()
+		<-call.done
+		return call.value, call.err
+	}
+	call := &metaCall{done: make(chan struct{})}
+	if m.inflight == nil {
+		m.inflight = map[string]*metaCall{}
+	}
+	m.inflight[key] = call
+	m.mu.Unlock()
+
+	call.value, call.err = load()
+
+	m.mu.Lock()
+	delete(m.inflight, key)
+	if call.err == nil {
+		if m.entries == nil {
+			m.entries = map[string]*metaEntry{}
+		}
+		m.entries[key] = &metaEntry{value: call.value, load: load}
+	}
+	m.mu.Unlock()
+	close(call.done)
+
+	if call.err != nil {
+		return nil, call.err
+	}
+	return call.value, nil
+}
+
+// Refresh reloads the cached metadata. The stale value is kept if it cannot be reloaded,
+// and the error of the first such value is returned. It is no-op if the cache is nil.
+func (m *MetaCache) Refresh(ctx context.Context) error {
+	if m == nil {
+		return nil
+	}
+
+	m.mu.Lock()
+	keys := make([]string, 0, len(m.entries))
+	entries := make(map[string]*metaEntry, len(m.entries))
+	for k, e := range m.entries {
+		keys = append(keys, k)
+		entries[k] = e
+	}
+	m.mu.Unlock()
+	sort.Strings(keys)
+
+	var o error
+	for _, k := range keys {
+		if err := ctx.Err(); err != nil {
+			return err
+		}
+		v, err := entries[k].load()
+		if err != nil {
+			if o == nil {
+				o = fmt.Errorf("could not refresh %s: %w", k, err)
+			}
+			continue
+		}
+		m.mu.Lock()
+		entries[k].value = v
+		m.mu.Unlock()
+	}
+	return o
+}
+
+// Start refreshes the cached metadata in the background every RefreshInterval shifted by the random jitter
+// until the context is done. It is no-op if the cache is nil.
+func (m *MetaCache) Start(ctx context.Context) {
+	if m == nil {
+		return
+	}
+
+	go func() {
+		for {
+			t := time.NewTimer(m.nextRefresh())
+			select {
+			case <-ctx.Done():
+				t.Stop()
+				return
+			case <-t.C:
+			}
+			if err := m.Refresh(ctx); err != nil && ctx.Err() == nil && m.OnError != nil {
+				m.OnError(err)
+			}
+		}
+	}()
+}
+
+func (m *MetaCache) nextRefresh() time.Duration {
+	interval := m.RefreshInterval
+	if interval <= 0 {
+		interval = defaultMetaRefreshInterval
+	}
+	jitter := m.Jitter
+	if jitter <= 0 {
+		jitter = defaultMetaRefreshJitter
+	}
+	if jitter > 1 {
+		jitter = 1
+	}
+	return interval + time.Duration((rand.Float64()*2-1)*jitter*float64(interval))
+}
@@ -0,0 +1,146 @@
+package sdk
+
+import (
+	"context"
+	"errors"
+	"sync"
+	"testing"
+	"time"
+)
+
+func TestMetaCache(t *testing.T) {
+	var (
+		mu    sync.Mutex
+		calls int
+		fail  bool
+	)
+	load := func() (interface{}, error) {
+		mu.Lock()
+		defer mu.Unlock()
+		calls++
+		if fail {
+			return nil, errors.New("unavailable")
+		}
+		return calls, nil
+	}
+
+	m := &MetaCache{}
+	for i := 0; i < 2; i++ {
+		if v, err := m.get("foo", load); err != nil || v != 1 {
+			t.Fatalf("unexpected value: %v, error: %v", v, err)
+		}
+	}
+
+	if err := m.Refresh(context.TODO()); err != nil {
+		t.Fatal(err)
+	}
+	if v, _ := m.get("foo", load); v != 2 {
+		t.Errorf("refreshed value is expected, got %v", v)
+	}
+
+	fail = true
+	if err := m.Refresh(context.TODO()); err == nil {
+		t.Error("error is expected")
+	}
+	if v, _ := m.get("foo", load); v != 2 {
+		t.Errorf("stale value is expected to be kept, got %v", v)
+	}
+
+	var nilCache *MetaCache
+	n := calls
+	_, _ = nilCache.get("foo", load)
+	if calls != n+1 {
+		t.Error("nil cache is expected to load the value on every call")
+	}
+	if err := nilCache.Refresh(context.TODO()); err != nil {
+		t.Errorf("nil cache refresh is expected to be no-op, got %v", err)
+	}
+	nilCache.Start(context.TODO())
+}
+
+func TestMetaCache_concurrentLoad(t *testing.T) {
+	var (
+		m       = &MetaCache{}
+		calls   int
+		release = make(chan struct{})
+		started = make(chan struct{})
+		wg      sync.WaitGroup
+	)
+	load := func() (interface{}, error) {
+		calls++
+		close(started)
+		<-release
+		return "foo", nil
+	}
+
+	wg.Add(2)
+	for i := 0; i < 2; i++ {
+		go func() {
+			defer wg.Done()
+			if v, err := m.get("foo", load); err != nil || v != "foo" {
+				t.Errorf("unexpected value: %v, error: %v", v, err)
+			}
+		}()
+	}
+	<-started
+
+	// the other keys are not blocked by the pending load
+	if v, err := m.get("bar", func() (interface{}, error) { return "bar", nil }); err != nil || v != "bar" {
+		t.Errorf("unexpected value: %v, error: %v", v, err)
+	}
+
+	close(release)
+	wg.Wait()
+	if calls != 1 {
+		t.Errorf("the value is expected to be loaded once, got %d loads", calls)
+	}
+}
+
+func TestMetaCache_Start(t *testing.T) {
+	var (
+		mu    sync.Mutex
+		calls int
+		errs  int
+	)
+	m := &MetaCache{
+		RefreshInterval: time.Millisecond,
+		OnError: func(error) {
+			mu.Lock()
+			defer mu.Unlock()
+			errs++
+		},
+	}
+	if _, err := m.get(
+		"foo", func() (interface{}, error) {
+			mu.Lock()
+			defer mu.Unlock()
+			calls++
+			if calls > 2 {
+				return nil, errors.New("unavailable")
+			}
+			return calls, nil
+		},
+	); err != nil {
+		t.Fatal(err)
+	}
+
+	ctx, cancel := context.WithCancel(context.TODO())
+	defer cancel()
+	m.Start(ctx)
+	waitFor(
+		t, func() bool {
+			mu.Lock()
+			defer mu.Unlock()
+			return calls > 2 && errs > 0
+		},
+	)
+}
+
+func TestMetaCache_nextRefresh(t *testing.T) {
+	m := &MetaCache{RefreshInterval: time.Minute, Jitter: 0.5}
+	for i := 0; i < 100; i++ {
+		if v := m.nextRefresh(); v < 30*time.Second || v > 90*time.Second {
+			t.Fatalf("refresh interval %v is out of the jitter's range", v)
+		}
+	}
+}
@@ -0,0 +1,45 @@
+package sdk
+
+import (
+	"net/http"
+)
+
+// ResponseMetadata the metadata of the last successful response, e.g. to distinguish the created resource
+// from the existing one by the status code.
+type ResponseMetadata struct {
+	// StatusCode the response's HTTP status code.
+	StatusCode int
+	// Location the value of the response's Location header, empty if not set.
+	Location string
+	// Header the response's HTTP headers.
+	Header http.Header
+}
+
+// Created defines if the response reported the creation of the resource, i.e. the status code 201.
+func (m ResponseMetadata) Created() bool {
+	return m.StatusCode == http.StatusCreated
+}
+
+// Accepted defines if the request was accepted for asynchronous processing, i.e. the status code 202.
+func (m ResponseMetadata) Accepted() bool {
+	return m.StatusCode == http.StatusAccepted
+}
+
+// WithResponseMetadata returns the copy of the client which stores the metadata of every successful response to m.
+// The metadata is not changed if the call fails. Note that the returned client must not be used concurrently.
+func (c Client) WithResponseMetadata(m *ResponseMetadata) Client {
+	c.metadata = m
+	return c
+}
+
+// recordMetadata stores the response's metadata if requested.
+func (c Client) recordMetadata(res *http.Response) {
+	if c.metadata == nil {
+		return
+	}
+	*c.metadata = ResponseMetadata{
+		StatusCode: res.StatusCode,
+		Location:   res.Header.Get("Location"),
+		Header:     res.Header.Clone(),
+	}
+}
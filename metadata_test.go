@@ -0,0 +1,84 @@
+package sdk
+
+import (
+	"io"
+	"net/http"
+	"strings"
+	"testing"
+)
+
+type metadataTestHTTPClient struct {
+	code   int
+	header http.Header
+}
+
+func (c metadataTestHTTPClient) Do(req *http.Request) (*http.Response, error) {
+	return &http.Response{
+		StatusCode: c.code,
+		Header:     c.header,
+		Body:       io.NopCloser(strings.NewReader(`{"code":"","message":"foo"}`)),
+		Request:    req,
+	}, nil
+}
+
+func TestClient_WithResponseMetadata(t *testing.T) {
+	tests := []struct {
+		name        string
+		code        int
+		header      http.Header
+		want        ResponseMetadata
+		wantCreated bool
+		wantErr     bool
+	}{
+		{
+			name:   "ok",
+			code:   http.StatusOK,
+			header: http.Header{},
+			want:   ResponseMetadata{StatusCode: http.StatusOK, Header: http.Header{}},
+		},
+		{
+			name:        "created",
+			code:        http.StatusCreated,
+			header:      http.Header{"Location": []string{"/projects/foo"}},
+			want:        ResponseMetadata{StatusCode: http.StatusCreated, Location: "/projects/foo"},
+			wantCreated: true,
+		},
+		{
+			name:    "failed call",
+			code:    http.StatusNotFound,
+			header:  http.Header{},
+			wantErr: true,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(
+			tt.name, func(t *testing.T) {
+				c, err := NewClient(
+					Config{Key: "foo", HTTPClient: metadataTestHTTPClient{code: tt.code, header: tt.header}},
+				)
+				if err != nil {
+					t.Fatal(err)
+				}
+
+				var m ResponseMetadata
+				err = c.WithResponseMetadata(&m).requestHandler(c.baseURL+"/foo", http.MethodPost, nil, nil)
+				if (err != nil) != tt.wantErr {
+					t.Fatalf("requestHandler() error = %v, wantErr %v", err, tt.wantErr)
+				}
+				if m.StatusCode != tt.want.StatusCode || m.Location != tt.want.Location {
+					t.Errorf("unexpected metadata: %+v, want %+v", m, tt.want)
+				}
+				if m.Created() != tt.wantCreated {
+					t.Errorf("Created() = %v, want %v", m.Created(), tt.wantCreated)
+				}
+			},
+		)
+	}
+
+	var m ResponseMetadata
+	c, _ := NewClient(Config{Key: "foo", HTTPClient: metadataTestHTTPClient{code: http.StatusOK}})
+	_ = c.WithResponseMetadata(&m)
+	if c.metadata != nil {
+		t.Errorf("the original client shall not record the metadata")
+	}
+}
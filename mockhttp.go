@@ -6,6 +6,8 @@ import (
 	"net/url"
 	"strconv"
 	"strings"
+	"sync"
+	"time"
 )
 
 var endpointResponseExamples = map[string]map[string]mockResponse{
@@ -616,6 +618,7 @@ var endpointResponseExamples = map[string]map[string]mockResponse{
 }
 
 // NewMockHTTPClient initiates a mock fo the HTTP client required for the SDK client.
+// Use NewMockBuilder to simulate other responses, e.g. 429, 500 or slow responses.
 // Mock client return the response as per API spec, except for the errors: 404 and 401 status codes are covered only.
 // - 401 is returned when the string `invalidApiKey` is used as the API key;
 // - 404 is returned if either of the following:
@@ -634,6 +637,115 @@ type mockResponse struct {
 	Code    int
 }
 
+// MockResponse the response of the mock HTTP client built with MockBuilder.
+type MockResponse struct {
+	Code int
+	Body string
+	// Err the transport error returned instead of the response if set, e.g. context.DeadlineExceeded.
+	Err error
+	// Latency the delay before the response is returned. The request's context cancels the delay.
+	Latency time.Duration
+}
+
+// MockBuilder builds the mock HTTP client with the custom handlers on top of the API spec's responses.
+// The handlers are registered per request method and route as defined by the API spec, e.g. "/projects/{project_id}".
+type MockBuilder struct {
+	registry *mockRegistry
+}
+
+// NewMockBuilder initiates the builder of the mock HTTP client.
+func NewMockBuilder() *MockBuilder {
+	return &MockBuilder{
+		registry: &mockRegistry{handlers: map[string]func(req *http.Request) (*http.Response, error){}},
+	}
+}
+
+// Handle registers the handler of the requests to the route.
+func (b *MockBuilder) Handle(method, route string, fn func(req *http.Request) (*http.Response, error)) *MockBuilder {
+	b.registry.mu.Lock()
+	defer b.registry.mu.Unlock()
+	b.registry.handlers[method+" "+route] = fn
+	return b
+}
+
+// Respond registers the static response to the requests to the route.
+func (b *MockBuilder) Respond(method, route string, resp MockResponse) *MockBuilder {
+	return b.Sequence(method, route, resp)
+}
+
+// Sequence registers the responses returned in order to the consecutive requests to the route.
+// The last response is repeated once the sequence is exhausted, e.g. to simulate 429 followed by 200.
+func (b *MockBuilder) Sequence(method, route string, responses ...MockResponse) *MockBuilder {
+	if len(responses) == 0 {
+		return b
+	}
+
+	var (
+		mu    sync.Mutex
+		calls int
+	)
+	return b.Handle(
+		method, route, func(req *http.Request) (*http.Response, error) {
+			mu.Lock()
+			resp := responses[len(responses)-1]
+			if calls < len(responses) {
+				resp = responses[calls]
+			}
+			calls++
+			mu.Unlock()
+
+			if resp.Latency > 0 {
+				select {
+				case <-req.Context().Done():
+					return nil, req.Context().Err()
+				case <-time.After(resp.Latency):
+				}
+			}
+			if resp.Err != nil {
+				return nil, resp.Err
+			}
+			return &http.Response{
+				Status:        http.StatusText(resp.Code),
+				StatusCode:    resp.Code,
+				Body:          io.NopCloser(strings.NewReader(resp.Body)),
+				ContentLength: int64(len(resp.Body)),
+				Request:       req,
+			}, nil
+		},
+	)
+}
+
+// Latency delays every response of the mock HTTP client.
+func (b *MockBuilder) Latency(d time.Duration) *MockBuilder {
+	b.registry.mu.Lock()
+	defer b.registry.mu.Unlock()
+	b.registry.latency = d
+	return b
+}
+
+// Build returns the mock HTTP client. The requests to the routes without custom handlers
+// are responded as defined by NewMockHTTPClient.
+func (b *MockBuilder) Build() HTTPClient {
+	o := NewMockHTTPClient().(mockHTTPClient)
+	o.registry = b.registry
+	return o
+}
+
+type mockRegistry struct {
+	mu       sync.Mutex
+	handlers map[string]func(req *http.Request) (*http.Response, error)
+	latency  time.Duration
+}
+
+func (r *mockRegistry) handler(method, route string) (func(req *http.Request) (*http.Response, error), time.Duration) {
+	if r == nil {
+		return nil, 0
+	}
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	return r.handlers[method+" "+route], r.latency
+}
+
 // mockHTTPClient defines http client to mock the SDK client.
 type mockHTTPClient struct {
 	// endpoints denotes response mock split by
@@ -645,6 +757,9 @@ type mockHTTPClient struct {
 	endpoints map[string]map[string]mockResponse
 
 	routePrefix string
+
+	// registry the custom handlers registered with MockBuilder.
+	registry *mockRegistry
 }
 
 func (m mockHTTPClient) Do(req *http.Request) (*http.Response, error) {
@@ -654,6 +769,18 @@ func (m mockHTTPClient) Do(req *http.Request) (*http.Response, error) {
 
 	p := parsePath(strings.TrimPrefix(req.URL.Path, m.routePrefix))
 
+	fn, latency := m.registry.handler(req.Method, p.path)
+	if latency > 0 {
+		select {
+		case <-req.Context().Done():
+			return nil, req.Context().Err()
+		case <-time.After(latency):
+		}
+	}
+	if fn != nil {
+		return fn(req)
+	}
+
 	endpoint, ok := m.endpoints[p.path]
 	if !ok {
 		o := Error{HTTPCode: http.StatusBadRequest}
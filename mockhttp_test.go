@@ -1,9 +1,13 @@
 package sdk
 
 import (
+	"encoding/json"
+	"errors"
 	"net/http"
 	"reflect"
+	"strings"
 	"testing"
+	"time"
 )
 
 func Test_newObjectPath(t *testing.T) {
@@ -116,3 +120,131 @@ func Test_authErrorResp(t *testing.T) {
 		)
 	}
 }
+
+// Test_endpointResponseExamples validates that the response examples are deserialized to the response types
+// without unknown fields, i.e. the types follow the spec.
+func Test_endpointResponseExamples(t *testing.T) {
+	tests := []struct {
+		path   string
+		method string
+		v      interface{}
+	}{
+		{"/api_keys", "GET", &[]ApiKeysListResponseItem{}},
+		{"/api_keys", "POST", &ApiKeyCreateResponse{}},
+		{"/api_keys/{key_id}", "DELETE", &ApiKeyRevokeResponse{}},
+		{"/consumption_history/account", "GET", &ConsumptionHistoryPerAccountResponse{}},
+		{"/consumption_history/projects", "GET", &GetConsumptionHistoryPerProjectRespObj{}},
+		{"/organizations/{org_id}", "GET", &Organization{}},
+		{"/organizations/{org_id}/api_keys", "GET", &[]OrgApiKeysListResponseItem{}},
+		{"/organizations/{org_id}/api_keys", "POST", &OrgApiKeyCreateResponse{}},
+		{"/organizations/{org_id}/api_keys/{key_id}", "DELETE", &OrgApiKeyRevokeResponse{}},
+		{"/organizations/{org_id}/invitations", "GET", &OrganizationInvitationsResponse{}},
+		{"/organizations/{org_id}/invitations", "POST", &OrganizationInvitationsResponse{}},
+		{"/organizations/{org_id}/members", "GET", &OrganizationMembersResponse{}},
+		{"/organizations/{org_id}/members/{member_id}", "DELETE", &EmptyResponse{}},
+		{"/organizations/{org_id}/members/{member_id}", "GET", &Member{}},
+		{"/organizations/{org_id}/members/{member_id}", "PATCH", &Member{}},
+		{"/projects", "GET", &ListProjectsRespObj{}},
+		{"/projects", "POST", &CreatedProject{}},
+		{"/projects/shared", "GET", &ListSharedProjectsRespObj{}},
+		{"/projects/{project_id}", "DELETE", &ProjectResponse{}},
+		{"/projects/{project_id}", "GET", &ProjectResponse{}},
+		{"/projects/{project_id}", "PATCH", &UpdateProjectRespObj{}},
+		{"/projects/{project_id}/branches", "GET", &ListProjectBranchesRespObj{}},
+		{"/projects/{project_id}/branches", "POST", &CreatedBranch{}},
+		{"/projects/{project_id}/branches/{branch_id}", "DELETE", &BranchOperations{}},
+		{"/projects/{project_id}/branches/{branch_id}", "GET", &GetProjectBranchRespObj{}},
+		{"/projects/{project_id}/branches/{branch_id}", "PATCH", &BranchOperations{}},
+		{"/projects/{project_id}/branches/{branch_id}/databases", "GET", &DatabasesResponse{}},
+		{"/projects/{project_id}/branches/{branch_id}/databases", "POST", &DatabaseOperations{}},
+		{"/projects/{project_id}/branches/{branch_id}/databases/{database_name}", "DELETE", &DatabaseOperations{}},
+		{"/projects/{project_id}/branches/{branch_id}/databases/{database_name}", "GET", &DatabaseResponse{}},
+		{"/projects/{project_id}/branches/{branch_id}/databases/{database_name}", "PATCH", &DatabaseOperations{}},
+		{"/projects/{project_id}/branches/{branch_id}/endpoints", "GET", &EndpointsResponse{}},
+		{"/projects/{project_id}/branches/{branch_id}/restore", "POST", &BranchOperations{}},
+		{"/projects/{project_id}/branches/{branch_id}/roles", "GET", &RolesResponse{}},
+		{"/projects/{project_id}/branches/{branch_id}/roles", "POST", &RoleOperations{}},
+		{"/projects/{project_id}/branches/{branch_id}/roles/{role_name}", "DELETE", &RoleOperations{}},
+		{"/projects/{project_id}/branches/{branch_id}/roles/{role_name}", "GET", &RoleResponse{}},
+		{"/projects/{project_id}/branches/{branch_id}/roles/{role_name}/reset_password", "POST", &RoleOperations{}},
+		{"/projects/{project_id}/branches/{branch_id}/roles/{role_name}/reveal_password", "GET", &RolePasswordResponse{}},
+		{"/projects/{project_id}/branches/{branch_id}/schema", "GET", &BranchSchemaResponse{}},
+		{"/projects/{project_id}/branches/{branch_id}/set_as_default", "POST", &BranchOperations{}},
+		{"/projects/{project_id}/connection_uri", "GET", &ConnectionURIResponse{}},
+		{"/projects/{project_id}/endpoints", "GET", &EndpointsResponse{}},
+		{"/projects/{project_id}/endpoints", "POST", &EndpointOperations{}},
+		{"/projects/{project_id}/endpoints/{endpoint_id}", "DELETE", &EndpointOperations{}},
+		{"/projects/{project_id}/endpoints/{endpoint_id}", "GET", &EndpointResponse{}},
+		{"/projects/{project_id}/endpoints/{endpoint_id}", "PATCH", &EndpointOperations{}},
+		{"/projects/{project_id}/endpoints/{endpoint_id}/restart", "POST", &EndpointOperations{}},
+		{"/projects/{project_id}/endpoints/{endpoint_id}/start", "POST", &EndpointOperations{}},
+		{"/projects/{project_id}/endpoints/{endpoint_id}/suspend", "POST", &EndpointOperations{}},
+		{"/projects/{project_id}/jwks", "GET", &ProjectJWKSResponse{}},
+		{"/projects/{project_id}/jwks", "POST", &JWKSCreationOperation{}},
+		{"/projects/{project_id}/jwks/{jwks_id}", "DELETE", &JWKS{}},
+		{"/projects/{project_id}/operations", "GET", &ListOperations{}},
+		{"/projects/{project_id}/operations/{operation_id}", "GET", &OperationResponse{}},
+		{"/regions", "GET", &ActiveRegionsResponse{}},
+		{"/users/me", "GET", &CurrentUserInfoResponse{}},
+		{"/users/me/organizations", "GET", &OrganizationsResponse{}},
+		{"/users/me/projects/transfer", "POST", &EmptyResponse{}},
+	}
+	for _, tt := range tests {
+		t.Run(
+			tt.method+" "+tt.path, func(t *testing.T) {
+				dec := json.NewDecoder(strings.NewReader(endpointResponseExamples[tt.path][tt.method].Content))
+				dec.DisallowUnknownFields()
+				if err := dec.Decode(tt.v); err != nil {
+					t.Errorf("response example does not match the type %T: %v", tt.v, err)
+				}
+			},
+		)
+	}
+}
+
+func TestMockBuilder(t *testing.T) {
+	errReset := errors.New("connection reset by peer")
+	httpClient := NewMockBuilder().
+		Sequence(
+			http.MethodGet, "/projects/{project_id}",
+			MockResponse{Code: http.StatusTooManyRequests, Body: `{"message":"rate limit exceeded"}`},
+			MockResponse{Code: http.StatusOK, Body: `{"project":{"id":"foo"}}`},
+		).
+		Respond(http.MethodDelete, "/projects/{project_id}", MockResponse{Err: errReset}).
+		Latency(10 * time.Millisecond).
+		Build()
+
+	c, err := NewClient(Config{HTTPClient: httpClient})
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	start := time.Now()
+	var e Error
+	if err := c.requestHandler(c.baseURL+"/projects/foo", http.MethodGet, nil, nil); !errors.As(err, &e) ||
+		e.HTTPCode != http.StatusTooManyRequests {
+		t.Errorf("unexpected error of the first call: %v", err)
+	}
+	if time.Since(start) < 10*time.Millisecond {
+		t.Errorf("latency was not simulated")
+	}
+
+	var v struct {
+		Project struct {
+			ID string `json:"id"`
+		} `json:"project"`
+	}
+	if err := c.requestHandler(c.baseURL+"/projects/foo", http.MethodGet, nil, &v); err != nil || v.Project.ID != "foo" {
+		t.Errorf("unexpected result of the second call: %v, error: %v", v, err)
+	}
+
+	if err := c.requestHandler(c.baseURL+"/projects/foo", http.MethodDelete, nil, nil); !errors.Is(err, errReset) {
+		t.Errorf("unexpected error: %v", err)
+	}
+
+	// the routes without custom handlers are responded as defined by NewMockHTTPClient
+	if err := c.requestHandler(c.baseURL+"/unknown", http.MethodGet, nil, nil); !errors.As(err, &e) ||
+		e.HTTPCode != http.StatusBadRequest {
+		t.Errorf("unexpected error: %v", err)
+	}
+}
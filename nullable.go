@@ -0,0 +1,69 @@
+package sdk
+
+// Nullable the optional request's attribute which distinguishes the absent value from the explicit null,
+// e.g. to clear the attribute, which the pointer with omitempty cannot express:
+//   - the nil Nullable is omitted from the request;
+//   - NewNull sends null;
+//   - NewNullable sends the value.
+//
+// The explicit null is stored under the key false, hence NewNull, unlike nil, is not omitted with omitempty.
+type Nullable[T any] map[bool]T
+
+// NewNullable returns Nullable set to the value.
+func NewNullable[T any](v T) Nullable[T] {
+	return Nullable[T]{true: v}
+}
+
+// NewNull returns Nullable set to the explicit null.
+func NewNull[T any]() Nullable[T] {
+	var v T
+	return Nullable[T]{false: v}
+}
+
+// Get returns the value, false if it is not set, or null.
+func (n Nullable[T]) Get() (T, bool) {
+	return getValue(n)
+}
+
+// IsNull defines if the value is set to the explicit null.
+func (n Nullable[T]) IsNull() bool {
+	_, ok := n[false]
+	return ok
+}
+
+// IsSpecified defines if the value, or the explicit null is set.
+func (n Nullable[T]) IsSpecified() bool {
+	return len(n) != 0
+}
+
+// Set sets the value.
+func (n *Nullable[T]) Set(v T) {
+	*n = NewNullable(v)
+}
+
+// SetNull sets the explicit null.
+func (n *Nullable[T]) SetNull() {
+	*n = NewNull[T]()
+}
+
+// SetUnspecified unsets the value, hence it is omitted from the request.
+func (n *Nullable[T]) SetUnspecified() {
+	*n = nil
+}
+
+func (n Nullable[T]) MarshalJSON() ([]byte, error) {
+	return marshalValue(n)
+}
+
+func (n *Nullable[T]) UnmarshalJSON(data []byte) error {
+	v, null, err := unmarshalValue[T](data)
+	switch {
+	case err != nil:
+		return err
+	case null:
+		n.SetNull()
+	default:
+		n.Set(v)
+	}
+	return nil
+}
@@ -0,0 +1,85 @@
+package sdk
+
+import (
+	"encoding/json"
+	"testing"
+)
+
+func TestNullable_MarshalJSON(t *testing.T) {
+	type request struct {
+		Foo Nullable[string] `json:"foo,omitempty"`
+	}
+
+	tests := []struct {
+		name string
+		v    request
+		want string
+	}{
+		{
+			name: "unspecified",
+			v:    request{},
+			want: `{}`,
+		},
+		{
+			name: "null",
+			v:    request{Foo: NewNull[string]()},
+			want: `{"foo":null}`,
+		},
+		{
+			name: "value",
+			v:    request{Foo: NewNullable("bar")},
+			want: `{"foo":"bar"}`,
+		},
+		{
+			name: "empty value",
+			v:    request{Foo: NewNullable("")},
+			want: `{"foo":""}`,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(
+			tt.name, func(t *testing.T) {
+				got, err := json.Marshal(tt.v)
+				if err != nil {
+					t.Fatal(err)
+				}
+				if string(got) != tt.want {
+					t.Errorf("Marshal() = %s, want %s", got, tt.want)
+				}
+
+				var v request
+				if err := json.Unmarshal(got, &v); err != nil {
+					t.Fatal(err)
+				}
+				gotV, gotOk := v.Foo.Get()
+				wantV, wantOk := tt.v.Foo.Get()
+				if gotV != wantV || gotOk != wantOk || v.Foo.IsNull() != tt.v.Foo.IsNull() ||
+					v.Foo.IsSpecified() != tt.v.Foo.IsSpecified() {
+					t.Errorf("Unmarshal() = %v, want %v", v.Foo, tt.v.Foo)
+				}
+			},
+		)
+	}
+}
+
+func TestNullable_Set(t *testing.T) {
+	var n Nullable[int]
+	if n.IsSpecified() || n.IsNull() {
+		t.Fatal("zero value must be unspecified")
+	}
+
+	n.Set(0)
+	if v, ok := n.Get(); !ok || v != 0 || n.IsNull() {
+		t.Errorf("value is expected, got %v", n)
+	}
+
+	n.SetNull()
+	if _, ok := n.Get(); ok || !n.IsNull() || !n.IsSpecified() {
+		t.Errorf("null is expected, got %v", n)
+	}
+
+	n.SetUnspecified()
+	if n.IsSpecified() {
+		t.Errorf("unspecified value is expected, got %v", n)
+	}
+}
@@ -0,0 +1,50 @@
+package sdk
+
+import (
+	"encoding/json"
+	"math"
+	"strconv"
+)
+
+// Int64 returns the setting's integer value and the flag indicating whether the key is set to the integer.
+// The value decoded into json.Number by NumberCodec, float64, and the string holding the integer are supported.
+func (v PgSettingsData) Int64(key string) (int64, bool) {
+	return int64Value(v[key])
+}
+
+// Int64 returns the setting's integer value and the flag indicating whether the key is set to the integer.
+// The value decoded into json.Number by NumberCodec, float64, and the string holding the integer are supported.
+func (v PgbouncerSettingsData) Int64(key string) (int64, bool) {
+	return int64Value(v[key])
+}
+
+// Int64 returns the annotation's integer value and the flag indicating whether the key is set to the integer.
+// The value decoded into json.Number by NumberCodec, float64, and the string holding the integer are supported.
+func (v AnnotationValueData) Int64(key string) (int64, bool) {
+	return int64Value(v[key])
+}
+
+// int64Value converts the untyped JSON value to int64. Note that float64 is only accepted if it holds the integer
+// within the range where float64 is exact, use NumberCodec to decode larger integers.
+func int64Value(v interface{}) (int64, bool) {
+	switch vv := v.(type) {
+	case json.Number:
+		o, err := vv.Int64()
+		return o, err == nil
+	case string:
+		o, err := strconv.ParseInt(vv, 10, 64)
+		return o, err == nil
+	case float64:
+		const maxExact = 1 << 53
+		if vv != math.Trunc(vv) || math.Abs(vv) > maxExact {
+			return 0, false
+		}
+		return int64(vv), true
+	case int64:
+		return vv, true
+	case int:
+		return int64(vv), true
+	default:
+		return 0, false
+	}
+}
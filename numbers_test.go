@@ -0,0 +1,49 @@
+package sdk
+
+import (
+	"encoding/json"
+	"testing"
+)
+
+func Test_int64Value(t *testing.T) {
+	tests := []struct {
+		name   string
+		v      interface{}
+		want   int64
+		wantOK bool
+	}{
+		{name: "json.Number", v: json.Number("9007199254740993"), want: 9007199254740993, wantOK: true},
+		{name: "string", v: "100", want: 100, wantOK: true},
+		{name: "float64 integer", v: float64(42), want: 42, wantOK: true},
+		{name: "float64 fraction", v: 0.5},
+		{name: "float64 beyond exact range", v: float64(1 << 60)},
+		{name: "int", v: 7, want: 7, wantOK: true},
+		{name: "not a number", v: "foo"},
+		{name: "missing", v: nil},
+	}
+	for _, tt := range tests {
+		t.Run(
+			tt.name, func(t *testing.T) {
+				got, ok := int64Value(tt.v)
+				if got != tt.want || ok != tt.wantOK {
+					t.Errorf("int64Value() = %v, %v, want %v, %v", got, ok, tt.want, tt.wantOK)
+				}
+			},
+		)
+	}
+}
+
+func TestPgSettingsData_Int64(t *testing.T) {
+	c, err := NewClient(Config{Key: "foo", HTTPClient: NewMockHTTPClient(), Codec: NumberCodec{}})
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	var v PgSettingsData
+	if err := c.codec().Unmarshal([]byte(`{"max_connections":9007199254740993}`), &v); err != nil {
+		t.Fatal(err)
+	}
+	if got, ok := v.Int64("max_connections"); !ok || got != 9007199254740993 {
+		t.Errorf("Int64() = %v, %v", got, ok)
+	}
+}
@@ -0,0 +1,208 @@
+package sdk
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"time"
+)
+
+// ErrOperationFailed the error returned when the awaited operation failed.
+var ErrOperationFailed = errors.New("operation failed")
+
+// operationsPollInterval the interval between the checks of the awaited operations' status.
+var operationsPollInterval = time.Second
+
+// operationsMaxPollInterval the maximum interval between the checks of the operations' status
+// awaited with the exponential backoff.
+var operationsMaxPollInterval = 30 * time.Second
+
+// OperationFailedError the error carrying the failed operation. It matches ErrOperationFailed with errors.Is.
+type OperationFailedError struct {
+	Operation Operation
+}
+
+func (e *OperationFailedError) Error() string {
+	op := e.Operation
+	msg := ErrOperationFailed.Error() + ": " + string(op.Action) + " " + op.ID
+	if op.ProjectID != "" {
+		msg += " of project " + op.ProjectID
+	}
+	if op.BranchID != nil {
+		msg += ", branch " + *op.BranchID
+	}
+	if op.EndpointID != nil {
+		msg += ", endpoint " + *op.EndpointID
+	}
+	if op.Error != nil && *op.Error != "" {
+		msg += ": " + *op.Error
+	}
+	return msg
+}
+
+func (e *OperationFailedError) Is(target error) bool {
+	return target == ErrOperationFailed
+}
+
+// IsTerminal defines if the operation reached the final status, i.e. its status will not change.
+// Note that the status "error" is not final because the failed operation is retried.
+func (o Operation) IsTerminal() bool {
+	switch o.Status {
+	case OperationStatusFinished, OperationStatusFailed, OperationStatusCancelled, OperationStatusSkipped:
+		return true
+	default:
+		return false
+	}
+}
+
+// Failed defines if the operation failed permanently.
+func (o Operation) Failed() bool {
+	return o.Status == OperationStatusFailed
+}
+
+// AllFinished defines if all operations reached the final status.
+func (r OperationsResponse) AllFinished() bool {
+	for _, op := range r.Operations {
+		if !op.IsTerminal() {
+			return false
+		}
+	}
+	return true
+}
+
+// OperationsFilter defines the client-side filter of the project's operations.
+// Empty attributes do not filter.
+type OperationsFilter struct {
+	// Actions the operations' actions to keep.
+	Actions []OperationAction
+	// Statuses the operations' statuses to keep.
+	Statuses []OperationStatus
+	// From keeps the operations created at, or after the timestamp.
+	From time.Time
+	// To keeps the operations created before the timestamp.
+	To time.Time
+}
+
+func (f OperationsFilter) match(op Operation) bool {
+	if len(f.Actions) > 0 && !containsOperationAction(f.Actions, op.Action) {
+		return false
+	}
+	if len(f.Statuses) > 0 && !containsOperationStatus(f.Statuses, op.Status) {
+		return false
+	}
+	if !f.From.IsZero() && op.CreatedAt.Before(f.From) {
+		return false
+	}
+	if !f.To.IsZero() && !op.CreatedAt.Before(f.To) {
+		return false
+	}
+	return true
+}
+
+func containsOperationAction(v []OperationAction, el OperationAction) bool {
+	for _, s := range v {
+		if s == el {
+			return true
+		}
+	}
+	return false
+}
+
+func containsOperationStatus(v []OperationStatus, el OperationStatus) bool {
+	for _, s := range v {
+		if s == el {
+			return true
+		}
+	}
+	return false
+}
+
+// ExportOperations pages through all project's operations and returns those matching the filter.
+func (c Client) ExportOperations(ctx context.Context, projectID string, filter OperationsFilter) ([]Operation, error) {
+	var o []Operation
+	if err := c.walkProjectOperations(
+		ctx, projectID, func(op Operation) error {
+			if filter.match(op) {
+				o = append(o, op)
+			}
+			return nil
+		},
+	); err != nil {
+		return nil, err
+	}
+	return o, nil
+}
+
+// StreamOperations pages through all project's operations and writes those matching the filter to w
+// as JSON lines, i.e. one JSON object per line.
+func (c Client) StreamOperations(ctx context.Context, projectID string, filter OperationsFilter, w io.Writer) error {
+	enc := json.NewEncoder(w)
+	return c.walkProjectOperations(
+		ctx, projectID, func(op Operation) error {
+			if !filter.match(op) {
+				return nil
+			}
+			return enc.Encode(op)
+		},
+	)
+}
+
+// WaitForAllOperations awaits the operations, see WaitForOperations.
+func (c Client) WaitForAllOperations(ctx context.Context, projectID string, ops []Operation) error {
+	return c.WaitForOperations(ctx, projectID, ops)
+}
+
+// WaitForOperations polls the operations' status until all of them reach the final status, e.g. to await
+// the operations returned by CreateProject. The duplicated operations are awaited once, all pending operations
+// are polled in a single round, and the interval between the rounds grows exponentially.
+// The error *OperationFailedError matching ErrOperationFailed is returned for the first failed operation.
+func (c Client) WaitForOperations(ctx context.Context, projectID string, ops []Operation) error {
+	var (
+		pending = make([]Operation, 0, len(ops))
+		seen    = make(map[string]bool, len(ops))
+	)
+	for _, op := range ops {
+		if seen[op.ID] {
+			continue
+		}
+		seen[op.ID] = true
+
+		if op.Failed() {
+			return &OperationFailedError{Operation: op}
+		}
+		if !op.IsTerminal() {
+			pending = append(pending, op)
+		}
+	}
+
+	interval := operationsPollInterval
+	for len(pending) > 0 {
+		select {
+		case <-ctx.Done():
+			return ctx.Err()
+		case <-time.After(interval):
+		}
+
+		next := pending[:0]
+		for _, op := range pending {
+			resp, err := c.GetProjectOperation(projectID, op.ID)
+			if err != nil {
+				return fmt.Errorf("could not read operation %s: %w", op.ID, err)
+			}
+			switch {
+			case resp.Operation.Failed():
+				return &OperationFailedError{Operation: resp.Operation}
+			case !resp.Operation.IsTerminal():
+				next = append(next, resp.Operation)
+			}
+		}
+		pending = next
+
+		if interval *= 2; interval > operationsMaxPollInterval {
+			interval = operationsMaxPollInterval
+		}
+	}
+	return nil
+}
@@ -0,0 +1,266 @@
+package sdk
+
+import (
+	"bytes"
+	"context"
+	"errors"
+	"net/http"
+	"strings"
+	"sync/atomic"
+	"testing"
+	"time"
+)
+
+func newOperationsFakeAPI() *fakeAPI {
+	return newFakeAPI().onFunc(
+		http.MethodGet, "/projects/foo/operations", func(req *http.Request) (int, string) {
+			if req.URL.Query().Get("cursor") == "" {
+				return http.StatusOK, `{"operations":[` +
+					repeatJSON(
+						`{"id":"op1","action":"start_compute","status":"finished","created_at":"2024-01-01T00:00:00Z"}`,
+						listOperationsPageLimit-1,
+					) +
+					`,{"id":"op2","action":"create_branch","status":"failed","created_at":"2024-01-02T00:00:00Z"}` +
+					`],"pagination":{"cursor":"op2"}}`
+			}
+			return http.StatusOK, `{"operations":[
+{"id":"op3","action":"create_branch","status":"finished","created_at":"2024-01-03T00:00:00Z"}
+],"pagination":{"cursor":"op3"}}`
+		},
+	)
+}
+
+func TestOperation_IsTerminal(t *testing.T) {
+	tests := []struct {
+		status       OperationStatus
+		wantTerminal bool
+		wantFailed   bool
+	}{
+		{status: OperationStatusScheduling},
+		{status: OperationStatusRunning},
+		{status: OperationStatusCancelling},
+		{status: OperationStatusError},
+		{status: OperationStatusFinished, wantTerminal: true},
+		{status: OperationStatusSkipped, wantTerminal: true},
+		{status: OperationStatusCancelled, wantTerminal: true},
+		{status: OperationStatusFailed, wantTerminal: true, wantFailed: true},
+	}
+	for _, tt := range tests {
+		t.Run(
+			string(tt.status), func(t *testing.T) {
+				op := Operation{Status: tt.status}
+				if got := op.IsTerminal(); got != tt.wantTerminal {
+					t.Errorf("IsTerminal() = %v, want %v", got, tt.wantTerminal)
+				}
+				if got := op.Failed(); got != tt.wantFailed {
+					t.Errorf("Failed() = %v, want %v", got, tt.wantFailed)
+				}
+			},
+		)
+	}
+}
+
+func TestOperationsResponse_AllFinished(t *testing.T) {
+	tests := []struct {
+		name string
+		ops  []Operation
+		want bool
+	}{
+		{name: "no operations", want: true},
+		{
+			name: "all terminal",
+			ops:  []Operation{{Status: OperationStatusFinished}, {Status: OperationStatusFailed}},
+			want: true,
+		},
+		{
+			name: "one running",
+			ops:  []Operation{{Status: OperationStatusFinished}, {Status: OperationStatusRunning}},
+			want: false,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(
+			tt.name, func(t *testing.T) {
+				if got := (OperationsResponse{Operations: tt.ops}).AllFinished(); got != tt.want {
+					t.Errorf("AllFinished() = %v, want %v", got, tt.want)
+				}
+			},
+		)
+	}
+}
+
+func TestClient_ExportOperations(t *testing.T) {
+	tests := []struct {
+		name    string
+		filter  OperationsFilter
+		wantIDs []string
+	}{
+		{
+			name: "by action",
+			filter: OperationsFilter{
+				Actions: []OperationAction{OperationActionCreateBranch},
+			},
+			wantIDs: []string{"op2", "op3"},
+		},
+		{
+			name: "by status",
+			filter: OperationsFilter{
+				Statuses: []OperationStatus{OperationStatusFailed},
+			},
+			wantIDs: []string{"op2"},
+		},
+		{
+			name: "by time range",
+			filter: OperationsFilter{
+				Actions: []OperationAction{OperationActionCreateBranch},
+				From:    time.Date(2024, 1, 2, 0, 0, 0, 0, time.UTC),
+				To:      time.Date(2024, 1, 3, 0, 0, 0, 0, time.UTC),
+			},
+			wantIDs: []string{"op2"},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(
+			tt.name, func(t *testing.T) {
+				c, err := NewClient(Config{Key: "foo", HTTPClient: newOperationsFakeAPI()})
+				if err != nil {
+					t.Fatal(err)
+				}
+
+				got, err := c.ExportOperations(context.TODO(), "foo", tt.filter)
+				if err != nil {
+					t.Fatal(err)
+				}
+
+				var gotIDs []string
+				for _, op := range got {
+					gotIDs = append(gotIDs, op.ID)
+				}
+				if strings.Join(gotIDs, ",") != strings.Join(tt.wantIDs, ",") {
+					t.Errorf("ExportOperations() got = %v, want %v", gotIDs, tt.wantIDs)
+				}
+			},
+		)
+	}
+}
+
+func TestClient_StreamOperations(t *testing.T) {
+	c, err := NewClient(Config{Key: "foo", HTTPClient: newOperationsFakeAPI()})
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	var buf bytes.Buffer
+	if err := c.StreamOperations(
+		context.TODO(), "foo", OperationsFilter{Actions: []OperationAction{OperationActionCreateBranch}}, &buf,
+	); err != nil {
+		t.Fatal(err)
+	}
+
+	lines := strings.Split(strings.TrimSpace(buf.String()), "\n")
+	if len(lines) != 2 || !strings.Contains(lines[0], `"id":"op2"`) {
+		t.Errorf("unexpected JSON lines: %v", lines)
+	}
+}
+
+func TestClient_WaitForOperations(t *testing.T) {
+	tests := []struct {
+		name    string
+		ops     []Operation
+		api     *fakeAPI
+		wantErr error
+	}{
+		{
+			name: "all operations finished",
+			ops:  []Operation{{ID: "op1", Status: OperationStatusFinished}},
+			api:  newFakeAPI(),
+		},
+		{
+			name: "operation finished after polling",
+			ops:  []Operation{{ID: "op1", Status: OperationStatusRunning}},
+			api: newFakeAPI().on(
+				http.MethodGet, "/projects/foo/operations/op1", http.StatusOK,
+				`{"operation":{"id":"op1","status":"finished"}}`,
+			),
+		},
+		{
+			name: "unhappy path: operation failed",
+			ops:  []Operation{{ID: "op1", Status: OperationStatusScheduling}},
+			api: newFakeAPI().on(
+				http.MethodGet, "/projects/foo/operations/op1", http.StatusOK,
+				`{"operation":{"id":"op1","status":"failed"}}`,
+			),
+			wantErr: ErrOperationFailed,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(
+			tt.name, func(t *testing.T) {
+				c, err := NewClient(Config{Key: "foo", HTTPClient: tt.api})
+				if err != nil {
+					t.Fatal(err)
+				}
+
+				err = c.WaitForOperations(context.TODO(), "foo", tt.ops)
+				if !errors.Is(err, tt.wantErr) {
+					t.Errorf("WaitForOperations() error = %v, wantErr %v", err, tt.wantErr)
+				}
+			},
+		)
+	}
+}
+
+func TestClient_WaitForAllOperations(t *testing.T) {
+	var polls int32
+	api := newFakeAPI().
+		onFunc(
+			http.MethodGet, "/projects/foo/operations/op1", func(_ *http.Request) (int, string) {
+				if atomic.AddInt32(&polls, 1) < 3 {
+					return http.StatusOK, `{"operation":{"id":"op1","status":"running"}}`
+				}
+				return http.StatusOK, `{"operation":{"id":"op1","status":"finished"}}`
+			},
+		).
+		on(
+			http.MethodGet, "/projects/foo/operations/op2", http.StatusOK,
+			`{"operation":{"id":"op2","status":"finished"}}`,
+		).
+		on(
+			http.MethodGet, "/projects/foo/operations/op3", http.StatusOK,
+			`{"operation":{"id":"op3","project_id":"foo","branch_id":"br-foo","action":"create_branch",
+"status":"failed","error":"no space left"}}`,
+		)
+	c, err := NewClient(Config{Key: "foo", HTTPClient: api})
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if err := c.WaitForAllOperations(
+		context.TODO(), "foo", []Operation{
+			{ID: "op1", Status: OperationStatusRunning},
+			{ID: "op2", Status: OperationStatusScheduling},
+			{ID: "op1", Status: OperationStatusRunning},
+		},
+	); err != nil {
+		t.Fatal(err)
+	}
+	if n := api.called(http.MethodGet, "/projects/foo/operations/op1"); n != 3 {
+		t.Errorf("duplicated operation shall be polled once per round, got %d calls", n)
+	}
+	if n := api.called(http.MethodGet, "/projects/foo/operations/op2"); n != 1 {
+		t.Errorf("finished operation shall not be polled again, got %d calls", n)
+	}
+
+	err = c.WaitForAllOperations(
+		context.TODO(), "foo", []Operation{
+			{ID: "op2", Status: OperationStatusRunning}, {ID: "op3", Status: OperationStatusRunning},
+		},
+	)
+	var e *OperationFailedError
+	if !errors.Is(err, ErrOperationFailed) || !errors.As(err, &e) || e.Operation.ID != "op3" {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if want := "operation failed: create_branch op3 of project foo, branch br-foo: no space left"; err.Error() != want {
+		t.Errorf("unexpected error message: %s, want %s", err, want)
+	}
+}
@@ -0,0 +1,94 @@
+package sdk
+
+import (
+	"bytes"
+	"encoding/json"
+)
+
+// Optional the optional request's attribute which is either set to the value, or unset and omitted
+// from the request. Unlike the pointer, it does not require the variable to take the address of,
+// see Nullable to send the explicit null. The unset Optional is nil, hence it is omitted with omitempty.
+type Optional[T any] map[bool]T
+
+// NewOptional returns Optional set to the value.
+func NewOptional[T any](v T) Optional[T] {
+	return Optional[T]{true: v}
+}
+
+// OptionalFromPointer returns Optional set to the pointer's value, or unset Optional if the pointer is nil.
+func OptionalFromPointer[T any](p *T) Optional[T] {
+	if p == nil {
+		return nil
+	}
+	return NewOptional(*p)
+}
+
+// Get returns the value, false if it is not set.
+func (o Optional[T]) Get() (T, bool) {
+	return getValue(o)
+}
+
+// IsSet defines if the value is set.
+func (o Optional[T]) IsSet() bool {
+	_, ok := o.Get()
+	return ok
+}
+
+// Pointer returns the pointer to the copy of the value, nil if the value is not set.
+func (o Optional[T]) Pointer() *T {
+	v, ok := o.Get()
+	if !ok {
+		return nil
+	}
+	return &v
+}
+
+// Set sets the value.
+func (o *Optional[T]) Set(v T) {
+	*o = NewOptional(v)
+}
+
+// Unset unsets the value, hence it is omitted from the request.
+func (o *Optional[T]) Unset() {
+	*o = nil
+}
+
+func (o Optional[T]) MarshalJSON() ([]byte, error) {
+	return marshalValue(o)
+}
+
+func (o *Optional[T]) UnmarshalJSON(data []byte) error {
+	v, null, err := unmarshalValue[T](data)
+	switch {
+	case err != nil:
+		return err
+	case null:
+		o.Unset()
+	default:
+		o.Set(v)
+	}
+	return nil
+}
+
+// getValue returns the value of Optional, or Nullable, stored under the key true.
+func getValue[T any](m map[bool]T) (T, bool) {
+	v, ok := m[true]
+	return v, ok
+}
+
+// marshalValue encodes the value of Optional, or Nullable, as JSON, or null if the value is not set.
+func marshalValue[T any](m map[bool]T) ([]byte, error) {
+	if v, ok := getValue(m); ok {
+		return json.Marshal(v)
+	}
+	return []byte("null"), nil
+}
+
+// unmarshalValue decodes the JSON value of Optional, or Nullable, the flag defines if the value is null.
+func unmarshalValue[T any](data []byte) (v T, null bool, err error) {
+	if bytes.Equal(bytes.TrimSpace(data), []byte("null")) {
+		return v, true, nil
+	}
+	err = json.Unmarshal(data, &v)
+	return v, false, err
+}
@@ -0,0 +1,80 @@
+package sdk
+
+import (
+	"encoding/json"
+	"testing"
+)
+
+func TestOptional_MarshalJSON(t *testing.T) {
+	type request struct {
+		Foo Optional[int] `json:"foo,omitempty"`
+	}
+
+	tests := []struct {
+		name string
+		v    request
+		want string
+	}{
+		{
+			name: "unset",
+			v:    request{},
+			want: `{}`,
+		},
+		{
+			name: "zero value",
+			v:    request{Foo: NewOptional(0)},
+			want: `{"foo":0}`,
+		},
+		{
+			name: "value",
+			v:    request{Foo: NewOptional(1)},
+			want: `{"foo":1}`,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(
+			tt.name, func(t *testing.T) {
+				got, err := json.Marshal(tt.v)
+				if err != nil {
+					t.Fatal(err)
+				}
+				if string(got) != tt.want {
+					t.Errorf("Marshal() = %s, want %s", got, tt.want)
+				}
+
+				var v request
+				if err := json.Unmarshal(got, &v); err != nil {
+					t.Fatal(err)
+				}
+				gotV, gotOk := v.Foo.Get()
+				wantV, wantOk := tt.v.Foo.Get()
+				if gotV != wantV || gotOk != wantOk {
+					t.Errorf("Unmarshal() = %v, want %v", v.Foo, tt.v.Foo)
+				}
+			},
+		)
+	}
+}
+
+func TestOptional_Pointer(t *testing.T) {
+	var o Optional[string]
+	if o.IsSet() || o.Pointer() != nil {
+		t.Fatal("zero value must be unset")
+	}
+
+	v := "foo"
+	o = OptionalFromPointer(&v)
+	if p := o.Pointer(); p == nil || *p != v || p == &v {
+		t.Errorf("pointer to the copy of the value is expected, got %v", p)
+	}
+
+	o.Unset()
+	if o.IsSet() || OptionalFromPointer[string](nil).IsSet() {
+		t.Error("unset value is expected")
+	}
+
+	o.Set("bar")
+	if v, ok := o.Get(); !ok || v != "bar" {
+		t.Errorf("value is expected, got %v", o)
+	}
+}
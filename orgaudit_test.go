@@ -0,0 +1,107 @@
+package sdk
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"net/http"
+	"strings"
+	"testing"
+)
+
+func newOrgAuditFakeAPI() *fakeAPI {
+	return newFakeAPI().
+		on(http.MethodGet, "/organizations/org-foo", http.StatusOK, `{"id":"org-foo","name":"Foo"}`).
+		on(
+			http.MethodGet, "/organizations/org-foo/members", http.StatusOK,
+			`{"members":[{"member":{"id":"m1","org_id":"org-foo","role":"admin","user_id":"u1"},"user":{"email":"admin@foo.bar"}}]}`,
+		).
+		on(
+			http.MethodGet, "/organizations/org-foo/invitations", http.StatusOK,
+			`{"invitations":[{"id":"i1","email":"new@foo.bar","role":"member","org_id":"org-foo","invited_at":"2024-01-01T00:00:00Z"}]}`,
+		).
+		on(
+			http.MethodGet, "/organizations/org-foo/api_keys", http.StatusOK,
+			`[{"id":1,"name":"ci","created_at":"2024-01-01T00:00:00Z","created_by":{"id":"u1"}}]`,
+		).
+		on(
+			http.MethodGet, "/projects", http.StatusOK,
+			`{"projects":[{"id":"p1","name":"bar"}],"pagination":{"cursor":"p1"}}`,
+		).
+		on(
+			http.MethodGet, "/projects/p1/permissions", http.StatusOK,
+			`{"project_permissions":[{"id":"perm1","granted_to_email":"guest@foo.bar","granted_at":"2024-01-02T00:00:00Z"},
+{"id":"perm2","granted_to_email":"former@foo.bar","granted_at":"2024-01-02T00:00:00Z",
+"revoked_at":"2024-01-03T00:00:00Z"}]}`,
+		)
+}
+
+func TestClient_AuditOrganization(t *testing.T) {
+	tests := []struct {
+		name     string
+		api      *fakeAPI
+		orgID    string
+		wantErr  bool
+		wantRows int
+	}{
+		{
+			name:     "happy path",
+			api:      newOrgAuditFakeAPI(),
+			orgID:    "org-foo",
+			wantRows: 5,
+		},
+		{
+			name: "unhappy path: permissions cannot be listed",
+			api: newOrgAuditFakeAPI().on(
+				http.MethodGet, "/projects/p1/permissions", http.StatusForbidden, `{"message":"forbidden"}`,
+			),
+			orgID:   "org-foo",
+			wantErr: true,
+		},
+		{
+			name:    "unhappy path: organization not found",
+			api:     newFakeAPI(),
+			orgID:   "org-bar",
+			wantErr: true,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(
+			tt.name, func(t *testing.T) {
+				c, err := NewClient(Config{Key: "foo", HTTPClient: tt.api})
+				if err != nil {
+					t.Fatal(err)
+				}
+
+				got, err := c.AuditOrganization(context.TODO(), tt.orgID)
+				if (err != nil) != tt.wantErr {
+					t.Fatalf("AuditOrganization() error = %v, wantErr %v", err, tt.wantErr)
+				}
+				if tt.wantErr {
+					return
+				}
+
+				if len(got.Projects) != 1 || len(got.Projects[0].Permissions) != 1 {
+					t.Errorf("unexpected projects in the report: %v", got.Projects)
+				}
+
+				var buf bytes.Buffer
+				if err := got.WriteCSV(&buf); err != nil {
+					t.Fatal(err)
+				}
+				if rows := strings.Count(buf.String(), "\n"); rows != tt.wantRows {
+					t.Errorf("WriteCSV() unexpected number of rows: want = %d, got = %d", tt.wantRows, rows)
+				}
+
+				buf.Reset()
+				if err := got.WriteJSON(&buf); err != nil {
+					t.Fatal(err)
+				}
+				var v OrgAuditReport
+				if err := json.Unmarshal(buf.Bytes(), &v); err != nil {
+					t.Errorf("WriteJSON() produced invalid JSON: %v", err)
+				}
+			},
+		)
+	}
+}
@@ -0,0 +1,82 @@
+package sdk
+
+import (
+	"context"
+	"fmt"
+	"strings"
+)
+
+// ListAllOrganizationMembers returns all organization's members. If fn is set, the members are streamed to it
+// one at a time instead of being accumulated, and nil is returned. The iteration stops when fn returns the error.
+// Note that the API returns all members in a single response for now, the helper shields the callers
+// from the introduction of pagination.
+func (c Client) ListAllOrganizationMembers(
+	ctx context.Context, orgID string, fn func(MemberWithUser) error,
+) ([]MemberWithUser, error) {
+	if err := ctx.Err(); err != nil {
+		return nil, err
+	}
+
+	resp, err := c.GetOrganizationMembers(orgID)
+	if err != nil {
+		return nil, fmt.Errorf("could not list members of organization %s: %w", orgID, err)
+	}
+	return streamItems(ctx, resp.Members, fn)
+}
+
+// ListAllOrganizationInvitations returns all organization's pending invitations. If fn is set, the invitations
+// are streamed to it one at a time instead of being accumulated, and nil is returned.
+// The iteration stops when fn returns the error.
+func (c Client) ListAllOrganizationInvitations(
+	ctx context.Context, orgID string, fn func(Invitation) error,
+) ([]Invitation, error) {
+	if err := ctx.Err(); err != nil {
+		return nil, err
+	}
+
+	resp, err := c.GetOrganizationInvitations(orgID)
+	if err != nil {
+		return nil, fmt.Errorf("could not list invitations of organization %s: %w", orgID, err)
+	}
+	return streamItems(ctx, resp.Invitations, fn)
+}
+
+// FindOrganizationMemberByEmail returns the organization's member with the email, the emails are compared
+// case-insensitively. The error wrapping ErrNotFound is returned if the organization has no such member.
+func (c Client) FindOrganizationMemberByEmail(ctx context.Context, orgID, email string) (MemberWithUser, error) {
+	var (
+		o     MemberWithUser
+		found bool
+	)
+	if _, err := c.ListAllOrganizationMembers(
+		ctx, orgID, func(m MemberWithUser) error {
+			if !found && strings.EqualFold(m.User.Email, email) {
+				o, found = m, true
+			}
+			return nil
+		},
+	); err != nil {
+		return MemberWithUser{}, err
+	}
+
+	if !found {
+		return MemberWithUser{}, fmt.Errorf("%w: member %s of organization %s", ErrNotFound, email, orgID)
+	}
+	return o, nil
+}
+
+// streamItems passes the items to fn, or returns them if fn is nil.
+func streamItems[T any](ctx context.Context, items []T, fn func(T) error) ([]T, error) {
+	if fn == nil {
+		return items, nil
+	}
+	for _, el := range items {
+		if err := ctx.Err(); err != nil {
+			return nil, err
+		}
+		if err := fn(el); err != nil {
+			return nil, err
+		}
+	}
+	return nil, nil
+}
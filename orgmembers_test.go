@@ -0,0 +1,104 @@
+package sdk
+
+import (
+	"context"
+	"errors"
+	"net/http"
+	"testing"
+)
+
+func newOrgMembersFakeAPI() *fakeAPI {
+	return newFakeAPI().
+		on(
+			http.MethodGet, "/organizations/org-foo/members", http.StatusOK, `{"members":[
+{"member":{"id":"m1","role":"admin"},"user":{"email":"Alice@example.com"}},
+{"member":{"id":"m2","role":"member"},"user":{"email":"bob@example.com"}}
+]}`,
+		).
+		on(
+			http.MethodGet, "/organizations/org-foo/invitations", http.StatusOK, `{"invitations":[
+{"id":"inv1","email":"carol@example.com","role":"member"}
+]}`,
+		)
+}
+
+func TestClient_ListAllOrganizationMembers(t *testing.T) {
+	c, err := NewClient(Config{Key: "foo", HTTPClient: newOrgMembersFakeAPI()})
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	got, err := c.ListAllOrganizationMembers(context.TODO(), "org-foo", nil)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(got) != 2 {
+		t.Errorf("unexpected number of members: %d", len(got))
+	}
+
+	var streamed []string
+	got, err = c.ListAllOrganizationMembers(
+		context.TODO(), "org-foo", func(m MemberWithUser) error {
+			streamed = append(streamed, m.Member.ID)
+			return nil
+		},
+	)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if got != nil || len(streamed) != 2 {
+		t.Errorf("members shall be streamed, got %v, streamed %v", got, streamed)
+	}
+
+	errStop := errors.New("stop")
+	if _, err := c.ListAllOrganizationMembers(
+		context.TODO(), "org-foo", func(MemberWithUser) error { return errStop },
+	); !errors.Is(err, errStop) {
+		t.Errorf("unexpected error: %v", err)
+	}
+}
+
+func TestClient_ListAllOrganizationInvitations(t *testing.T) {
+	c, err := NewClient(Config{Key: "foo", HTTPClient: newOrgMembersFakeAPI()})
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	got, err := c.ListAllOrganizationInvitations(context.TODO(), "org-foo", nil)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(got) != 1 || got[0].ID != "inv1" {
+		t.Errorf("unexpected invitations: %v", got)
+	}
+}
+
+func TestClient_FindOrganizationMemberByEmail(t *testing.T) {
+	c, err := NewClient(Config{Key: "foo", HTTPClient: newOrgMembersFakeAPI()})
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	tests := []struct {
+		name    string
+		email   string
+		wantID  string
+		wantErr error
+	}{
+		{name: "case-insensitive match", email: "alice@EXAMPLE.com", wantID: "m1"},
+		{name: "not found", email: "carol@example.com", wantErr: ErrNotFound},
+	}
+	for _, tt := range tests {
+		t.Run(
+			tt.name, func(t *testing.T) {
+				got, err := c.FindOrganizationMemberByEmail(context.TODO(), "org-foo", tt.email)
+				if !errors.Is(err, tt.wantErr) {
+					t.Fatalf("FindOrganizationMemberByEmail() error = %v, wantErr %v", err, tt.wantErr)
+				}
+				if got.Member.ID != tt.wantID {
+					t.Errorf("FindOrganizationMemberByEmail() got = %s, want %s", got.Member.ID, tt.wantID)
+				}
+			},
+		)
+	}
+}
@@ -0,0 +1,192 @@
+package sdk
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"strings"
+	"time"
+)
+
+// DirectoryUser the user of the external directory.
+type DirectoryUser struct {
+	Email string
+	// Role the user's role in the organization, MemberRoleMember is used if it is empty.
+	Role MemberRole
+}
+
+// Directory the external directory, e.g. the SCIM, or LDAP adapter, defining the organization's members.
+type Directory interface {
+	ListUsers(ctx context.Context) ([]DirectoryUser, error)
+}
+
+// OrgSyncOptions defines how the organization's members are reconciled with the directory.
+type OrgSyncOptions struct {
+	// RemoveMembers defines if the members missing in the directory shall be removed from the organization.
+	// They are only reported as unmanaged otherwise.
+	RemoveMembers bool
+	// DryRun defines if the changes shall only be planned, not applied.
+	DryRun bool
+}
+
+// OrgSyncResult the changes of the organization's members reconciled with the directory.
+type OrgSyncResult struct {
+	// Invited the invitations sent to the directory's users who are neither members, nor invited.
+	Invited []OrganizationInviteCreateRequest
+	// Updated the members whose role was changed, the members' role is the new one.
+	Updated []MemberWithUser
+	// Removed the members removed because they are missing in the directory.
+	Removed []MemberWithUser
+	// Unmanaged the members missing in the directory which were not removed.
+	Unmanaged []MemberWithUser
+}
+
+// SyncOrganizationMembers reconciles the organization's members and invitations with the directory:
+// the missing users are invited, the members' roles are updated, and the members missing in the directory
+// are removed if requested. The users are matched by the case-insensitive email. Note that the pending
+// invitations are not updated because the API does not support it. If a change fails, the changes applied
+// until the failure are returned along with the error.
+func (c Client) SyncOrganizationMembers(
+	ctx context.Context, orgID string, dir Directory, opts OrgSyncOptions,
+) (OrgSyncResult, error) {
+	var o OrgSyncResult
+
+	users, err := dir.ListUsers(ctx)
+	if err != nil {
+		return o, fmt.Errorf("could not list directory users: %w", err)
+	}
+
+	members, err := c.GetOrganizationMembers(orgID)
+	if err != nil {
+		return o, fmt.Errorf("could not list members of organization %s: %w", orgID, err)
+	}
+
+	invitations, err := c.GetOrganizationInvitations(orgID)
+	if err != nil {
+		return o, fmt.Errorf("could not list invitations of organization %s: %w", orgID, err)
+	}
+
+	plan := planOrgSync(users, members.Members, invitations.Invitations, opts.RemoveMembers)
+	if opts.DryRun {
+		return plan, nil
+	}
+	return c.applyOrgSync(ctx, orgID, plan)
+}
+
+// planOrgSync defines the changes of the organization's members required to match the directory's users.
+func planOrgSync(
+	users []DirectoryUser, members []MemberWithUser, invitations []Invitation, removeMembers bool,
+) OrgSyncResult {
+	var o OrgSyncResult
+
+	wanted := make(map[string]MemberRole, len(users))
+	for _, u := range users {
+		role := u.Role
+		if role == "" {
+			role = MemberRoleMember
+		}
+		wanted[strings.ToLower(u.Email)] = role
+	}
+
+	known := map[string]bool{}
+	for _, inv := range invitations {
+		known[strings.ToLower(inv.Email)] = true
+	}
+
+	for _, m := range members {
+		email := strings.ToLower(m.User.Email)
+		known[email] = true
+
+		role, ok := wanted[email]
+		switch {
+		case !ok && !removeMembers:
+			o.Unmanaged = append(o.Unmanaged, m)
+		case !ok:
+			o.Removed = append(o.Removed, m)
+		case role != m.Member.Role:
+			m.Member.Role = role
+			o.Updated = append(o.Updated, m)
+		}
+	}
+
+	for _, u := range users {
+		email := strings.ToLower(u.Email)
+		if known[email] {
+			continue
+		}
+		known[email] = true
+		o.Invited = append(o.Invited, OrganizationInviteCreateRequest{Email: u.Email, Role: wanted[email]})
+	}
+	return o
+}
+
+// applyOrgSync applies the planned changes of the organization's members.
+// The changes applied until the failure are returned along with the error.
+func (c Client) applyOrgSync(ctx context.Context, orgID string, plan OrgSyncResult) (OrgSyncResult, error) {
+	o := OrgSyncResult{Unmanaged: plan.Unmanaged}
+
+	for _, m := range plan.Removed {
+		if err := ctx.Err(); err != nil {
+			return o, err
+		}
+		if _, err := c.RemoveOrganizationMember(orgID, m.Member.ID); err != nil {
+			return o, fmt.Errorf("could not remove member %s: %w", m.User.Email, err)
+		}
+		o.Removed = append(o.Removed, m)
+	}
+
+	for _, m := range plan.Updated {
+		if err := ctx.Err(); err != nil {
+			return o, err
+		}
+		updated, err := c.UpdateOrganizationMember(
+			orgID, m.Member.ID, OrganizationMemberUpdateRequest{Role: m.Member.Role},
+		)
+		if err != nil {
+			return o, fmt.Errorf("could not update role of member %s: %w", m.User.Email, err)
+		}
+		m.Member = updated
+		o.Updated = append(o.Updated, m)
+	}
+
+	if len(plan.Invited) == 0 {
+		return o, nil
+	}
+	if err := ctx.Err(); err != nil {
+		return o, err
+	}
+	if _, err := c.CreateOrganizationInvitations(
+		orgID, OrganizationInvitesCreateRequest{Invitations: plan.Invited},
+	); err != nil {
+		return o, fmt.Errorf("could not invite %d users: %w", len(plan.Invited), err)
+	}
+	o.Invited = plan.Invited
+	return o, nil
+}
+
+// RunOrganizationSync reconciles the organization's members with the directory periodically until
+// the context is cancelled. The result of every reconciliation is passed to fn, the reconciliation's
+// failure does not stop the subsequent runs. The interval between the runs must be positive.
+func (c Client) RunOrganizationSync(
+	ctx context.Context, orgID string, dir Directory, opts OrgSyncOptions, interval time.Duration,
+	fn func(OrgSyncResult, error),
+) error {
+	if interval <= 0 {
+		return errors.New("organization sync interval must be positive")
+	}
+	ticker := time.NewTicker(interval)
+	defer ticker.Stop()
+
+	for {
+		o, err := c.SyncOrganizationMembers(ctx, orgID, dir, opts)
+		if fn != nil {
+			fn(o, err)
+		}
+
+		select {
+		case <-ctx.Done():
+			return ctx.Err()
+		case <-ticker.C:
+		}
+	}
+}
@@ -0,0 +1,173 @@
+package sdk
+
+import (
+	"context"
+	"errors"
+	"io"
+	"net/http"
+	"strings"
+	"testing"
+	"time"
+)
+
+type staticDirectory []DirectoryUser
+
+func (d staticDirectory) ListUsers(_ context.Context) ([]DirectoryUser, error) {
+	return d, nil
+}
+
+func newOrgSyncFakeAPI(gotInvitations *string) *fakeAPI {
+	return newFakeAPI().on(
+		http.MethodGet, "/organizations/org-foo/members", http.StatusOK, `{"members":[
+{"member":{"id":"m-alice","role":"member"},"user":{"email":"alice@example.com"}},
+{"member":{"id":"m-bob","role":"member"},"user":{"email":"bob@example.com"}},
+{"member":{"id":"m-eve","role":"admin"},"user":{"email":"eve@example.com"}}
+]}`,
+	).on(
+		http.MethodGet, "/organizations/org-foo/invitations", http.StatusOK,
+		`{"invitations":[{"id":"inv-carol","email":"carol@example.com","role":"member"}]}`,
+	).on(
+		http.MethodPatch, "/organizations/org-foo/members/m-bob", http.StatusOK, `{"id":"m-bob","role":"admin"}`,
+	).on(
+		http.MethodDelete, "/organizations/org-foo/members/m-eve", http.StatusOK, `{}`,
+	).onFunc(
+		http.MethodPost, "/organizations/org-foo/invitations", func(req *http.Request) (int, string) {
+			b, _ := io.ReadAll(req.Body)
+			*gotInvitations = string(b)
+			return http.StatusOK, `{"invitations":[]}`
+		},
+	)
+}
+
+func TestClient_SyncOrganizationMembers(t *testing.T) {
+	dir := staticDirectory{
+		{Email: "Alice@example.com"},
+		{Email: "bob@example.com", Role: MemberRoleAdmin},
+		{Email: "carol@example.com"},
+		{Email: "dave@example.com"},
+	}
+
+	tests := []struct {
+		name            string
+		opts            OrgSyncOptions
+		wantRemoved     int
+		wantUnmanaged   int
+		wantAPIChanges  bool
+		wantInvitations string
+	}{
+		{
+			name:            "members removed",
+			opts:            OrgSyncOptions{RemoveMembers: true},
+			wantRemoved:     1,
+			wantAPIChanges:  true,
+			wantInvitations: `{"invitations":[{"email":"dave@example.com","role":"member"}]}`,
+		},
+		{
+			name:            "unmanaged members kept",
+			wantUnmanaged:   1,
+			wantAPIChanges:  true,
+			wantInvitations: `{"invitations":[{"email":"dave@example.com","role":"member"}]}`,
+		},
+		{
+			name:        "dry run",
+			opts:        OrgSyncOptions{RemoveMembers: true, DryRun: true},
+			wantRemoved: 1,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(
+			tt.name, func(t *testing.T) {
+				var gotInvitations string
+				api := newOrgSyncFakeAPI(&gotInvitations)
+				c, err := NewClient(Config{Key: "foo", HTTPClient: api})
+				if err != nil {
+					t.Fatal(err)
+				}
+
+				got, err := c.SyncOrganizationMembers(context.TODO(), "org-foo", dir, tt.opts)
+				if err != nil {
+					t.Fatal(err)
+				}
+
+				if len(got.Invited) != 1 || got.Invited[0].Email != "dave@example.com" {
+					t.Errorf("unexpected invitations: %v", got.Invited)
+				}
+				if len(got.Updated) != 1 || got.Updated[0].Member.Role != MemberRoleAdmin {
+					t.Errorf("unexpected updated members: %v", got.Updated)
+				}
+				if len(got.Removed) != tt.wantRemoved || len(got.Unmanaged) != tt.wantUnmanaged {
+					t.Errorf("unexpected removed: %v, unmanaged: %v", got.Removed, got.Unmanaged)
+				}
+
+				if gotInvitations != tt.wantInvitations {
+					t.Errorf("unexpected invitations request: %s", gotInvitations)
+				}
+				if n := api.called(http.MethodPatch, "/organizations/org-foo/members/m-bob"); (n > 0) != tt.wantAPIChanges {
+					t.Errorf("unexpected number of member updates: %d", n)
+				}
+				if n := api.called(http.MethodDelete, "/organizations/org-foo/members/m-eve"); (n > 0) != (tt.wantAPIChanges &&
+					tt.opts.RemoveMembers) {
+					t.Errorf("unexpected number of member removals: %d", n)
+				}
+			},
+		)
+	}
+}
+
+func TestClient_RunOrganizationSync(t *testing.T) {
+	var gotInvitations string
+	c, err := NewClient(Config{Key: "foo", HTTPClient: newOrgSyncFakeAPI(&gotInvitations)})
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	ctx, cancel := context.WithCancel(context.TODO())
+	var runs int
+	err = c.RunOrganizationSync(
+		ctx, "org-foo", staticDirectory{{Email: "dave@example.com"}}, OrgSyncOptions{}, time.Millisecond,
+		func(_ OrgSyncResult, err error) {
+			if err != nil {
+				t.Errorf("unexpected error: %v", err)
+			}
+			if runs++; runs == 2 {
+				cancel()
+			}
+		},
+	)
+	if !errors.Is(err, context.Canceled) {
+		t.Errorf("unexpected error: %v", err)
+	}
+	if runs != 2 {
+		t.Errorf("unexpected number of runs: %d", runs)
+	}
+	if !strings.Contains(gotInvitations, "dave@example.com") {
+		t.Errorf("unexpected invitations request: %s", gotInvitations)
+	}
+}
+
+func TestClient_RunOrganizationSync_invalidInterval(t *testing.T) {
+	for _, interval := range []time.Duration{0, -time.Second} {
+		t.Run(
+			interval.String(), func(t *testing.T) {
+				var gotInvitations string
+				api := newOrgSyncFakeAPI(&gotInvitations)
+				c, err := NewClient(Config{Key: "foo", HTTPClient: api})
+				if err != nil {
+					t.Fatal(err)
+				}
+
+				var runs int
+				err = c.RunOrganizationSync(
+					context.TODO(), "org-foo", staticDirectory{}, OrgSyncOptions{}, interval,
+					func(OrgSyncResult, error) { runs++ },
+				)
+				if err == nil {
+					t.Fatal("expected error")
+				}
+				if runs != 0 || api.called(http.MethodGet, "/organizations/org-foo/members") != 0 {
+					t.Errorf("unexpected sync runs: %d", runs)
+				}
+			},
+		)
+	}
+}
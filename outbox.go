@@ -0,0 +1,253 @@
+package sdk
+
+import (
+	"context"
+	"crypto/rand"
+	"encoding/hex"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"net/http"
+	"net/url"
+	"os"
+	"reflect"
+	"sync"
+	"time"
+)
+
+// idempotencyKeyHeader the header carrying the key of the mutating call when the client has the Outbox.
+// The key is kept by the OutboxEntry, and is sent again when the call is replayed.
+const idempotencyKeyHeader = "Idempotency-Key"
+
+func setIdempotencyKey(req *http.Request, key string) {
+	if key != "" {
+		req.Header.Set(idempotencyKeyHeader, key)
+	}
+}
+
+// OutboxEntry the mutating call which failed because the API was unavailable.
+type OutboxEntry struct {
+	// ID the idempotency key of the call.
+	ID     string          `json:"id"`
+	Method string          `json:"method"`
+	URL    string          `json:"url"`
+	Body   json.RawMessage `json:"body,omitempty"`
+	// Error the failure's message.
+	Error    string    `json:"error"`
+	FailedAt time.Time `json:"failed_at"`
+}
+
+// Outbox stores the failed mutating calls to replay them with Client.ReplayOutbox, e.g. after the API outage.
+// The calls are recorded when the request could not be sent, the circuit breaker is open,
+// or the API responded with 423, 429, or 5xx status code, i.e. the calls which may succeed later.
+type Outbox interface {
+	// Add stores the entry.
+	Add(e OutboxEntry) error
+	// Entries returns the stored entries in the order they were added.
+	Entries() ([]OutboxEntry, error)
+	// Remove deletes the entry with the ID.
+	Remove(id string) error
+}
+
+// MemoryOutbox the Outbox storing the entries in memory.
+type MemoryOutbox struct {
+	mu      sync.Mutex
+	entries []OutboxEntry
+}
+
+// NewMemoryOutbox initialises the MemoryOutbox.
+func NewMemoryOutbox() *MemoryOutbox {
+	return &MemoryOutbox{}
+}
+
+// Add stores the entry.
+func (o *MemoryOutbox) Add(e OutboxEntry) error {
+	o.mu.Lock()
+	defer o.mu.Unlock()
+	o.entries = append(o.entries, e)
+	return nil
+}
+
+// Entries returns the stored entries in the order they were added.
+func (o *MemoryOutbox) Entries() ([]OutboxEntry, error) {
+	o.mu.Lock()
+	defer o.mu.Unlock()
+	return append([]OutboxEntry(nil), o.entries...), nil
+}
+
+// Remove deletes the entry with the ID.
+func (o *MemoryOutbox) Remove(id string) error {
+	o.mu.Lock()
+	defer o.mu.Unlock()
+	o.entries = removeOutboxEntry(o.entries, id)
+	return nil
+}
+
+// FileOutbox the Outbox storing the entries in the JSON file, hence the entries survive the process restarts.
+// The file is rewritten on every change, and it is not expected to be shared by multiple processes.
+type FileOutbox struct {
+	mu   sync.Mutex
+	path string
+}
+
+// NewFileOutbox initialises the FileOutbox storing the entries in the file,
+// the file is created upon the first entry's addition.
+func NewFileOutbox(path string) *FileOutbox {
+	return &FileOutbox{path: path}
+}
+
+// Add stores the entry.
+func (o *FileOutbox) Add(e OutboxEntry) error {
+	o.mu.Lock()
+	defer o.mu.Unlock()
+	entries, err := o.read()
+	if err != nil {
+		return err
+	}
+	return o.write(append(entries, e))
+}
+
+// Entries returns the stored entries in the order they were added.
+func (o *FileOutbox) Entries() ([]OutboxEntry, error) {
+	o.mu.Lock()
+	defer o.mu.Unlock()
+	return o.read()
+}
+
+// Remove deletes the entry with the ID.
+func (o *FileOutbox) Remove(id string) error {
+	o.mu.Lock()
+	defer o.mu.Unlock()
+	entries, err := o.read()
+	if err != nil {
+		return err
+	}
+	return o.write(removeOutboxEntry(entries, id))
+}
+
+func (o *FileOutbox) read() ([]OutboxEntry, error) {
+	b, err := os.ReadFile(o.path)
+	if errors.Is(err, os.ErrNotExist) {
+		return nil, nil
+	}
+	if err != nil {
+		return nil, err
+	}
+	var v []OutboxEntry
+	if err := json.Unmarshal(b, &v); err != nil {
+		return nil, fmt.Errorf("could not decode outbox %s: %w", o.path, err)
+	}
+	return v, nil
+}
+
+func (o *FileOutbox) write(entries []OutboxEntry) error {
+	b, err := json.Marshal(entries)
+	if err != nil {
+		return err
+	}
+	tmp := o.path + ".tmp"
+	if err := os.WriteFile(tmp, b, 0o600); err != nil {
+		return err
+	}
+	return os.Rename(tmp, o.path)
+}
+
+func removeOutboxEntry(entries []OutboxEntry, id string) []OutboxEntry {
+	o := entries[:0]
+	for _, e := range entries {
+		if e.ID != id {
+			o = append(o, e)
+		}
+	}
+	return o
+}
+
+// newIdempotencyKey returns the random key of the mutating call, or the empty string
+// if the client has no Outbox, or the call does not change the resources.
+func (c Client) newIdempotencyKey(method string) string {
+	if c.cfg.Outbox == nil || method == http.MethodGet {
+		return ""
+	}
+	b := make([]byte, 16)
+	if _, err := rand.Read(b); err != nil {
+		return ""
+	}
+	return hex.EncodeToString(b)
+}
+
+// recordInOutbox stores the failed call in the Outbox if the call may succeed later.
+// The returned error is the call's error, extended with the Outbox's error if the call could not be stored.
+func (c Client) recordInOutbox(key, method, u string, reqPayload interface{}, err error) error {
+	if key == "" || err == nil || !isOutboxRetryable(err) {
+		return err
+	}
+
+	e := OutboxEntry{ID: key, Method: method, URL: u, Error: err.Error(), FailedAt: time.Now().UTC()}
+	if reqPayload != nil {
+		if v := reflect.ValueOf(reqPayload); v.Kind() == reflect.Struct || !v.IsNil() {
+			b, mErr := c.codec().Marshal(reqPayload)
+			if mErr != nil {
+				return fmt.Errorf("%w; could not record call in outbox: %v", err, mErr)
+			}
+			e.Body = b
+		}
+	}
+	if oErr := c.cfg.Outbox.Add(e); oErr != nil {
+		return fmt.Errorf("%w; could not record call in outbox: %v", err, oErr)
+	}
+	return err
+}
+
+// isOutboxRetryable defines if the failed call may succeed when it is replayed.
+func isOutboxRetryable(err error) bool {
+	if errors.Is(err, ErrCircuitOpen) {
+		return true
+	}
+	var e Error
+	if errors.As(err, &e) {
+		return e.HTTPCode == http.StatusLocked || e.HTTPCode == http.StatusTooManyRequests || e.HTTPCode >= 500
+	}
+	var ue *url.Error
+	return errors.As(err, &ue)
+}
+
+// ReplayOutbox resends the calls stored in the Outbox in the order they were recorded with their idempotency keys,
+// and removes the calls which succeeded. The replay stops at the first failure to keep the order of the changes,
+// the failed call is kept if it may succeed later, and it is removed otherwise. The replayed entries are returned
+// along with the error.
+func (c Client) ReplayOutbox(ctx context.Context) ([]OutboxEntry, error) {
+	if c.cfg.Outbox == nil {
+		return nil, errors.New("outbox is not configured")
+	}
+	entries, err := c.cfg.Outbox.Entries()
+	if err != nil {
+		return nil, fmt.Errorf("could not read outbox: %w", err)
+	}
+
+	var o []OutboxEntry
+	for _, e := range entries {
+		if err := ctx.Err(); err != nil {
+			return o, err
+		}
+
+		var payload interface{}
+		if len(e.Body) > 0 {
+			payload = e.Body
+		}
+		start := time.Now()
+		err := c.sendRequest(e.URL, e.Method, payload, nil, e.ID)
+		c.cfg.Audit.record(e.Method, e.URL, start, err)
+
+		if err != nil && isOutboxRetryable(err) {
+			return o, fmt.Errorf("could not replay %s %s: %w", e.Method, e.URL, err)
+		}
+		if rErr := c.cfg.Outbox.Remove(e.ID); rErr != nil {
+			return o, fmt.Errorf("could not remove %s %s from outbox: %w", e.Method, e.URL, rErr)
+		}
+		if err != nil {
+			return o, fmt.Errorf("could not replay %s %s, the call is removed from outbox: %w", e.Method, e.URL, err)
+		}
+		o = append(o, e)
+	}
+	return o, nil
+}
@@ -0,0 +1,138 @@
+package sdk
+
+import (
+	"context"
+	"errors"
+	"io"
+	"net/http"
+	"net/url"
+	"path/filepath"
+	"reflect"
+	"strings"
+	"testing"
+)
+
+func TestClient_Outbox(t *testing.T) {
+	var (
+		outage    = true
+		gotKeys   []string
+		gotBody   string
+		outbox    = NewMemoryOutbox()
+		errOutage = errors.New("connection refused")
+	)
+	c, err := NewClient(
+		Config{
+			Key: "foo",
+			HTTPClient: doerFunc(
+				func(req *http.Request) (*http.Response, error) {
+					if req.Method == http.MethodGet {
+						return nil, &url.Error{Op: req.Method, URL: req.URL.String(), Err: errOutage}
+					}
+					gotKeys = append(gotKeys, req.Header.Get(idempotencyKeyHeader))
+					if outage {
+						return &http.Response{
+							StatusCode: http.StatusServiceUnavailable,
+							Body:       io.NopCloser(strings.NewReader(`{"code":"","message":"unavailable"}`)),
+							Request:    req,
+						}, nil
+					}
+					b, _ := io.ReadAll(req.Body)
+					gotBody = string(b)
+					return &http.Response{
+						StatusCode: http.StatusOK,
+						Body:       io.NopCloser(strings.NewReader(`{}`)),
+						Request:    req,
+					}, nil
+				},
+			),
+			Outbox: outbox,
+		},
+	)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if _, err := c.GetProjectBranch("foo", "bar"); !errors.Is(err, errOutage) {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if err := c.requestHandler(
+		c.baseURL+"/projects/foo/branches/bar", http.MethodPatch, map[string]string{"foo": "bar"}, nil,
+	); err == nil {
+		t.Fatal("error is expected")
+	}
+
+	entries, _ := outbox.Entries()
+	if len(entries) != 1 || entries[0].Method != http.MethodPatch || string(entries[0].Body) != `{"foo":"bar"}` ||
+		entries[0].ID == "" || entries[0].ID != gotKeys[0] {
+		t.Fatalf("unexpected outbox entries: %+v, idempotency keys sent: %v", entries, gotKeys)
+	}
+
+	if _, err := c.ReplayOutbox(context.TODO()); err == nil {
+		t.Fatal("replay is expected to fail during the outage")
+	}
+	if entries, _ := outbox.Entries(); len(entries) != 1 {
+		t.Fatalf("the call failed during the replay is expected to be kept, got %+v", entries)
+	}
+
+	outage = false
+	got, err := c.ReplayOutbox(context.TODO())
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(got) != 1 || gotBody != `{"foo":"bar"}` || gotKeys[len(gotKeys)-1] != entries[0].ID {
+		t.Errorf("unexpected replay: %+v, body: %s, idempotency keys sent: %v", got, gotBody, gotKeys)
+	}
+	if entries, _ := outbox.Entries(); len(entries) != 0 {
+		t.Errorf("replayed call is expected to be removed, got %+v", entries)
+	}
+}
+
+func Test_isOutboxRetryable(t *testing.T) {
+	tests := []struct {
+		err  error
+		want bool
+	}{
+		{err: Error{HTTPCode: http.StatusInternalServerError}, want: true},
+		{err: Error{HTTPCode: http.StatusTooManyRequests}, want: true},
+		{err: Error{HTTPCode: http.StatusLocked}, want: true},
+		{err: &url.Error{Op: "POST", URL: "foo", Err: errors.New("timeout")}, want: true},
+		{err: ErrCircuitOpen, want: true},
+		{err: Error{HTTPCode: http.StatusBadRequest}},
+		{err: ErrDestructiveCallRejected},
+		{err: ErrReadOnlyClient},
+	}
+	for _, tt := range tests {
+		if got := isOutboxRetryable(tt.err); got != tt.want {
+			t.Errorf("isOutboxRetryable(%v) = %v, want %v", tt.err, got, tt.want)
+		}
+	}
+}
+
+func TestFileOutbox(t *testing.T) {
+	p := filepath.Join(t.TempDir(), "outbox.json")
+	o := NewFileOutbox(p)
+
+	if v, err := o.Entries(); err != nil || len(v) != 0 {
+		t.Fatalf("empty outbox is expected, got %v, error: %v", v, err)
+	}
+	for _, id := range []string{"foo", "bar", "baz"} {
+		if err := o.Add(OutboxEntry{ID: id, Method: http.MethodPost}); err != nil {
+			t.Fatal(err)
+		}
+	}
+	if err := o.Remove("bar"); err != nil {
+		t.Fatal(err)
+	}
+
+	v, err := NewFileOutbox(p).Entries()
+	if err != nil {
+		t.Fatal(err)
+	}
+	var ids []string
+	for _, e := range v {
+		ids = append(ids, e.ID)
+	}
+	if !reflect.DeepEqual(ids, []string{"foo", "baz"}) {
+		t.Errorf("unexpected entries: %v", ids)
+	}
+}
@@ -0,0 +1,145 @@
+package sdk
+
+import (
+	"context"
+	"errors"
+)
+
+// Page the page of items returned by the list endpoint with cursor pagination.
+type Page[T any] struct {
+	Items []T
+	// NextCursor the cursor to request the next page.
+	NextCursor string
+	// HasMore defines if the next page may contain more items.
+	// Note that the API does not report the total number of items, the last page can be empty.
+	HasMore bool
+}
+
+// Next returns the cursor to request the next page, or nil if there are no more pages.
+func (p Page[T]) Next() *string {
+	if !p.HasMore {
+		return nil
+	}
+	v := p.NextCursor
+	return &v
+}
+
+func newPage[T any](items []T, limit int, cursor *string, p PaginationResponse) Page[T] {
+	o := Page[T]{Items: items, HasMore: hasNextPage(len(items), limit, cursor, p)}
+	if p.Pagination != nil {
+		o.NextCursor = p.Pagination.Cursor
+	}
+	return o
+}
+
+// hasNextPage defines if another page shall be requested given the last page's size and cursor.
+func hasNextPage(pageSize, limit int, cursor *string, p PaginationResponse) bool {
+	switch {
+	case pageSize < limit, p.Pagination == nil, p.Pagination.Cursor == "":
+		return false
+	case cursor != nil && *cursor == p.Pagination.Cursor:
+		return false
+	default:
+		return true
+	}
+}
+
+// listProjectsPageLimit the maximum number of projects the API returns per page.
+const listProjectsPageLimit = 400
+
+// ListProjectsPage returns the page of projects starting at the cursor, the first page if the cursor is nil.
+func (c Client) ListProjectsPage(
+	cursor *string, limit int, search *string, orgID *string,
+) (Page[ProjectListItem], error) {
+	resp, err := c.ListProjects(cursor, &limit, search, orgID)
+	if err != nil {
+		return Page[ProjectListItem]{}, err
+	}
+	return newPage(resp.Projects, limit, cursor, resp.PaginationResponse), nil
+}
+
+// ListSharedProjectsPage returns the page of shared projects starting at the cursor,
+// the first page if the cursor is nil.
+func (c Client) ListSharedProjectsPage(cursor *string, limit int, search *string) (Page[ProjectListItem], error) {
+	resp, err := c.ListSharedProjects(cursor, &limit, search)
+	if err != nil {
+		return Page[ProjectListItem]{}, err
+	}
+	return newPage(resp.Projects, limit, cursor, resp.PaginationResponse), nil
+}
+
+// listOperationsPageLimit the maximum number of operations the API returns per page.
+const listOperationsPageLimit = 1000
+
+// ListProjectOperationsPage returns the page of project's operations starting at the cursor,
+// the first page if the cursor is nil.
+func (c Client) ListProjectOperationsPage(projectID string, cursor *string, limit int) (Page[Operation], error) {
+	resp, err := c.ListProjectOperations(projectID, cursor, &limit)
+	if err != nil {
+		return Page[Operation]{}, err
+	}
+	return newPage(resp.Operations, limit, cursor, resp.PaginationResponse), nil
+}
+
+// errOrgIDNotSet the error returned by the organization's helpers called with the empty organization ID.
+var errOrgIDNotSet = errors.New("organization ID must be set")
+
+// ListOrganizationProjects returns all projects of the organization paging through ListProjects.
+// It is the entry point to list the organization's projects: the API does not define the listing
+// scoped by the organization's path, hence the projects are listed by ListProjects with the organization's ID.
+func (c Client) ListOrganizationProjects(ctx context.Context, orgID string) ([]ProjectListItem, error) {
+	if orgID == "" {
+		return nil, errOrgIDNotSet
+	}
+	return c.listAllProjects(ctx, nil, &orgID)
+}
+
+// listAllProjects pages through ListProjects until the cursor is exhausted.
+func (c Client) listAllProjects(ctx context.Context, search *string, orgID *string) ([]ProjectListItem, error) {
+	var (
+		o      []ProjectListItem
+		cursor *string
+	)
+
+	for {
+		if err := ctx.Err(); err != nil {
+			return nil, err
+		}
+
+		page, err := c.ListProjectsPage(cursor, listProjectsPageLimit, search, orgID)
+		if err != nil {
+			return nil, err
+		}
+		o = append(o, page.Items...)
+
+		if cursor = page.Next(); cursor == nil {
+			return o, nil
+		}
+	}
+}
+
+// walkProjectOperations pages through ListProjectOperations and calls fn for every operation.
+func (c Client) walkProjectOperations(ctx context.Context, projectID string, fn func(Operation) error) error {
+	var cursor *string
+
+	for {
+		if err := ctx.Err(); err != nil {
+			return err
+		}
+
+		page, err := c.ListProjectOperationsPage(projectID, cursor, listOperationsPageLimit)
+		if err != nil {
+			return err
+		}
+
+		for _, op := range page.Items {
+			if err := fn(op); err != nil {
+				return err
+			}
+		}
+
+		if cursor = page.Next(); cursor == nil {
+			return nil
+		}
+	}
+}
@@ -0,0 +1,175 @@
+package sdk
+
+import (
+	"context"
+	"net/http"
+	"testing"
+)
+
+func Test_hasNextPage(t *testing.T) {
+	cursor := "foo"
+	tests := []struct {
+		name     string
+		pageSize int
+		limit    int
+		cursor   *string
+		p        PaginationResponse
+		want     bool
+	}{
+		{
+			name:     "full page with the new cursor",
+			pageSize: 10,
+			limit:    10,
+			p:        PaginationResponse{Pagination: &Pagination{Cursor: "bar"}},
+			want:     true,
+		},
+		{
+			name:     "incomplete page",
+			pageSize: 9,
+			limit:    10,
+			p:        PaginationResponse{Pagination: &Pagination{Cursor: "bar"}},
+			want:     false,
+		},
+		{
+			name:     "no pagination",
+			pageSize: 10,
+			limit:    10,
+			want:     false,
+		},
+		{
+			name:     "cursor did not move",
+			pageSize: 10,
+			limit:    10,
+			cursor:   &cursor,
+			p:        PaginationResponse{Pagination: &Pagination{Cursor: cursor}},
+			want:     false,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(
+			tt.name, func(t *testing.T) {
+				if got := hasNextPage(tt.pageSize, tt.limit, tt.cursor, tt.p); got != tt.want {
+					t.Errorf("hasNextPage() = %v, want %v", got, tt.want)
+				}
+			},
+		)
+	}
+}
+
+func TestClient_ListProjectsPage(t *testing.T) {
+	tests := []struct {
+		name        string
+		body        string
+		limit       int
+		wantItems   int
+		wantHasMore bool
+	}{
+		{
+			name:        "full page",
+			body:        `{"projects":[{"id":"foo"},{"id":"bar"}],"pagination":{"cursor":"bar"}}`,
+			limit:       2,
+			wantItems:   2,
+			wantHasMore: true,
+		},
+		{
+			name:      "last page",
+			body:      `{"projects":[{"id":"foo"}],"pagination":{"cursor":"foo"}}`,
+			limit:     2,
+			wantItems: 1,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(
+			tt.name, func(t *testing.T) {
+				c, err := NewClient(
+					Config{Key: "foo", HTTPClient: newFakeAPI().on(http.MethodGet, "/projects", http.StatusOK, tt.body)},
+				)
+				if err != nil {
+					t.Fatal(err)
+				}
+
+				got, err := c.ListProjectsPage(nil, tt.limit, nil, nil)
+				if err != nil {
+					t.Fatal(err)
+				}
+				if len(got.Items) != tt.wantItems || got.HasMore != tt.wantHasMore {
+					t.Errorf("ListProjectsPage() = %+v", got)
+				}
+				if next := got.Next(); (next != nil) != tt.wantHasMore || (next != nil && *next != got.NextCursor) {
+					t.Errorf("Next() = %v", next)
+				}
+			},
+		)
+	}
+}
+
+func TestClient_listAllProjects(t *testing.T) {
+	api := newFakeAPI().onFunc(
+		http.MethodGet, "/projects", func(req *http.Request) (int, string) {
+			if req.URL.Query().Get("cursor") == "" {
+				return http.StatusOK, `{"projects":[` + repeatJSON(`{"id":"p"}`, listProjectsPageLimit) +
+					`],"pagination":{"cursor":"next"}}`
+			}
+			return http.StatusOK, `{"projects":[{"id":"last"}],"pagination":{"cursor":"last"}}`
+		},
+	)
+
+	c, err := NewClient(Config{Key: "foo", HTTPClient: api})
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	got, err := c.listAllProjects(context.TODO(), nil, nil)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(got) != listProjectsPageLimit+1 {
+		t.Errorf("unexpected number of projects: want = %d, got = %d", listProjectsPageLimit+1, len(got))
+	}
+	if n := api.called(http.MethodGet, "/projects"); n != 2 {
+		t.Errorf("unexpected number of calls: want = 2, got = %d", n)
+	}
+}
+
+func repeatJSON(el string, n int) string {
+	var o string
+	for i := 0; i < n; i++ {
+		if i > 0 {
+			o += ","
+		}
+		o += el
+	}
+	return o
+}
+
+func TestClient_ListOrganizationProjects(t *testing.T) {
+	api := newFakeAPI().onFunc(
+		http.MethodGet, "/projects", func(req *http.Request) (int, string) {
+			if req.URL.Query().Get("org_id") != "org-foo" {
+				return http.StatusBadRequest, `{"code":"","message":"unexpected organization"}`
+			}
+			if req.URL.Query().Get("cursor") == "" {
+				return http.StatusOK, `{"projects":[` + repeatJSON(`{"id":"p"}`, listProjectsPageLimit) +
+					`],"pagination":{"cursor":"next"}}`
+			}
+			return http.StatusOK, `{"projects":[{"id":"last"}],"pagination":{"cursor":"last"}}`
+		},
+	)
+
+	c, err := NewClient(Config{Key: "foo", HTTPClient: api})
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	got, err := c.ListOrganizationProjects(context.TODO(), "org-foo")
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(got) != listProjectsPageLimit+1 {
+		t.Errorf("unexpected number of projects: want = %d, got = %d", listProjectsPageLimit+1, len(got))
+	}
+
+	if _, err := c.ListOrganizationProjects(context.TODO(), ""); err == nil {
+		t.Error("error is expected for the empty organization ID")
+	}
+}
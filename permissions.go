@@ -0,0 +1,85 @@
+package sdk
+
+import (
+	"context"
+	"fmt"
+	"sort"
+	"strings"
+)
+
+// ProjectPermissionsChange the changes required to align the project's permissions with the desired list of emails.
+type ProjectPermissionsChange struct {
+	// Granted emails which were granted the access to the project.
+	Granted []string
+	// Revoked permissions which were revoked from the project.
+	Revoked []ProjectPermission
+}
+
+// EnsureProjectPermissions reconciles the project's permissions with the list of emails:
+// missing permissions are granted, extra permissions are revoked. Emails are compared case-insensitively.
+// No changes are applied in the dryRun mode, the returned value contains the planned changes.
+func (c Client) EnsureProjectPermissions(
+	ctx context.Context, projectID string, emails []string, dryRun bool,
+) (ProjectPermissionsChange, error) {
+	current, err := c.ListProjectPermissions(projectID)
+	if err != nil {
+		return ProjectPermissionsChange{}, fmt.Errorf("could not list permissions of project %s: %w", projectID, err)
+	}
+
+	o := planProjectPermissionsChange(current.ProjectPermissions, emails)
+	if dryRun {
+		return o, nil
+	}
+
+	for _, email := range o.Granted {
+		if err := ctx.Err(); err != nil {
+			return o, err
+		}
+		if _, err := c.GrantPermissionToProject(projectID, GrantPermissionToProjectRequest{Email: email}); err != nil {
+			return o, fmt.Errorf("could not grant permission to %s: %w", email, err)
+		}
+	}
+
+	for _, p := range o.Revoked {
+		if err := ctx.Err(); err != nil {
+			return o, err
+		}
+		if _, err := c.RevokePermissionFromProject(projectID, p.ID); err != nil {
+			return o, fmt.Errorf("could not revoke permission from %s: %w", p.GrantedToEmail, err)
+		}
+	}
+
+	return o, nil
+}
+
+func planProjectPermissionsChange(current []ProjectPermission, emails []string) ProjectPermissionsChange {
+	desired := map[string]struct{}{}
+	for _, email := range emails {
+		if email = strings.ToLower(strings.TrimSpace(email)); email != "" {
+			desired[email] = struct{}{}
+		}
+	}
+
+	var o ProjectPermissionsChange
+	granted := map[string]struct{}{}
+	for _, p := range current {
+		if p.RevokedAt != nil {
+			continue
+		}
+		email := strings.ToLower(p.GrantedToEmail)
+		if _, ok := desired[email]; ok {
+			granted[email] = struct{}{}
+			continue
+		}
+		o.Revoked = append(o.Revoked, p)
+	}
+
+	for email := range desired {
+		if _, ok := granted[email]; !ok {
+			o.Granted = append(o.Granted, email)
+		}
+	}
+	sort.Strings(o.Granted)
+
+	return o
+}
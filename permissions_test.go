@@ -0,0 +1,89 @@
+package sdk
+
+import (
+	"context"
+	"net/http"
+	"reflect"
+	"testing"
+)
+
+func TestClient_EnsureProjectPermissions(t *testing.T) {
+	newAPI := func() *fakeAPI {
+		return newFakeAPI().
+			on(
+				http.MethodGet, "/projects/foo/permissions", http.StatusOK,
+				`{"project_permissions":[
+{"id":"p1","granted_to_email":"Keep@foo.bar","granted_at":"2024-01-01T00:00:00Z"},
+{"id":"p2","granted_to_email":"extra@foo.bar","granted_at":"2024-01-01T00:00:00Z"},
+{"id":"p3","granted_to_email":"gone@foo.bar","granted_at":"2024-01-01T00:00:00Z","revoked_at":"2024-01-02T00:00:00Z"}
+]}`,
+			).
+			on(
+				http.MethodPost, "/projects/foo/permissions", http.StatusOK,
+				`{"id":"p4","granted_to_email":"new@foo.bar","granted_at":"2024-01-03T00:00:00Z"}`,
+			).
+			on(
+				http.MethodDelete, "/projects/foo/permissions/p2", http.StatusOK,
+				`{"id":"p2","granted_to_email":"extra@foo.bar","granted_at":"2024-01-01T00:00:00Z"}`,
+			)
+	}
+
+	tests := []struct {
+		name        string
+		dryRun      bool
+		wantGranted []string
+		wantRevoked []string
+		wantCalls   int
+	}{
+		{
+			name:        "apply changes",
+			wantGranted: []string{"new@foo.bar"},
+			wantRevoked: []string{"p2"},
+			wantCalls:   1,
+		},
+		{
+			name:        "dry run",
+			dryRun:      true,
+			wantGranted: []string{"new@foo.bar"},
+			wantRevoked: []string{"p2"},
+			wantCalls:   0,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(
+			tt.name, func(t *testing.T) {
+				api := newAPI()
+				c, err := NewClient(Config{Key: "foo", HTTPClient: api})
+				if err != nil {
+					t.Fatal(err)
+				}
+
+				got, err := c.EnsureProjectPermissions(
+					context.TODO(), "foo", []string{"keep@foo.bar", " New@foo.bar", ""}, tt.dryRun,
+				)
+				if err != nil {
+					t.Fatal(err)
+				}
+
+				if !reflect.DeepEqual(got.Granted, tt.wantGranted) {
+					t.Errorf("unexpected granted: want = %v, got = %v", tt.wantGranted, got.Granted)
+				}
+
+				var revoked []string
+				for _, p := range got.Revoked {
+					revoked = append(revoked, p.ID)
+				}
+				if !reflect.DeepEqual(revoked, tt.wantRevoked) {
+					t.Errorf("unexpected revoked: want = %v, got = %v", tt.wantRevoked, revoked)
+				}
+
+				if n := api.called(http.MethodPost, "/projects/foo/permissions"); n != tt.wantCalls {
+					t.Errorf("unexpected number of grant calls: want = %d, got = %d", tt.wantCalls, n)
+				}
+				if n := api.called(http.MethodDelete, "/projects/foo/permissions/p2"); n != tt.wantCalls {
+					t.Errorf("unexpected number of revoke calls: want = %d, got = %d", tt.wantCalls, n)
+				}
+			},
+		)
+	}
+}
@@ -0,0 +1,22 @@
+package sdk
+
+import (
+	"fmt"
+	"net/http"
+)
+
+// PreSignFunc modifies the fully-built request before it is sent, e.g. to add the HMAC signature,
+// or the token required by the internal API gateway. The request body, if any, can be read using req.GetBody.
+// The request is not sent if the function returns the error.
+type PreSignFunc func(req *http.Request) error
+
+// preSign calls the PreSign hook if it is set.
+func (c Client) preSign(req *http.Request) error {
+	if c.cfg.PreSign == nil {
+		return nil
+	}
+	if err := c.cfg.PreSign(req); err != nil {
+		return fmt.Errorf("could not pre-sign request %s %s: %w", req.Method, req.URL, err)
+	}
+	return nil
+}
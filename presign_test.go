@@ -0,0 +1,75 @@
+package sdk
+
+import (
+	"crypto/hmac"
+	"crypto/sha256"
+	"encoding/hex"
+	"errors"
+	"io"
+	"net/http"
+	"strings"
+	"testing"
+)
+
+type presignTestHTTPClient struct {
+	got *http.Request
+}
+
+func (c *presignTestHTTPClient) Do(req *http.Request) (*http.Response, error) {
+	c.got = req
+	return &http.Response{
+		StatusCode: http.StatusOK,
+		Body:       io.NopCloser(strings.NewReader(`{}`)),
+		Request:    req,
+	}, nil
+}
+
+func TestClient_requestHandler_PreSign(t *testing.T) {
+	sign := func(body []byte) string {
+		mac := hmac.New(sha256.New, []byte("secret"))
+		mac.Write(body)
+		return hex.EncodeToString(mac.Sum(nil))
+	}
+
+	api := &presignTestHTTPClient{}
+	c, err := NewClient(
+		Config{
+			Key:        "foo",
+			HTTPClient: api,
+			PreSign: func(req *http.Request) error {
+				body, err := req.GetBody()
+				if err != nil {
+					return err
+				}
+				b, err := io.ReadAll(body)
+				if err != nil {
+					return err
+				}
+				req.Header.Set("X-Signature", sign(b))
+				return nil
+			},
+		},
+	)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if err := c.requestHandler(c.baseURL+"/foo", http.MethodPost, map[string]string{"foo": "bar"}, nil); err != nil {
+		t.Fatal(err)
+	}
+	if got, want := api.got.Header.Get("X-Signature"), sign([]byte(`{"foo":"bar"}`)); got != want {
+		t.Errorf("unexpected signature: %s, want %s", got, want)
+	}
+
+	errFoo := errors.New("foo")
+	api.got = nil
+	c, _ = NewClient(
+		Config{Key: "foo", HTTPClient: api, PreSign: func(*http.Request) error { return errFoo }},
+	)
+	if err := c.requestHandler(c.baseURL+"/foo", http.MethodGet, nil, nil); !errors.Is(err, errFoo) {
+		t.Errorf("unexpected error: %v", err)
+	}
+	if api.got != nil {
+		t.Errorf("request shall not be sent if pre-signing failed")
+	}
+}
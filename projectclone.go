@@ -0,0 +1,183 @@
+package sdk
+
+import (
+	"context"
+	"fmt"
+	"sort"
+	"strings"
+)
+
+// SchemaRestoreFunc applies the schema SQL dumped from the source database to the database of the same name
+// in the branch of the project clone.
+type SchemaRestoreFunc func(ctx context.Context, projectID, branchID string, db Database, sql string) error
+
+// CloneProjectOptions defines how the project is cloned.
+type CloneProjectOptions struct {
+	// Name the clone's name, the source project's name is used if it is empty.
+	Name string
+	// OrgID the clone's organization, the source project's organization is used if it is nil.
+	OrgID *string
+	// RegionID the clone's region, the source project's region is used if it is nil.
+	RegionID *string
+	// RestoreSchema applies the schemas of the source databases to the clone's databases,
+	// e.g. Client.RestoreSchemaWithQuery. The schemas are not restored if it is nil.
+	RestoreSchema SchemaRestoreFunc
+}
+
+// ClonedProject the project created by CloneProject.
+type ClonedProject struct {
+	ProjectFromTemplate
+	// RestoredSchemas the names of the databases the schemas were restored to.
+	RestoredSchemas []string
+}
+
+// CloneProject creates the new project with the settings of the source project, replays the roles
+// and the databases of the source's default branch, and optionally restores the databases' schemas.
+// The data and the roles' passwords are not copied. If a step fails, the resources created until the failure
+// are returned along with the error, hence the caller can clean them up.
+func (c Client) CloneProject(
+	ctx context.Context, sourceProjectID string, opts CloneProjectOptions,
+) (ClonedProject, error) {
+	var o ClonedProject
+	if err := ctx.Err(); err != nil {
+		return o, err
+	}
+
+	tmpl, source, branchID, err := c.projectTemplateOf(sourceProjectID)
+	if err != nil {
+		return o, err
+	}
+
+	name := opts.Name
+	if name == "" {
+		name = source.Name
+	}
+	orgID := source.OrgID
+	if opts.OrgID != nil {
+		orgID = opts.OrgID
+	}
+	if opts.RegionID != nil {
+		tmpl.Project.RegionID = opts.RegionID
+	}
+
+	o.ProjectFromTemplate, err = c.CreateFromTemplate(ctx, tmpl, name, orgID)
+	if err != nil || opts.RestoreSchema == nil {
+		return o, err
+	}
+
+	schemas, err := c.DumpBranchSchemas(ctx, sourceProjectID, branchID, 0)
+	if err != nil {
+		return o, err
+	}
+
+	dbs := append([]Database{}, o.Project.Databases...)
+	dbs = append(dbs, o.Databases...)
+	sort.Slice(dbs, func(i, j int) bool { return dbs[i].Name < dbs[j].Name })
+	for _, db := range dbs {
+		sql := schemas[db.Name]
+		if strings.TrimSpace(sql) == "" {
+			continue
+		}
+		if err := ctx.Err(); err != nil {
+			return o, err
+		}
+		if err := opts.RestoreSchema(ctx, o.Project.Project.ID, o.Project.Branch.ID, db, sql); err != nil {
+			return o, fmt.Errorf("could not restore schema of database %s: %w", db.Name, err)
+		}
+		o.RestoredSchemas = append(o.RestoredSchemas, db.Name)
+	}
+	return o, nil
+}
+
+// projectTemplateOf reads the project's settings, and the roles and the databases of its default branch,
+// and returns the template to recreate them along with the project and the default branch's ID.
+// The first database and its owner are created with the project, the system-protected roles are skipped.
+func (c Client) projectTemplateOf(projectID string) (ProjectTemplate, Project, string, error) {
+	resp, err := c.GetProject(projectID)
+	if err != nil {
+		return ProjectTemplate{}, Project{}, "", fmt.Errorf("could not read project %s: %w", projectID, err)
+	}
+	p := resp.Project
+
+	branches, err := c.ListProjectBranches(projectID, nil)
+	if err != nil {
+		return ProjectTemplate{}, p, "", fmt.Errorf("could not list branches of project %s: %w", projectID, err)
+	}
+	var branch *Branch
+	for i := range branches.Branches {
+		if branches.Branches[i].Default {
+			branch = &branches.Branches[i]
+			break
+		}
+	}
+	if branch == nil {
+		return ProjectTemplate{}, p, "", fmt.Errorf("%w: default branch of project %s", ErrNotFound, projectID)
+	}
+
+	roles, err := c.ListProjectBranchRoles(projectID, branch.ID)
+	if err != nil {
+		return ProjectTemplate{}, p, "", fmt.Errorf("could not list roles of branch %s: %w", branch.ID, err)
+	}
+	dbs, err := c.ListProjectBranchDatabases(projectID, branch.ID)
+	if err != nil {
+		return ProjectTemplate{}, p, "", fmt.Errorf("could not list databases of branch %s: %w", branch.ID, err)
+	}
+
+	branchName := branch.Name
+	provisioner, pgVersion := p.Provisioner, p.PgVersion
+	historyRetentionSeconds, storePasswords := p.HistoryRetentionSeconds, p.StorePasswords
+	regionID := p.RegionID
+	o := ProjectTemplate{
+		Project: ProjectCreateRequestProject{
+			Branch:                  &ProjectCreateRequestProjectBranch{Name: &branchName},
+			DefaultEndpointSettings: p.DefaultEndpointSettings,
+			HistoryRetentionSeconds: &historyRetentionSeconds,
+			PgVersion:               &pgVersion,
+			Provisioner:             &provisioner,
+			RegionID:                &regionID,
+			Settings:                p.Settings,
+			StorePasswords:          &storePasswords,
+		},
+	}
+	if s := p.DefaultEndpointSettings; s != nil {
+		o.Project.AutoscalingLimitMinCu = s.AutoscalingLimitMinCu
+		o.Project.AutoscalingLimitMaxCu = s.AutoscalingLimitMaxCu
+	}
+
+	var defaultOwner string
+	if len(dbs.Databases) > 0 {
+		first := dbs.Databases[0]
+		defaultOwner = first.OwnerName
+		o.Project.Branch.DatabaseName = &first.Name
+		o.Project.Branch.RoleName = &defaultOwner
+		for _, db := range dbs.Databases[1:] {
+			o.Databases = append(o.Databases, DatabaseCreateRequestDatabase{Name: db.Name, OwnerName: db.OwnerName})
+		}
+	}
+
+	for _, r := range roles.Roles {
+		if r.Name == defaultOwner || (r.Protected != nil && *r.Protected) {
+			continue
+		}
+		o.Roles = append(o.Roles, r.Name)
+	}
+
+	return o, p, branch.ID, nil
+}
+
+// RestoreSchemaWithQuery applies the schema SQL with QueryDatabase as the database owner, it can be used
+// as CloneProjectOptions.RestoreSchema. The lines of psql meta-commands, e.g. "\connect", are removed,
+// and the remaining statements are sent as a single query. Use the custom SchemaRestoreFunc,
+// e.g. running psql, if the compute rejects the query.
+func (c Client) RestoreSchemaWithQuery(ctx context.Context, projectID, branchID string, db Database, sql string) error {
+	lines := strings.Split(sql, "\n")
+	o := make([]string, 0, len(lines))
+	for _, l := range lines {
+		if strings.HasPrefix(strings.TrimSpace(l), `\`) {
+			continue
+		}
+		o = append(o, l)
+	}
+	_, err := c.QueryDatabase(ctx, projectID, branchID, db.Name, db.OwnerName, strings.Join(o, "\n"))
+	return err
+}
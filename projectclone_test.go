@@ -0,0 +1,139 @@
+package sdk
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"io"
+	"net/http"
+	"reflect"
+	"testing"
+)
+
+func newCloneFakeAPI(t *testing.T, got *ProjectCreateRequest) *fakeAPI {
+	return newFakeAPI().
+		on(
+			http.MethodGet, "/projects/src", http.StatusOK, `{"project":{
+"id":"src","name":"source","org_id":"org-foo","region_id":"aws-us-east-2","pg_version":16,
+"provisioner":"k8s-neonvm","history_retention_seconds":3600,"store_passwords":true,
+"default_endpoint_settings":{"autoscaling_limit_min_cu":0.5,"autoscaling_limit_max_cu":2}
+}}`,
+		).
+		on(
+			http.MethodGet, "/projects/src/branches", http.StatusOK,
+			`{"branches":[{"id":"br-dev","name":"dev"},{"id":"br-main","name":"production","default":true}]}`,
+		).
+		on(
+			http.MethodGet, "/projects/src/branches/br-main/roles", http.StatusOK,
+			`{"roles":[{"name":"owner"},{"name":"app"},{"name":"neon_superuser","protected":true}]}`,
+		).
+		on(
+			http.MethodGet, "/projects/src/branches/br-main/databases", http.StatusOK,
+			`{"databases":[{"name":"maindb","owner_name":"owner"},{"name":"appdb","owner_name":"app"}]}`,
+		).
+		onFunc(
+			http.MethodPost, "/projects", func(req *http.Request) (int, string) {
+				b, _ := io.ReadAll(req.Body)
+				if err := json.Unmarshal(b, got); err != nil {
+					t.Errorf("could not decode project creation request: %v", err)
+				}
+				return http.StatusCreated, `{"project":{"id":"dst"},"branch":{"id":"br-dst"},
+"databases":[{"name":"maindb","owner_name":"owner"}],"roles":[{"name":"owner"}],"operations":[]}`
+			},
+		).
+		on(
+			http.MethodPost, "/projects/dst/branches/br-dst/roles", http.StatusCreated,
+			`{"role":{"name":"app"},"operations":[]}`,
+		).
+		on(
+			http.MethodPost, "/projects/dst/branches/br-dst/databases", http.StatusCreated,
+			`{"database":{"name":"appdb","owner_name":"app"},"operations":[]}`,
+		).
+		onFunc(
+			http.MethodGet, "/projects/src/branches/br-main/schema", func(req *http.Request) (int, string) {
+				if req.URL.Query().Get("db_name") == "maindb" {
+					return http.StatusOK, `{"sql":""}`
+				}
+				return http.StatusOK, `{"sql":"CREATE TABLE foo (id int);"}`
+			},
+		)
+}
+
+func TestClient_CloneProject(t *testing.T) {
+	var req ProjectCreateRequest
+	c, err := NewClient(Config{Key: "foo", HTTPClient: newCloneFakeAPI(t, &req)})
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	restored := map[string]string{}
+	regionID := "aws-eu-central-1"
+	got, err := c.CloneProject(
+		context.TODO(), "src", CloneProjectOptions{
+			RegionID: &regionID,
+			RestoreSchema: func(_ context.Context, projectID, branchID string, db Database, sql string) error {
+				if projectID != "dst" || branchID != "br-dst" {
+					t.Errorf("unexpected schema restore target: %s/%s", projectID, branchID)
+				}
+				restored[db.Name+"/"+db.OwnerName] = sql
+				return nil
+			},
+		},
+	)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	p := req.Project
+	if p.Name == nil || *p.Name != "source" || p.OrgID == nil || *p.OrgID != "org-foo" ||
+		p.RegionID == nil || *p.RegionID != regionID || p.PgVersion == nil || *p.PgVersion != 16 ||
+		p.HistoryRetentionSeconds == nil || *p.HistoryRetentionSeconds != 3600 ||
+		p.AutoscalingLimitMaxCu == nil || *p.AutoscalingLimitMaxCu != 2 {
+		t.Errorf("unexpected project creation request: %+v", p)
+	}
+	if b := p.Branch; b == nil || *b.Name != "production" || *b.DatabaseName != "maindb" || *b.RoleName != "owner" {
+		t.Errorf("unexpected default branch of the clone: %+v", p.Branch)
+	}
+
+	if len(got.Roles) != 1 || got.Roles[0].Name != "app" {
+		t.Errorf("unexpected roles: %+v", got.Roles)
+	}
+	if len(got.Databases) != 1 || got.Databases[0].Name != "appdb" {
+		t.Errorf("unexpected databases: %+v", got.Databases)
+	}
+
+	wantRestored := map[string]string{"appdb/app": "CREATE TABLE foo (id int);"}
+	if !reflect.DeepEqual(restored, wantRestored) {
+		t.Errorf("unexpected restored schemas: %v, want %v", restored, wantRestored)
+	}
+	if !reflect.DeepEqual(got.RestoredSchemas, []string{"appdb"}) {
+		t.Errorf("unexpected restored schemas reported: %v", got.RestoredSchemas)
+	}
+}
+
+func TestClient_CloneProject_restoreFailed(t *testing.T) {
+	var req ProjectCreateRequest
+	c, err := NewClient(Config{Key: "foo", HTTPClient: newCloneFakeAPI(t, &req)})
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	errRestore := errors.New("syntax error")
+	got, err := c.CloneProject(
+		context.TODO(), "src", CloneProjectOptions{
+			Name: "clone",
+			RestoreSchema: func(context.Context, string, string, Database, string) error {
+				return errRestore
+			},
+		},
+	)
+	if !errors.Is(err, errRestore) {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if got.Project.Project.ID != "dst" {
+		t.Errorf("created project is expected to be returned along with the error, got %+v", got.Project.Project)
+	}
+	if *req.Project.Name != "clone" {
+		t.Errorf("unexpected clone's name: %s", *req.Project.Name)
+	}
+}
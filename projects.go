@@ -0,0 +1,266 @@
+package sdk
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"net/http"
+	"net/url"
+	"regexp"
+	"sort"
+	"strconv"
+	"strings"
+	"time"
+)
+
+// ProjectsFilter defines the client-side filter of the projects. Empty attributes do not filter.
+type ProjectsFilter struct {
+	// Name the regular expression the project's name shall match.
+	Name *regexp.Regexp
+	// RegionIDs the projects' regions to keep, e.g. "aws-us-east-2".
+	RegionIDs []string
+	// CreatedFrom keeps the projects created at, or after the timestamp.
+	CreatedFrom time.Time
+	// CreatedTo keeps the projects created before the timestamp.
+	CreatedTo time.Time
+}
+
+func (f ProjectsFilter) match(p ProjectListItem) bool {
+	if f.Name != nil && !f.Name.MatchString(p.Name) {
+		return false
+	}
+	if len(f.RegionIDs) > 0 && !containsString(f.RegionIDs, p.RegionID) {
+		return false
+	}
+	if !f.CreatedFrom.IsZero() && p.CreatedAt.Before(f.CreatedFrom) {
+		return false
+	}
+	if !f.CreatedTo.IsZero() && !p.CreatedAt.Before(f.CreatedTo) {
+		return false
+	}
+	return true
+}
+
+func containsString(v []string, el string) bool {
+	for _, s := range v {
+		if s == el {
+			return true
+		}
+	}
+	return false
+}
+
+// ProjectsSortKey defines the attribute to sort the projects by.
+type ProjectsSortKey string
+
+const (
+	ProjectsSortByName      ProjectsSortKey = "name"
+	ProjectsSortByRegion    ProjectsSortKey = "region_id"
+	ProjectsSortByCreatedAt ProjectsSortKey = "created_at"
+)
+
+// ListAllProjectsOptions defines the options of ListAllProjects.
+type ListAllProjectsOptions struct {
+	// Search the search parameter of the API, it filters by the project's name or ID.
+	Search *string
+	// OrgID the organization to list the projects of.
+	OrgID *string
+	// Filter the client-side filter applied to all projects.
+	Filter ProjectsFilter
+	// SortBy the attribute to sort the projects by, the order of the API is kept if it is not set.
+	SortBy ProjectsSortKey
+	// Descending defines the descending sorting order.
+	Descending bool
+}
+
+// ListAllProjects pages through all projects, filters and sorts them on the client side.
+func (c Client) ListAllProjects(ctx context.Context, opts ListAllProjectsOptions) ([]ProjectListItem, error) {
+	projects, err := c.listAllProjects(ctx, opts.Search, opts.OrgID)
+	if err != nil {
+		return nil, err
+	}
+
+	var o []ProjectListItem
+	for _, p := range projects {
+		if opts.Filter.match(p) {
+			o = append(o, p)
+		}
+	}
+
+	if less := projectsLess(opts.SortBy); less != nil {
+		sort.SliceStable(
+			o, func(i, j int) bool {
+				if opts.Descending {
+					return less(o[j], o[i])
+				}
+				return less(o[i], o[j])
+			},
+		)
+	}
+	return o, nil
+}
+
+func projectsLess(k ProjectsSortKey) func(a, b ProjectListItem) bool {
+	switch k {
+	case ProjectsSortByName:
+		return func(a, b ProjectListItem) bool {
+			return strings.ToLower(a.Name) < strings.ToLower(b.Name)
+		}
+	case ProjectsSortByRegion:
+		return func(a, b ProjectListItem) bool {
+			return a.RegionID < b.RegionID
+		}
+	case ProjectsSortByCreatedAt:
+		return func(a, b ProjectListItem) bool {
+			return a.CreatedAt.Before(b.CreatedAt)
+		}
+	default:
+		return nil
+	}
+}
+
+// ListProjectsDetailedOptions defines the options of ListProjectsDetailed.
+type ListProjectsDetailedOptions struct {
+	ListAllProjectsOptions
+	// Parallelism the maximum number of concurrent requests, the default is used if it is not positive.
+	Parallelism int
+	// RequestsPerSecond limits the rate of requests to read the projects' details, no limit if it is not positive.
+	RequestsPerSecond float64
+}
+
+// ListProjectsDetailed lists all projects and reads every project's details, e.g. settings and quotas,
+// concurrently. The projects are returned in the order of ListAllProjects.
+func (c Client) ListProjectsDetailed(ctx context.Context, opts ListProjectsDetailedOptions) ([]Project, error) {
+	projects, err := c.ListAllProjects(ctx, opts.ListAllProjectsOptions)
+	if err != nil {
+		return nil, err
+	}
+
+	limiter := newRateLimiter(opts.RequestsPerSecond)
+	defer limiter.stop()
+
+	o := make([]Project, len(projects))
+	if err := forEach(
+		ctx, len(projects), opts.Parallelism, func(ctx context.Context, i int) error {
+			if err := limiter.wait(ctx); err != nil {
+				return err
+			}
+			resp, err := c.GetProject(projects[i].ID)
+			if err != nil {
+				return fmt.Errorf("could not read project %s: %w", projects[i].ID, err)
+			}
+			o[i] = resp.Project
+			return nil
+		},
+	); err != nil {
+		return nil, err
+	}
+	return o, nil
+}
+
+// ProjectsListOptions defines the query parameters of the projects' listing.
+// Nil attributes are not sent.
+type ProjectsListOptions struct {
+	Cursor *string
+	Limit  *int
+	Search *string
+	// OrgID the organization's projects to list, it is not supported by ListSharedProjectsWithOptions.
+	OrgID *string
+	// Timeout the server-side timeout of the listing, the API returns the projects listed before the timeout.
+	// The timeout is sent in milliseconds, and is not sent if it is not positive.
+	// Note that the parameter is documented by the Neon API, but is not defined by the OpenAPI spec
+	// the SDK is generated from, hence ListProjects does not expose it.
+	Timeout time.Duration
+}
+
+func (o ProjectsListOptions) query(withOrgID bool) string {
+	q := url.Values{}
+	if o.Cursor != nil {
+		q.Set("cursor", *o.Cursor)
+	}
+	if o.Limit != nil {
+		q.Set("limit", strconv.Itoa(*o.Limit))
+	}
+	if o.Search != nil {
+		q.Set("search", *o.Search)
+	}
+	if withOrgID && o.OrgID != nil {
+		q.Set("org_id", *o.OrgID)
+	}
+	if o.Timeout > 0 {
+		q.Set("timeout", strconv.FormatInt(o.Timeout.Milliseconds(), 10))
+	}
+	if len(q) == 0 {
+		return ""
+	}
+	return "?" + q.Encode()
+}
+
+// ListProjectsWithOptions lists the projects as ListProjects, and exposes all query parameters including the timeout.
+func (c Client) ListProjectsWithOptions(opts ProjectsListOptions) (ListProjectsRespObj, error) {
+	var v ListProjectsRespObj
+	if err := c.requestHandler(c.baseURL+"/projects"+opts.query(true), http.MethodGet, nil, &v); err != nil {
+		return ListProjectsRespObj{}, err
+	}
+	return v, nil
+}
+
+// ListSharedProjectsWithOptions lists the shared projects as ListSharedProjects,
+// and exposes all query parameters including the timeout.
+func (c Client) ListSharedProjectsWithOptions(opts ProjectsListOptions) (ListSharedProjectsRespObj, error) {
+	var v ListSharedProjectsRespObj
+	if err := c.requestHandler(c.baseURL+"/projects/shared"+opts.query(false), http.MethodGet, nil, &v); err != nil {
+		return ListSharedProjectsRespObj{}, err
+	}
+	return v, nil
+}
+
+// ErrProjectOrgMismatch the error returned when the project does not belong to the expected organization.
+var ErrProjectOrgMismatch = errors.New("project does not belong to organization")
+
+// ProjectOrgMismatchError the error carrying the organization the project belongs to.
+// It matches ErrProjectOrgMismatch with errors.Is.
+type ProjectOrgMismatchError struct {
+	ProjectID string
+	// OrgID the expected organization.
+	OrgID string
+	// ActualOrgID the organization the project belongs to, empty if the project is the personal one.
+	ActualOrgID string
+}
+
+func (e *ProjectOrgMismatchError) Error() string {
+	actual := "no organization"
+	if e.ActualOrgID != "" {
+		actual = "organization " + e.ActualOrgID
+	}
+	return "project " + e.ProjectID + " belongs to " + actual + ", expected organization " + e.OrgID
+}
+
+func (e *ProjectOrgMismatchError) Is(target error) bool {
+	return target == ErrProjectOrgMismatch
+}
+
+// VerifyProjectInOrg returns *ProjectOrgMismatchError if the project does not belong to the organization,
+// e.g. to guard the automation against acting on the project of the wrong organization.
+func (c Client) VerifyProjectInOrg(ctx context.Context, projectID, orgID string) error {
+	if err := ctx.Err(); err != nil {
+		return err
+	}
+	if orgID == "" {
+		return errOrgIDNotSet
+	}
+
+	resp, err := c.GetProject(projectID)
+	if err != nil {
+		return fmt.Errorf("could not read project %s: %w", projectID, err)
+	}
+
+	var actual string
+	if resp.Project.OrgID != nil {
+		actual = *resp.Project.OrgID
+	}
+	if actual != orgID {
+		return &ProjectOrgMismatchError{ProjectID: projectID, OrgID: orgID, ActualOrgID: actual}
+	}
+	return nil
+}
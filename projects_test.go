@@ -0,0 +1,217 @@
+package sdk
+
+import (
+	"context"
+	"errors"
+	"net/http"
+	"regexp"
+	"strings"
+	"testing"
+	"time"
+)
+
+func TestClient_ListAllProjects(t *testing.T) {
+	const body = `{"projects":[
+{"id":"p1","name":"prod-api","region_id":"aws-us-east-2","created_at":"2024-03-01T00:00:00Z"},
+{"id":"p2","name":"dev-api","region_id":"aws-eu-central-1","created_at":"2024-01-01T00:00:00Z"},
+{"id":"p3","name":"prod-web","region_id":"aws-eu-central-1","created_at":"2024-02-01T00:00:00Z"}
+]}`
+
+	tests := []struct {
+		name    string
+		opts    ListAllProjectsOptions
+		wantIDs []string
+	}{
+		{
+			name:    "no options",
+			wantIDs: []string{"p1", "p2", "p3"},
+		},
+		{
+			name:    "by name",
+			opts:    ListAllProjectsOptions{Filter: ProjectsFilter{Name: regexp.MustCompile("^prod-")}},
+			wantIDs: []string{"p1", "p3"},
+		},
+		{
+			name:    "by region sorted by name",
+			opts:    ListAllProjectsOptions{Filter: ProjectsFilter{RegionIDs: []string{"aws-eu-central-1"}}, SortBy: ProjectsSortByName},
+			wantIDs: []string{"p2", "p3"},
+		},
+		{
+			name: "by creation time",
+			opts: ListAllProjectsOptions{
+				Filter: ProjectsFilter{
+					CreatedFrom: time.Date(2024, 2, 1, 0, 0, 0, 0, time.UTC),
+					CreatedTo:   time.Date(2024, 3, 1, 0, 0, 0, 0, time.UTC),
+				},
+			},
+			wantIDs: []string{"p3"},
+		},
+		{
+			name:    "sorted by creation time descending",
+			opts:    ListAllProjectsOptions{SortBy: ProjectsSortByCreatedAt, Descending: true},
+			wantIDs: []string{"p1", "p3", "p2"},
+		},
+		{
+			name:    "sorted by region",
+			opts:    ListAllProjectsOptions{SortBy: ProjectsSortByRegion},
+			wantIDs: []string{"p2", "p3", "p1"},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(
+			tt.name, func(t *testing.T) {
+				c, err := NewClient(
+					Config{Key: "foo", HTTPClient: newFakeAPI().on(http.MethodGet, "/projects", http.StatusOK, body)},
+				)
+				if err != nil {
+					t.Fatal(err)
+				}
+
+				got, err := c.ListAllProjects(context.TODO(), tt.opts)
+				if err != nil {
+					t.Fatal(err)
+				}
+
+				var gotIDs []string
+				for _, p := range got {
+					gotIDs = append(gotIDs, p.ID)
+				}
+				if strings.Join(gotIDs, ",") != strings.Join(tt.wantIDs, ",") {
+					t.Errorf("ListAllProjects() got = %v, want %v", gotIDs, tt.wantIDs)
+				}
+			},
+		)
+	}
+}
+
+func TestClient_ListProjectsDetailed(t *testing.T) {
+	api := newFakeAPI().
+		on(http.MethodGet, "/projects", http.StatusOK, `{"projects":[{"id":"p1"},{"id":"p2"},{"id":"p3"}]}`).
+		on(http.MethodGet, "/projects/p1", http.StatusOK, `{"project":{"id":"p1","settings":{"quota":{"active_time_seconds":1}}}}`).
+		on(http.MethodGet, "/projects/p2", http.StatusOK, `{"project":{"id":"p2"}}`).
+		on(http.MethodGet, "/projects/p3", http.StatusOK, `{"project":{"id":"p3"}}`)
+	c, err := NewClient(Config{Key: "foo", HTTPClient: api})
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	got, err := c.ListProjectsDetailed(
+		context.TODO(), ListProjectsDetailedOptions{Parallelism: 2, RequestsPerSecond: 1000},
+	)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(got) != 3 || got[0].ID != "p1" || got[2].ID != "p3" {
+		t.Fatalf("unexpected projects: %v", got)
+	}
+	if got[0].Settings == nil || got[0].Settings.Quota == nil {
+		t.Errorf("project details are missing: %+v", got[0])
+	}
+
+	api.on(http.MethodGet, "/projects/p2", http.StatusInternalServerError, `{"message":"internal"}`)
+	if _, err := c.ListProjectsDetailed(context.TODO(), ListProjectsDetailedOptions{}); err == nil {
+		t.Error("error expected")
+	}
+}
+
+func TestClient_ListProjectsWithOptions(t *testing.T) {
+	var (
+		cursor = "foo"
+		limit  = 10
+		search = "my project"
+		orgID  = "org-foo"
+	)
+
+	tests := []struct {
+		name      string
+		opts      ProjectsListOptions
+		shared    bool
+		wantQuery string
+	}{
+		{
+			name:      "no options",
+			wantQuery: "",
+		},
+		{
+			name: "all options",
+			opts: ProjectsListOptions{
+				Cursor: &cursor, Limit: &limit, Search: &search, OrgID: &orgID, Timeout: 2 * time.Second,
+			},
+			wantQuery: "cursor=foo&limit=10&org_id=org-foo&search=my+project&timeout=2000",
+		},
+		{
+			name:      "shared projects: organization is not sent",
+			opts:      ProjectsListOptions{OrgID: &orgID, Timeout: 500 * time.Millisecond},
+			shared:    true,
+			wantQuery: "timeout=500",
+		},
+	}
+	for _, tt := range tests {
+		t.Run(
+			tt.name, func(t *testing.T) {
+				var gotQuery string
+				handler := func(req *http.Request) (int, string) {
+					gotQuery = req.URL.RawQuery
+					return http.StatusOK, `{"projects":[{"id":"p1"}]}`
+				}
+				api := newFakeAPI().onFunc(http.MethodGet, "/projects", handler).
+					onFunc(http.MethodGet, "/projects/shared", handler)
+				c, err := NewClient(Config{Key: "foo", HTTPClient: api})
+				if err != nil {
+					t.Fatal(err)
+				}
+
+				var projects []ProjectListItem
+				if tt.shared {
+					resp, err := c.ListSharedProjectsWithOptions(tt.opts)
+					if err != nil {
+						t.Fatal(err)
+					}
+					projects = resp.Projects
+				} else {
+					resp, err := c.ListProjectsWithOptions(tt.opts)
+					if err != nil {
+						t.Fatal(err)
+					}
+					projects = resp.Projects
+				}
+
+				if len(projects) != 1 {
+					t.Errorf("unexpected projects: %v", projects)
+				}
+				if gotQuery != tt.wantQuery {
+					t.Errorf("unexpected query: %s, want %s", gotQuery, tt.wantQuery)
+				}
+			},
+		)
+	}
+}
+
+func TestClient_VerifyProjectInOrg(t *testing.T) {
+	api := newFakeAPI().
+		on(http.MethodGet, "/projects/foo", http.StatusOK, `{"project":{"id":"foo","org_id":"org-foo"}}`).
+		on(http.MethodGet, "/projects/bar", http.StatusOK, `{"project":{"id":"bar"}}`)
+	c, err := NewClient(Config{Key: "foo", HTTPClient: api})
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if err := c.VerifyProjectInOrg(context.TODO(), "foo", "org-foo"); err != nil {
+		t.Errorf("unexpected error: %v", err)
+	}
+
+	tests := []struct {
+		projectID, orgID string
+		wantActualOrgID  string
+	}{
+		{projectID: "foo", orgID: "org-bar", wantActualOrgID: "org-foo"},
+		{projectID: "bar", orgID: "org-bar"},
+	}
+	for _, tt := range tests {
+		err := c.VerifyProjectInOrg(context.TODO(), tt.projectID, tt.orgID)
+		var e *ProjectOrgMismatchError
+		if !errors.Is(err, ErrProjectOrgMismatch) || !errors.As(err, &e) || e.ActualOrgID != tt.wantActualOrgID {
+			t.Errorf("unexpected error for project %s: %v", tt.projectID, err)
+		}
+	}
+}
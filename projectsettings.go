@@ -0,0 +1,118 @@
+package sdk
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"net/http"
+	"reflect"
+	"time"
+)
+
+// ErrProjectSettingsConflict the error returned when the project's settings could not be updated
+// because they kept changing concurrently.
+var ErrProjectSettingsConflict = errors.New("project settings changed concurrently")
+
+// projectSettingsMaxAttempts the maximum number of read-modify-write cycles of UpdateProjectSettings.
+const projectSettingsMaxAttempts = 5
+
+// UpdateProjectSettings performs read-modify-write of the project's settings: it reads the settings,
+// applies mutate to their copy, and updates the settings changed by mutate. The cycle is repeated
+// until the settings read match the result of mutate, hence the update overwritten by the concurrent writer,
+// e.g. another controller editing the allowed IPs, is re-applied on top of the latest settings instead of
+// being lost. The API does not support the conditional updates, hence mutate must be idempotent,
+// e.g. add the IP unless it is already allowed. Note that the setting cannot be cleared by setting it to nil.
+// The error wrapping ErrProjectSettingsConflict is returned if the settings do not converge after several attempts.
+// It returns the project's settings after the update.
+func (c Client) UpdateProjectSettings(
+	ctx context.Context, projectID string, mutate func(*ProjectSettingsData),
+) (ProjectSettingsData, error) {
+	for attempt := 0; ; attempt++ {
+		if err := ctx.Err(); err != nil {
+			return ProjectSettingsData{}, err
+		}
+
+		resp, err := c.GetProject(projectID)
+		if err != nil {
+			return ProjectSettingsData{}, fmt.Errorf("could not read project %s: %w", projectID, err)
+		}
+		var current ProjectSettingsData
+		if resp.Project.Settings != nil {
+			current = *resp.Project.Settings
+		}
+
+		desired, err := cloneProjectSettings(current)
+		if err != nil {
+			return ProjectSettingsData{}, err
+		}
+		mutate(&desired)
+
+		patch, changed := projectSettingsPatch(current, desired)
+		if !changed {
+			return current, nil
+		}
+		if attempt == projectSettingsMaxAttempts {
+			return ProjectSettingsData{}, fmt.Errorf("%w: project %s", ErrProjectSettingsConflict, projectID)
+		}
+
+		updated, err := callUnlessLocked(
+			ctx, func() (UpdateProjectRespObj, error) {
+				return c.UpdateProject(
+					projectID, ProjectUpdateRequest{Project: ProjectUpdateRequestProject{Settings: &patch}},
+				)
+			},
+		)
+		var e Error
+		switch {
+		case errors.As(err, &e) && e.HTTPCode == http.StatusConflict:
+			// the project is being changed concurrently, the settings are read again
+			select {
+			case <-ctx.Done():
+				return ProjectSettingsData{}, ctx.Err()
+			case <-time.After(operationsPollInterval):
+			}
+			continue
+		case err != nil:
+			return ProjectSettingsData{}, fmt.Errorf("could not update settings of project %s: %w", projectID, err)
+		}
+
+		if err := c.WaitForOperations(ctx, projectID, updated.Operations); err != nil {
+			return ProjectSettingsData{}, err
+		}
+	}
+}
+
+// cloneProjectSettings returns the deep copy of the settings, hence mutate cannot change the settings read.
+func cloneProjectSettings(v ProjectSettingsData) (ProjectSettingsData, error) {
+	b, err := json.Marshal(v)
+	if err != nil {
+		return ProjectSettingsData{}, err
+	}
+	var o ProjectSettingsData
+	if err := json.Unmarshal(b, &o); err != nil {
+		return ProjectSettingsData{}, err
+	}
+	return o, nil
+}
+
+// projectSettingsPatch returns the settings with the attributes of desired which differ from current,
+// so the settings not changed by mutate are not sent to the API.
+func projectSettingsPatch(current, desired ProjectSettingsData) (ProjectSettingsData, bool) {
+	var (
+		o       ProjectSettingsData
+		changed bool
+		c       = reflect.ValueOf(current)
+		d       = reflect.ValueOf(desired)
+		p       = reflect.ValueOf(&o).Elem()
+	)
+	for i := 0; i < d.NumField(); i++ {
+		f := d.Field(i)
+		if f.Kind() == reflect.Ptr && f.IsNil() || reflect.DeepEqual(c.Field(i).Interface(), f.Interface()) {
+			continue
+		}
+		p.Field(i).Set(f)
+		changed = true
+	}
+	return o, changed
+}
@@ -0,0 +1,186 @@
+package sdk
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"io"
+	"net/http"
+	"reflect"
+	"sort"
+	"sync"
+	"testing"
+)
+
+// projectSettingsAPI the fake API storing the project's settings.
+type projectSettingsAPI struct {
+	mu       sync.Mutex
+	settings ProjectSettingsData
+	// onUpdate is called with the received settings' patch, and the stored settings, it applies the patch if not set.
+	onUpdate func(patch ProjectSettingsData, settings *ProjectSettingsData)
+	// patches the received settings' patches.
+	patches []ProjectSettingsData
+}
+
+func (a *projectSettingsAPI) fake() *fakeAPI {
+	return newFakeAPI().
+		onFunc(
+			http.MethodGet, "/projects/foo", func(*http.Request) (int, string) {
+				a.mu.Lock()
+				defer a.mu.Unlock()
+				b, _ := json.Marshal(ProjectResponse{Project: Project{ID: "foo", Settings: &a.settings}})
+				return http.StatusOK, string(b)
+			},
+		).
+		onFunc(
+			http.MethodPatch, "/projects/foo", func(req *http.Request) (int, string) {
+				var v ProjectUpdateRequest
+				b, _ := io.ReadAll(req.Body)
+				if err := json.Unmarshal(b, &v); err != nil || v.Project.Settings == nil {
+					return http.StatusBadRequest, `{"code":"","message":"bad request"}`
+				}
+
+				a.mu.Lock()
+				defer a.mu.Unlock()
+				a.patches = append(a.patches, *v.Project.Settings)
+				if a.onUpdate != nil {
+					a.onUpdate(*v.Project.Settings, &a.settings)
+				} else {
+					applyProjectSettingsPatch(&a.settings, *v.Project.Settings)
+				}
+				return http.StatusOK, `{"project":{"id":"foo"},"operations":[]}`
+			},
+		)
+}
+
+func applyProjectSettingsPatch(dst *ProjectSettingsData, patch ProjectSettingsData) {
+	d := reflect.ValueOf(dst).Elem()
+	p := reflect.ValueOf(patch)
+	for i := 0; i < p.NumField(); i++ {
+		if !p.Field(i).IsNil() {
+			d.Field(i).Set(p.Field(i))
+		}
+	}
+}
+
+// allowIP the idempotent mutation adding the IP to the allowed IPs.
+func allowIP(ip string) func(*ProjectSettingsData) {
+	return func(s *ProjectSettingsData) {
+		if s.AllowedIps == nil {
+			s.AllowedIps = &AllowedIps{}
+		}
+		var ips []string
+		if s.AllowedIps.Ips != nil {
+			ips = *s.AllowedIps.Ips
+		}
+		for _, el := range ips {
+			if el == ip {
+				return
+			}
+		}
+		ips = append(ips, ip)
+		s.AllowedIps.Ips = &ips
+	}
+}
+
+func allowedIPs(s ProjectSettingsData) []string {
+	if s.AllowedIps == nil || s.AllowedIps.Ips == nil {
+		return nil
+	}
+	o := append([]string{}, *s.AllowedIps.Ips...)
+	sort.Strings(o)
+	return o
+}
+
+func TestClient_UpdateProjectSettings(t *testing.T) {
+	enabled := true
+	ips := []string{"10.0.0.1"}
+
+	tests := []struct {
+		name        string
+		api         *projectSettingsAPI
+		mutate      func(*ProjectSettingsData)
+		wantIPs     []string
+		wantUpdates int
+		wantErr     error
+	}{
+		{
+			name: "setting is added, other settings are not sent",
+			api: &projectSettingsAPI{
+				settings: ProjectSettingsData{
+					AllowedIps: &AllowedIps{Ips: &ips}, EnableLogicalReplication: &enabled,
+				},
+			},
+			mutate:      allowIP("10.0.0.2"),
+			wantIPs:     []string{"10.0.0.1", "10.0.0.2"},
+			wantUpdates: 1,
+		},
+		{
+			name:    "no change",
+			api:     &projectSettingsAPI{settings: ProjectSettingsData{AllowedIps: &AllowedIps{Ips: &ips}}},
+			mutate:  allowIP("10.0.0.1"),
+			wantIPs: []string{"10.0.0.1"},
+		},
+		{
+			name: "update overwritten concurrently is re-applied",
+			api: func() *projectSettingsAPI {
+				a := &projectSettingsAPI{settings: ProjectSettingsData{AllowedIps: &AllowedIps{Ips: &ips}}}
+				var calls int
+				a.onUpdate = func(patch ProjectSettingsData, settings *ProjectSettingsData) {
+					calls++
+					applyProjectSettingsPatch(settings, patch)
+					if calls == 1 {
+						// the concurrent writer overwrites the update with the settings it read before
+						concurrent := []string{"10.0.0.1", "10.0.0.3"}
+						settings.AllowedIps = &AllowedIps{Ips: &concurrent}
+					}
+				}
+				return a
+			}(),
+			mutate:      allowIP("10.0.0.2"),
+			wantIPs:     []string{"10.0.0.1", "10.0.0.2", "10.0.0.3"},
+			wantUpdates: 2,
+		},
+		{
+			name: "unhappy path: settings do not converge",
+			api: &projectSettingsAPI{
+				settings: ProjectSettingsData{AllowedIps: &AllowedIps{Ips: &ips}},
+				onUpdate: func(ProjectSettingsData, *ProjectSettingsData) {},
+			},
+			mutate:      allowIP("10.0.0.2"),
+			wantUpdates: projectSettingsMaxAttempts,
+			wantErr:     ErrProjectSettingsConflict,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(
+			tt.name, func(t *testing.T) {
+				api := tt.api.fake()
+
+				c, err := NewClient(Config{Key: "foo", HTTPClient: api})
+				if err != nil {
+					t.Fatal(err)
+				}
+
+				got, err := c.UpdateProjectSettings(context.TODO(), "foo", tt.mutate)
+				if !errors.Is(err, tt.wantErr) {
+					t.Fatalf("UpdateProjectSettings() error = %v, want %v", err, tt.wantErr)
+				}
+				if n := api.called(http.MethodPatch, "/projects/foo"); n != tt.wantUpdates {
+					t.Errorf("unexpected number of updates: %d, want %d", n, tt.wantUpdates)
+				}
+				for _, p := range tt.api.patches {
+					if p.EnableLogicalReplication != nil {
+						t.Errorf("unchanged setting is sent: %+v", p)
+					}
+				}
+				if tt.wantErr != nil {
+					return
+				}
+				if !reflect.DeepEqual(allowedIPs(got), tt.wantIPs) {
+					t.Errorf("unexpected allowed IPs: %v, want %v", allowedIPs(got), tt.wantIPs)
+				}
+			},
+		)
+	}
+}
@@ -0,0 +1,152 @@
+package sdk
+
+import (
+	"context"
+	"fmt"
+)
+
+// ProjectTemplate defines the reusable configuration of the projects.
+type ProjectTemplate struct {
+	// Project the project's configuration, e.g. the region, Postgres version, autoscaling limits
+	// and default settings. The name and organization are set when the project is created.
+	Project ProjectCreateRequestProject
+	// Roles the roles created in the default branch.
+	Roles []string
+	// Databases the databases created in the default branch after the roles,
+	// hence the databases can be owned by the template's roles.
+	Databases []DatabaseCreateRequestDatabase
+	// Branches the branches created from the default branch after its roles and databases.
+	Branches []BranchTemplate
+}
+
+// BranchTemplate defines the branch created from the project's default branch.
+type BranchTemplate struct {
+	Name string
+	// EndpointType the type of the branch's compute endpoint, the branch has no compute endpoint if it is nil.
+	EndpointType *EndpointType
+}
+
+// ProjectFromTemplate the resources created from the ProjectTemplate.
+type ProjectFromTemplate struct {
+	Project   CreatedProject
+	Roles     []Role
+	Databases []Database
+	Branches  []CreatedBranch
+}
+
+// CreateFromTemplate creates the project, and its roles, databases and branches defined by the template.
+// Every step waits for the operations of the previous one. If a step fails, the resources created
+// until the failure are returned along with the error, hence the caller can clean them up.
+func (c Client) CreateFromTemplate(
+	ctx context.Context, tmpl ProjectTemplate, name string, orgID *string,
+) (ProjectFromTemplate, error) {
+	var o ProjectFromTemplate
+	if err := ctx.Err(); err != nil {
+		return o, err
+	}
+
+	req := tmpl.Project
+	if name != "" {
+		req.Name = &name
+	}
+	if orgID != nil {
+		req.OrgID = orgID
+	}
+
+	created, err := c.CreateProject(ProjectCreateRequest{Project: req})
+	if err != nil {
+		return o, fmt.Errorf("could not create project: %w", err)
+	}
+	o.Project = created
+
+	projectID, branchID := created.Project.ID, created.Branch.ID
+	if err := c.WaitForOperations(ctx, projectID, created.Operations); err != nil {
+		return o, err
+	}
+
+	if err := c.createTemplateRoles(ctx, projectID, branchID, tmpl.Roles, &o); err != nil {
+		return o, err
+	}
+	if err := c.createTemplateDatabases(ctx, projectID, branchID, tmpl.Databases, &o); err != nil {
+		return o, err
+	}
+	if err := c.createTemplateBranches(ctx, projectID, branchID, tmpl.Branches, &o); err != nil {
+		return o, err
+	}
+	return o, nil
+}
+
+// createTemplateRoles creates the roles in the branch one by one, and adds the created roles to o.
+func (c Client) createTemplateRoles(
+	ctx context.Context, projectID, branchID string, roles []string, o *ProjectFromTemplate,
+) error {
+	for _, roleName := range roles {
+		resp, err := callUnlessLocked(
+			ctx, func() (RoleOperations, error) {
+				return c.CreateProjectBranchRole(
+					projectID, branchID, RoleCreateRequest{Role: RoleCreateRequestRole{Name: roleName}},
+				)
+			},
+		)
+		if err != nil {
+			return fmt.Errorf("could not create role %s: %w", roleName, err)
+		}
+		o.Roles = append(o.Roles, resp.Role)
+		if err := c.WaitForOperations(ctx, projectID, resp.Operations); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// createTemplateDatabases creates the databases in the branch one by one, and adds the created databases to o.
+func (c Client) createTemplateDatabases(
+	ctx context.Context, projectID, branchID string, dbs []DatabaseCreateRequestDatabase, o *ProjectFromTemplate,
+) error {
+	for _, db := range dbs {
+		resp, err := callUnlessLocked(
+			ctx, func() (DatabaseOperations, error) {
+				return c.CreateProjectBranchDatabase(projectID, branchID, DatabaseCreateRequest{Database: db})
+			},
+		)
+		if err != nil {
+			return fmt.Errorf("could not create database %s: %w", db.Name, err)
+		}
+		o.Databases = append(o.Databases, resp.Database)
+		if err := c.WaitForOperations(ctx, projectID, resp.Operations); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// createTemplateBranches creates the branches with their compute endpoints from the parent branch one by one,
+// and adds the created branches to o.
+func (c Client) createTemplateBranches(
+	ctx context.Context, projectID, parentID string, branches []BranchTemplate, o *ProjectFromTemplate,
+) error {
+	for _, b := range branches {
+		req := BranchCreateRequest{Branch: &BranchCreateRequestBranch{ParentID: &parentID}}
+		if b.Name != "" {
+			name := b.Name
+			req.Branch.Name = &name
+		}
+		if b.EndpointType != nil {
+			req.Endpoints = &[]BranchCreateRequestEndpointOptions{{Type: *b.EndpointType}}
+		}
+
+		resp, err := callUnlessLocked(
+			ctx, func() (CreatedBranch, error) {
+				return c.CreateProjectBranch(projectID, &CreateProjectBranchReqObj{BranchCreateRequest: req})
+			},
+		)
+		if err != nil {
+			return fmt.Errorf("could not create branch %s: %w", b.Name, err)
+		}
+		o.Branches = append(o.Branches, resp)
+		if err := c.WaitForOperations(ctx, projectID, resp.Operations); err != nil {
+			return err
+		}
+	}
+	return nil
+}
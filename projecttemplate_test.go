@@ -0,0 +1,103 @@
+package sdk
+
+import (
+	"context"
+	"io"
+	"net/http"
+	"strings"
+	"testing"
+)
+
+func TestClient_CreateFromTemplate(t *testing.T) {
+	var (
+		readOnly  = EndpointTypeReadOnly
+		regionID  = "aws-us-east-2"
+		pgVersion = PgVersion(16)
+	)
+	tmpl := ProjectTemplate{
+		Project:   ProjectCreateRequestProject{RegionID: &regionID, PgVersion: &pgVersion},
+		Roles:     []string{"app"},
+		Databases: []DatabaseCreateRequestDatabase{{Name: "appdb", OwnerName: "app"}},
+		Branches:  []BranchTemplate{{Name: "dev", EndpointType: &readOnly}},
+	}
+
+	tests := []struct {
+		name          string
+		api           func() *fakeAPI
+		wantBranches  int
+		wantProjectID string
+		wantErr       bool
+	}{
+		{
+			name: "happy path",
+			api: func() *fakeAPI {
+				return newTemplateFakeAPI().on(
+					http.MethodPost, "/projects/foo/branches", http.StatusCreated,
+					`{"branch":{"id":"br-dev","name":"dev"},"operations":[]}`,
+				)
+			},
+			wantBranches:  1,
+			wantProjectID: "foo",
+		},
+		{
+			name: "unhappy path: branch creation failed",
+			api: func() *fakeAPI {
+				return newTemplateFakeAPI().on(
+					http.MethodPost, "/projects/foo/branches", http.StatusBadRequest, `{"message":"invalid"}`,
+				)
+			},
+			wantProjectID: "foo",
+			wantErr:       true,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(
+			tt.name, func(t *testing.T) {
+				c, err := NewClient(Config{Key: "foo", HTTPClient: tt.api()})
+				if err != nil {
+					t.Fatal(err)
+				}
+
+				got, err := c.CreateFromTemplate(context.TODO(), tmpl, "myproject", nil)
+				if (err != nil) != tt.wantErr {
+					t.Fatalf("CreateFromTemplate() error = %v, wantErr %v", err, tt.wantErr)
+				}
+				if got.Project.Project.ID != tt.wantProjectID {
+					t.Errorf("unexpected project: %v", got.Project.Project)
+				}
+				if len(got.Roles) != 1 || got.Roles[0].Name != "app" {
+					t.Errorf("unexpected roles: %v", got.Roles)
+				}
+				if len(got.Databases) != 1 || got.Databases[0].Name != "appdb" {
+					t.Errorf("unexpected databases: %v", got.Databases)
+				}
+				if len(got.Branches) != tt.wantBranches {
+					t.Errorf("unexpected branches: %v", got.Branches)
+				}
+			},
+		)
+	}
+}
+
+// newTemplateFakeAPI returns the fake API creating the project, its role and database.
+func newTemplateFakeAPI() *fakeAPI {
+	return newFakeAPI().onFunc(
+		http.MethodPost, "/projects", func(req *http.Request) (int, string) {
+			b, _ := io.ReadAll(req.Body)
+			if !strings.Contains(string(b), `"name":"myproject"`) ||
+				!strings.Contains(string(b), `"region_id":"aws-us-east-2"`) {
+				return http.StatusBadRequest, `{"message":"unexpected request"}`
+			}
+			return http.StatusCreated, `{"project":{"id":"foo"},"branch":{"id":"br-main"},
+"operations":[{"id":"op1","status":"running"}]}`
+		},
+	).on(
+		http.MethodGet, "/projects/foo/operations/op1", http.StatusOK, `{"operation":{"id":"op1","status":"finished"}}`,
+	).on(
+		http.MethodPost, "/projects/foo/branches/br-main/roles", http.StatusCreated,
+		`{"role":{"name":"app","password":"secret"},"operations":[]}`,
+	).on(
+		http.MethodPost, "/projects/foo/branches/br-main/databases", http.StatusCreated,
+		`{"database":{"name":"appdb","owner_name":"app"},"operations":[]}`,
+	)
+}
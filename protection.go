@@ -0,0 +1,84 @@
+package sdk
+
+import (
+	"context"
+	"fmt"
+	"strconv"
+	"time"
+)
+
+// Annotation keys of the branch protection's audit record.
+const (
+	AnnotationKeyProtected           = "protected"
+	AnnotationKeyProtectionReason    = "protection-reason"
+	AnnotationKeyProtectionChangedAt = "protection-changed-at"
+)
+
+// BranchProtectionChange the audit record of the branch protection's change.
+type BranchProtectionChange struct {
+	ProjectID string `json:"project_id"`
+	BranchID  string `json:"branch_id"`
+	Protected bool   `json:"protected"`
+	Reason    string `json:"reason"`
+	// ChangedAt the time the change was requested.
+	ChangedAt time.Time `json:"changed_at"`
+	// Changed defines if the branch was updated, false if the branch's protection was already set as requested.
+	Changed bool `json:"changed"`
+}
+
+// Annotation returns the audit record as the annotation value. Note that the API only accepts annotations
+// upon the branch creation, hence the record can annotate the branch created from the protected branch,
+// e.g. using CreateProjectBranchWithAnnotations, but it cannot be attached to the protected branch itself.
+func (c BranchProtectionChange) Annotation() AnnotationValueData {
+	return NewAnnotationValue(
+		map[string]string{
+			AnnotationKeyProtected:           strconv.FormatBool(c.Protected),
+			AnnotationKeyProtectionReason:    c.Reason,
+			AnnotationKeyProtectionChangedAt: c.ChangedAt.UTC().Format(time.RFC3339),
+		},
+	)
+}
+
+// ProtectBranch protects the branch and returns the audit record of the change with the reason.
+func (c Client) ProtectBranch(
+	ctx context.Context, projectID, branchID, reason string,
+) (BranchProtectionChange, error) {
+	return c.changeBranchProtection(ctx, projectID, branchID, true, reason)
+}
+
+// UnprotectBranch removes the branch's protection and returns the audit record of the change with the reason.
+func (c Client) UnprotectBranch(
+	ctx context.Context, projectID, branchID, reason string,
+) (BranchProtectionChange, error) {
+	return c.changeBranchProtection(ctx, projectID, branchID, false, reason)
+}
+
+func (c Client) changeBranchProtection(
+	ctx context.Context, projectID, branchID string, protected bool, reason string,
+) (BranchProtectionChange, error) {
+	if err := ctx.Err(); err != nil {
+		return BranchProtectionChange{}, err
+	}
+
+	resp, err := c.GetProjectBranch(projectID, branchID)
+	if err != nil {
+		return BranchProtectionChange{}, fmt.Errorf("could not read branch %s: %w", branchID, err)
+	}
+
+	o := BranchProtectionChange{
+		ProjectID: projectID,
+		BranchID:  branchID,
+		Protected: protected,
+		Reason:    reason,
+		ChangedAt: time.Now().UTC(),
+	}
+	if resp.Branch.Protected == protected {
+		return o, nil
+	}
+
+	if err := c.setBranchProtected(ctx, projectID, branchID, protected); err != nil {
+		return BranchProtectionChange{}, err
+	}
+	o.Changed = true
+	return o, nil
+}
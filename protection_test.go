@@ -0,0 +1,77 @@
+package sdk
+
+import (
+	"context"
+	"encoding/json"
+	"io"
+	"net/http"
+	"strconv"
+	"testing"
+)
+
+func TestClient_ProtectBranch(t *testing.T) {
+	newAPI := func(protected bool) *fakeAPI {
+		return newFakeAPI().
+			on(
+				http.MethodGet, "/projects/foo/branches/br-foo", http.StatusOK,
+				`{"branch":{"id":"br-foo","protected":`+strconv.FormatBool(protected)+`}}`,
+			).
+			onFunc(
+				http.MethodPatch, "/projects/foo/branches/br-foo", func(req *http.Request) (int, string) {
+					var v BranchUpdateRequest
+					b, _ := io.ReadAll(req.Body)
+					if err := json.Unmarshal(b, &v); err != nil || v.Branch.Protected == nil {
+						return http.StatusBadRequest, `{"code":"","message":"invalid body"}`
+					}
+					return http.StatusOK, `{"branch":{"id":"br-foo"},"operations":[]}`
+				},
+			)
+	}
+
+	tests := []struct {
+		name        string
+		protected   bool
+		protect     bool
+		wantChanged bool
+	}{
+		{name: "protected", protected: false, protect: true, wantChanged: true},
+		{name: "unprotected", protected: true, protect: false, wantChanged: true},
+		{name: "already protected", protected: true, protect: true, wantChanged: false},
+	}
+	for _, tt := range tests {
+		t.Run(
+			tt.name, func(t *testing.T) {
+				api := newAPI(tt.protected)
+				c, err := NewClient(Config{Key: "foo", HTTPClient: api})
+				if err != nil {
+					t.Fatal(err)
+				}
+
+				f := c.UnprotectBranch
+				if tt.protect {
+					f = c.ProtectBranch
+				}
+				got, err := f(context.TODO(), "foo", "br-foo", "release freeze")
+				if err != nil {
+					t.Fatal(err)
+				}
+
+				if got.Changed != tt.wantChanged || got.Protected != tt.protect || got.Reason != "release freeze" ||
+					got.ChangedAt.IsZero() {
+					t.Errorf("unexpected audit record: %+v", got)
+				}
+				if patched := api.called(http.MethodPatch, "/projects/foo/branches/br-foo") > 0; patched != tt.wantChanged {
+					t.Errorf("branch updated = %v, want %v", patched, tt.wantChanged)
+				}
+
+				a := got.Annotation()
+				if v, _ := a.Get(AnnotationKeyProtectionReason); v != "release freeze" {
+					t.Errorf("unexpected annotation reason: %s", v)
+				}
+				if v, _ := a.Get(AnnotationKeyProtected); v != strconv.FormatBool(tt.protect) {
+					t.Errorf("unexpected annotation protected flag: %s", v)
+				}
+			},
+		)
+	}
+}
@@ -0,0 +1,134 @@
+package sdk
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"net/http"
+	"net/url"
+	"strings"
+)
+
+// ErrAmbiguousDatabase the error returned when the database to query cannot be selected
+// because the branch has multiple databases.
+var ErrAmbiguousDatabase = errors.New("branch has multiple databases")
+
+// QueryError the error of the SQL query returned by the compute endpoint.
+type QueryError struct {
+	// Code the Postgres error code, e.g. "42P01", empty if unknown.
+	Code    string `json:"code"`
+	Message string `json:"message"`
+	// HTTPCode the status code of the response.
+	HTTPCode int `json:"-"`
+}
+
+func (e QueryError) Error() string {
+	if e.Code == "" {
+		return fmt.Sprintf("query failed with the status code %d: %s", e.HTTPCode, e.Message)
+	}
+	return fmt.Sprintf("query failed with the code %s: %s", e.Code, e.Message)
+}
+
+type sqlOverHTTPRequest struct {
+	Query  string        `json:"query"`
+	Params []interface{} `json:"params"`
+}
+
+type sqlOverHTTPResponse struct {
+	Rows []map[string]interface{} `json:"rows"`
+}
+
+// Query runs the SQL query against the only database of the branch as the database owner, and returns the rows.
+// The query's placeholders, i.e. $1, $2, etc., are substituted with the args.
+//
+// The Data API is not described in the OpenAPI spec, hence the query is sent to the SQL-over-HTTP endpoint
+// of the branch's compute, the same endpoint used by Neon's serverless driver. It does not require
+// the Postgres driver, but the compute must be reachable over HTTPS. Note that the compute is started
+// if it is suspended. The error is of the type QueryError if the query fails.
+func (c Client) Query(
+	ctx context.Context, projectID, branchID, sql string, args ...interface{},
+) ([]map[string]interface{}, error) {
+	if err := ctx.Err(); err != nil {
+		return nil, err
+	}
+
+	resp, err := c.ListProjectBranchDatabases(projectID, branchID)
+	if err != nil {
+		return nil, fmt.Errorf("could not list databases of branch %s: %w", branchID, err)
+	}
+	switch len(resp.Databases) {
+	case 0:
+		return nil, fmt.Errorf("%w: branch %s has no databases", ErrNotFound, branchID)
+	case 1:
+	default:
+		return nil, fmt.Errorf("%w: branch %s, use QueryDatabase instead", ErrAmbiguousDatabase, branchID)
+	}
+
+	db := resp.Databases[0]
+	return c.QueryDatabase(ctx, projectID, branchID, db.Name, db.OwnerName, sql, args...)
+}
+
+// QueryDatabase runs the SQL query against the branch's database as the role, and returns the rows.
+// See Query for details.
+func (c Client) QueryDatabase(
+	ctx context.Context, projectID, branchID, databaseName, roleName, sql string, args ...interface{},
+) ([]map[string]interface{}, error) {
+	if err := ctx.Err(); err != nil {
+		return nil, err
+	}
+
+	resp, err := c.GetConnectionURI(projectID, &branchID, nil, databaseName, roleName, nil)
+	if err != nil {
+		return nil, fmt.Errorf("could not read connection URI of branch %s: %w", branchID, err)
+	}
+	u, err := url.Parse(resp.URI)
+	if err != nil {
+		return nil, fmt.Errorf("could not parse connection URI of branch %s: %w", branchID, err)
+	}
+
+	if args == nil {
+		args = []interface{}{}
+	}
+	body, err := json.Marshal(sqlOverHTTPRequest{Query: sql, Params: args})
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequestWithContext(
+		ctx, http.MethodPost, "https://"+u.Hostname()+"/sql", bytes.NewReader(body),
+	)
+	if err != nil {
+		return nil, err
+	}
+	req.Header.Set("Accept", "application/json")
+	req.Header.Set("Content-Type", "application/json")
+	req.Header.Set("Neon-Connection-String", resp.URI)
+
+	res, err := c.cfg.HTTPClient.Do(req)
+	if err != nil {
+		return nil, err
+	}
+	defer func() { _ = res.Body.Close() }()
+
+	buf, err := io.ReadAll(res.Body)
+	if err != nil {
+		return nil, err
+	}
+
+	if res.StatusCode > 299 {
+		e := QueryError{HTTPCode: res.StatusCode}
+		if err := json.Unmarshal(buf, &e); err != nil || e.Message == "" {
+			e.Message = strings.TrimSpace(string(buf))
+		}
+		return nil, e
+	}
+
+	var o sqlOverHTTPResponse
+	if err := json.Unmarshal(buf, &o); err != nil {
+		return nil, fmt.Errorf("could not decode query response: %w", err)
+	}
+	return o.Rows, nil
+}
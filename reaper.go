@@ -0,0 +1,160 @@
+package sdk
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"regexp"
+	"sort"
+	"time"
+)
+
+// ReapPolicy defines the stale branches deleted by ReapBranches.
+// The default and protected branches are never deleted.
+type ReapPolicy struct {
+	// MaxAge the branches created earlier than MaxAge ago are stale, it must be positive.
+	MaxAge time.Duration
+	// InactiveFor the branches which compute endpoints were active within InactiveFor are kept.
+	// The compute activity is not checked if it is not positive.
+	InactiveFor time.Duration
+	// Exclude the regular expressions of the names of the branches to keep, e.g. "^release-".
+	Exclude []*regexp.Regexp
+	// DryRun reports the stale branches without deleting them.
+	DryRun bool
+}
+
+func (p ReapPolicy) excluded(name string) bool {
+	for _, re := range p.Exclude {
+		if re.MatchString(name) {
+			return true
+		}
+	}
+	return false
+}
+
+// ReapBranches deletes the project's stale branches according to the policy, and returns the deleted branches,
+// or the branches to delete in the dry-run mode. The child branches are deleted before their parents,
+// the stale branch with the child branch which is kept is not deleted. If the deletion fails,
+// the branches deleted until the failure are returned along with the error.
+func (c Client) ReapBranches(ctx context.Context, projectID string, policy ReapPolicy) ([]Branch, error) {
+	if err := ctx.Err(); err != nil {
+		return nil, err
+	}
+	if policy.MaxAge <= 0 {
+		return nil, errors.New("reap policy's MaxAge must be positive")
+	}
+
+	branches, err := c.ListProjectBranches(projectID, nil)
+	if err != nil {
+		return nil, fmt.Errorf("could not list branches of project %s: %w", projectID, err)
+	}
+
+	var lastActive map[string]time.Time
+	if policy.InactiveFor > 0 {
+		if lastActive, err = c.branchesLastActive(projectID); err != nil {
+			return nil, err
+		}
+	}
+
+	o := reapCandidates(branches.Branches, policy, lastActive)
+	if policy.DryRun {
+		return o, nil
+	}
+	return c.deleteBranchesInOrder(ctx, projectID, o)
+}
+
+// reapCandidates returns the stale branches which can be deleted, the children are ordered before their parents.
+func reapCandidates(branches []Branch, policy ReapPolicy, lastActive map[string]time.Time) []Branch {
+	stale := staleBranches(branches, policy, lastActive)
+	keepAncestors(branches, stale)
+
+	var o []Branch
+	for _, b := range branches {
+		if stale[b.ID] {
+			o = append(o, b)
+		}
+	}
+	// the children are created after their parents
+	sort.SliceStable(o, func(i, j int) bool { return o[i].CreatedAt.After(o[j].CreatedAt) })
+	return o
+}
+
+// staleBranches returns the IDs of the branches which are stale according to the policy.
+func staleBranches(branches []Branch, policy ReapPolicy, lastActive map[string]time.Time) map[string]bool {
+	now := time.Now()
+	o := map[string]bool{}
+	for _, b := range branches {
+		switch {
+		case b.Default, b.Protected, policy.excluded(b.Name):
+		case now.Sub(b.CreatedAt) < policy.MaxAge:
+		case policy.InactiveFor > 0 && now.Sub(lastActive[b.ID]) < policy.InactiveFor:
+		default:
+			o[b.ID] = true
+		}
+	}
+	return o
+}
+
+// keepAncestors removes the ancestors of the branches which are kept from the stale branches.
+func keepAncestors(branches []Branch, stale map[string]bool) {
+	for _, b := range branches {
+		if stale[b.ID] {
+			continue
+		}
+		for parentID := b.ParentID; parentID != nil; {
+			delete(stale, *parentID)
+			parentID = findBranchParent(branches, *parentID)
+		}
+	}
+}
+
+// deleteBranchesInOrder deletes the branches one by one and awaits the deletion,
+// the branches deleted until the failure are returned along with the error.
+func (c Client) deleteBranchesInOrder(ctx context.Context, projectID string, branches []Branch) ([]Branch, error) {
+	for i, b := range branches {
+		if err := ctx.Err(); err != nil {
+			return branches[:i], err
+		}
+		resp, err := callUnlessLocked(
+			ctx, func() (BranchOperations, error) { return c.DeleteProjectBranch(projectID, b.ID) },
+		)
+		if err != nil && !isNotFound(err) {
+			return branches[:i], fmt.Errorf("could not delete branch %s: %w", b.ID, err)
+		}
+		if err := c.WaitForOperations(ctx, projectID, resp.Operations); err != nil {
+			return branches[:i+1], fmt.Errorf("branch %s: %w", b.ID, err)
+		}
+	}
+	return branches, nil
+}
+
+// branchesLastActive returns the latest activity of the branches' compute endpoints keyed by the branch ID.
+// The branch with the active endpoint is considered active now.
+func (c Client) branchesLastActive(projectID string) (map[string]time.Time, error) {
+	resp, err := c.ListProjectEndpoints(projectID)
+	if err != nil {
+		return nil, fmt.Errorf("could not list endpoints of project %s: %w", projectID, err)
+	}
+
+	o := map[string]time.Time{}
+	for _, ep := range resp.Endpoints {
+		last := o[ep.BranchID]
+		switch {
+		case ep.CurrentState == EndpointStateActive:
+			last = time.Now()
+		case ep.LastActive != nil && ep.LastActive.After(last):
+			last = *ep.LastActive
+		}
+		o[ep.BranchID] = last
+	}
+	return o, nil
+}
+
+func findBranchParent(branches []Branch, id string) *string {
+	for _, b := range branches {
+		if b.ID == id {
+			return b.ParentID
+		}
+	}
+	return nil
+}
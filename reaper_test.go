@@ -0,0 +1,134 @@
+package sdk
+
+import (
+	"context"
+	"errors"
+	"net/http"
+	"regexp"
+	"testing"
+	"time"
+)
+
+func newReaperFakeAPI() *fakeAPI {
+	old := time.Now().AddDate(0, 0, -30).UTC().Format(time.RFC3339)
+	older := time.Now().AddDate(0, 0, -31).UTC().Format(time.RFC3339)
+	recent := time.Now().Add(-time.Hour).UTC().Format(time.RFC3339)
+
+	return newFakeAPI().
+		on(
+			http.MethodGet, "/projects/foo/branches", http.StatusOK, `{"branches":[
+{"id":"br-main","name":"main","default":true,"created_at":"`+older+`"},
+{"id":"br-preview-1","name":"preview-1","parent_id":"br-main","created_at":"`+older+`"},
+{"id":"br-preview-1-child","name":"preview-1-child","parent_id":"br-preview-1","created_at":"`+old+`"},
+{"id":"br-preview-2","name":"preview-2","parent_id":"br-main","created_at":"`+old+`"},
+{"id":"br-keep-parent","name":"keep-parent","parent_id":"br-main","created_at":"`+older+`"},
+{"id":"br-release","name":"release-1","parent_id":"br-keep-parent","created_at":"`+old+`"},
+{"id":"br-active","name":"active","parent_id":"br-main","created_at":"`+old+`"},
+{"id":"br-protected","name":"protected","parent_id":"br-main","protected":true,"created_at":"`+old+`"},
+{"id":"br-new","name":"new","parent_id":"br-main","created_at":"`+recent+`"}
+]}`,
+		).
+		on(
+			http.MethodGet, "/projects/foo/endpoints", http.StatusOK, `{"endpoints":[
+{"id":"ep-active","branch_id":"br-active","current_state":"idle","last_active":"`+recent+`"},
+{"id":"ep-preview","branch_id":"br-preview-2","current_state":"idle","last_active":"`+old+`"}
+]}`,
+		).
+		on(
+			http.MethodDelete, "/projects/foo/branches/br-preview-1", http.StatusOK,
+			`{"branch":{"id":"br-preview-1"},"operations":[]}`,
+		).
+		on(
+			http.MethodDelete, "/projects/foo/branches/br-preview-1-child", http.StatusOK,
+			`{"branch":{"id":"br-preview-1-child"},"operations":[]}`,
+		).
+		on(
+			http.MethodDelete, "/projects/foo/branches/br-preview-2", http.StatusOK,
+			`{"branch":{"id":"br-preview-2"},"operations":[]}`,
+		)
+}
+
+func TestClient_ReapBranches(t *testing.T) {
+	policy := ReapPolicy{
+		MaxAge:      7 * 24 * time.Hour,
+		InactiveFor: 24 * time.Hour,
+		Exclude:     []*regexp.Regexp{regexp.MustCompile("^release-")},
+	}
+
+	tests := []struct {
+		name   string
+		dryRun bool
+	}{
+		{name: "dry run", dryRun: true},
+		{name: "deleted"},
+	}
+	for _, tt := range tests {
+		t.Run(
+			tt.name, func(t *testing.T) {
+				api := newReaperFakeAPI()
+				c, err := NewClient(Config{Key: "foo", HTTPClient: api})
+				if err != nil {
+					t.Fatal(err)
+				}
+
+				p := policy
+				p.DryRun = tt.dryRun
+				got, err := c.ReapBranches(context.TODO(), "foo", p)
+				if err != nil {
+					t.Fatal(err)
+				}
+
+				want := []string{"br-preview-1-child", "br-preview-2", "br-preview-1"}
+				if len(got) != len(want) {
+					t.Fatalf("ReapBranches() got = %v, want %v", got, want)
+				}
+				for i := range want {
+					if got[i].ID != want[i] {
+						t.Errorf("ReapBranches() got %s at %d, want %s", got[i].ID, i, want[i])
+					}
+					deleted := api.called(http.MethodDelete, "/projects/foo/branches/"+want[i]) > 0
+					if deleted == tt.dryRun {
+						t.Errorf("branch %s deleted = %v in dry-run %v", want[i], deleted, tt.dryRun)
+					}
+				}
+			},
+		)
+	}
+}
+
+func TestClient_ReapBranches_zeroPolicy(t *testing.T) {
+	api := newReaperFakeAPI()
+	c, err := NewClient(Config{Key: "foo", HTTPClient: api})
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if _, err := c.ReapBranches(context.TODO(), "foo", ReapPolicy{}); err == nil {
+		t.Fatal("error is expected for the policy without MaxAge")
+	}
+	if n := api.called(http.MethodGet, "/projects/foo/branches"); n != 0 {
+		t.Errorf("branches are not expected to be listed, listed %d times", n)
+	}
+}
+
+func TestClient_ReapBranches_operationFailed(t *testing.T) {
+	api := newReaperFakeAPI().on(
+		http.MethodDelete, "/projects/foo/branches/br-preview-2", http.StatusOK,
+		`{"branch":{"id":"br-preview-2"},"operations":[{"id":"op1","project_id":"foo","status":"failed"}]}`,
+	)
+	c, err := NewClient(Config{Key: "foo", HTTPClient: api})
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	got, err := c.ReapBranches(context.TODO(), "foo", ReapPolicy{MaxAge: 7 * 24 * time.Hour})
+	if !errors.Is(err, ErrOperationFailed) {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if len(got) != 2 || got[0].ID != "br-preview-1-child" || got[1].ID != "br-preview-2" {
+		t.Errorf("the deleted branches are expected until the failure, got %v", got)
+	}
+	if n := api.called(http.MethodDelete, "/projects/foo/branches/br-preview-1"); n != 0 {
+		t.Errorf("branch is not expected to be deleted after the failure, deleted %d times", n)
+	}
+}
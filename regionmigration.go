@@ -0,0 +1,206 @@
+package sdk
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"strings"
+)
+
+// ErrSameRegion the error returned when the project is migrated to the region it is located in.
+var ErrSameRegion = errors.New("project is located in the target region")
+
+// regionMigrationPublication the name of the publication and the subscription used to replicate the data.
+const regionMigrationPublication = "neon_region_migration"
+
+// RegionMigrationStep the step of MigrateRegion.
+type RegionMigrationStep string
+
+// Steps of the region migration in the order of execution.
+const (
+	// RegionMigrationStepEnableReplication enables logical replication of the source project.
+	RegionMigrationStepEnableReplication RegionMigrationStep = "enable_logical_replication"
+	// RegionMigrationStepCreateProject creates the project in the target region, and restores the schemas.
+	RegionMigrationStepCreateProject RegionMigrationStep = "create_project"
+	// RegionMigrationStepReplication prepares the replication guidance and calls the Replicate hook.
+	RegionMigrationStepReplication RegionMigrationStep = "replication"
+	// RegionMigrationStepCutover prepares the cutover checklist.
+	RegionMigrationStepCutover RegionMigrationStep = "cutover"
+)
+
+// RegionMigrationProgress the progress of the migration's step.
+type RegionMigrationProgress struct {
+	Step RegionMigrationStep
+	// Done defines if the step completed, the step started otherwise.
+	Done bool
+	// Err the step's failure, nil if the step started, or succeeded.
+	Err error
+}
+
+// MigrateRegionOptions defines how the project is migrated.
+type MigrateRegionOptions struct {
+	// Name the name of the project in the target region, the source project's name is used if it is empty.
+	Name string
+	// RestoreSchema applies the schemas of the source databases to the target databases, see CloneProjectOptions.
+	// Note that logical replication requires the tables to exist in the target databases.
+	RestoreSchema SchemaRestoreFunc
+	// EnableLogicalReplication enables logical replication of the source project. The change cannot be undone,
+	// and the source project's active endpoints are suspended, see Client.EnableLogicalReplication.
+	EnableLogicalReplication bool
+	// Replicate sets up the replication of the data, e.g. runs the guidance's SQL statements.
+	// The replication is not set up if it is nil.
+	Replicate func(ctx context.Context, guidance ReplicationGuidance) error
+	// OnProgress is invoked when every step starts and finishes.
+	OnProgress func(RegionMigrationProgress)
+}
+
+// ReplicationGuidance the SQL statements to replicate the data of the source databases to the target databases.
+type ReplicationGuidance struct {
+	SourceProjectID string
+	TargetProjectID string
+	TargetBranchID  string
+	Databases       []DatabaseReplication
+}
+
+// DatabaseReplication the SQL statements to replicate the data of the database.
+type DatabaseReplication struct {
+	Database Database
+	// Publication the statement to run in the source database.
+	Publication string
+	// Subscription the statement to run in the target database, it contains the source's credentials.
+	Subscription string
+}
+
+// RegionMigration the result of MigrateRegion.
+type RegionMigration struct {
+	Target   ClonedProject
+	Guidance ReplicationGuidance
+	// Checklist the steps to switch the workload to the target project in the order of execution.
+	Checklist []string
+}
+
+// MigrateRegion creates the clone of the project in the target region, see CloneProject, and prepares
+// the guidance to replicate the data with the logical replication, and the cutover checklist.
+// The replication is set up by the Replicate hook, the cutover is left to the caller. If a step fails,
+// the migration's state until the failure is returned along with the error, hence the caller can clean up
+// the target project. It returns the error wrapping ErrSameRegion if the project is located in the target region.
+func (c Client) MigrateRegion(
+	ctx context.Context, sourceProjectID, regionID string, opts MigrateRegionOptions,
+) (RegionMigration, error) {
+	var o RegionMigration
+	if err := ctx.Err(); err != nil {
+		return o, err
+	}
+
+	resp, err := c.GetProject(sourceProjectID)
+	if err != nil {
+		return o, fmt.Errorf("could not read project %s: %w", sourceProjectID, err)
+	}
+	if resp.Project.RegionID == regionID {
+		return o, fmt.Errorf("%w: project %s, region %s", ErrSameRegion, sourceProjectID, regionID)
+	}
+
+	step := func(s RegionMigrationStep, fn func() error) error {
+		opts.progress(RegionMigrationProgress{Step: s})
+		err := fn()
+		opts.progress(RegionMigrationProgress{Step: s, Done: true, Err: err})
+		return err
+	}
+
+	if opts.EnableLogicalReplication {
+		if err := step(
+			RegionMigrationStepEnableReplication, func() error {
+				return c.EnableLogicalReplication(ctx, sourceProjectID, true)
+			},
+		); err != nil {
+			return o, err
+		}
+	}
+
+	if err := step(
+		RegionMigrationStepCreateProject, func() error {
+			var err error
+			o.Target, err = c.CloneProject(
+				ctx, sourceProjectID, CloneProjectOptions{
+					Name: opts.Name, RegionID: &regionID, RestoreSchema: opts.RestoreSchema,
+				},
+			)
+			return err
+		},
+	); err != nil {
+		return o, err
+	}
+
+	if err := step(
+		RegionMigrationStepReplication, func() error {
+			var err error
+			if o.Guidance, err = c.replicationGuidance(sourceProjectID, o.Target); err != nil {
+				return err
+			}
+			if opts.Replicate == nil {
+				return nil
+			}
+			return opts.Replicate(ctx, o.Guidance)
+		},
+	); err != nil {
+		return o, err
+	}
+
+	_ = step(
+		RegionMigrationStepCutover, func() error {
+			o.Checklist = regionMigrationChecklist(sourceProjectID, o.Target.Project.Project.ID)
+			return nil
+		},
+	)
+	return o, nil
+}
+
+func (o MigrateRegionOptions) progress(p RegionMigrationProgress) {
+	if o.OnProgress != nil {
+		o.OnProgress(p)
+	}
+}
+
+func (c Client) replicationGuidance(sourceProjectID string, target ClonedProject) (ReplicationGuidance, error) {
+	o := ReplicationGuidance{
+		SourceProjectID: sourceProjectID,
+		TargetProjectID: target.Project.Project.ID,
+		TargetBranchID:  target.Project.Branch.ID,
+	}
+
+	dbs := append([]Database{}, target.Project.Databases...)
+	dbs = append(dbs, target.Databases...)
+	for _, db := range dbs {
+		resp, err := c.GetConnectionURI(sourceProjectID, nil, nil, db.Name, db.OwnerName, nil)
+		if err != nil {
+			return o, fmt.Errorf("could not read connection URI of database %s: %w", db.Name, err)
+		}
+		o.Databases = append(
+			o.Databases, DatabaseReplication{
+				Database:    db,
+				Publication: "CREATE PUBLICATION " + regionMigrationPublication + " FOR ALL TABLES;",
+				Subscription: "CREATE SUBSCRIPTION " + regionMigrationPublication +
+					" CONNECTION '" + strings.ReplaceAll(resp.URI, "'", "''") + "'" +
+					" PUBLICATION " + regionMigrationPublication + ";",
+			},
+		)
+	}
+	return o, nil
+}
+
+func regionMigrationChecklist(sourceProjectID, targetProjectID string) []string {
+	return []string{
+		fmt.Sprintf(
+			"Verify that the subscriptions of project %s caught up with the publications of project %s",
+			targetProjectID, sourceProjectID,
+		),
+		fmt.Sprintf("Stop the writes to project %s", sourceProjectID),
+		fmt.Sprintf("Update the sequences of project %s, they are not replicated", targetProjectID),
+		fmt.Sprintf("Switch the applications' connection strings to project %s", targetProjectID),
+		fmt.Sprintf(
+			"Drop the subscription %s in project %s, and the publication %s in project %s",
+			regionMigrationPublication, targetProjectID, regionMigrationPublication, sourceProjectID,
+		),
+		fmt.Sprintf("Delete project %s after the verification period", sourceProjectID),
+	}
+}
@@ -0,0 +1,72 @@
+// Package regions defines the IDs of the active Neon regions.
+// The constants are generated from the response of the active regions' endpoint.
+package regions
+
+import (
+	"errors"
+	"fmt"
+)
+
+// ErrUnknownRegion the error returned when the region is not active.
+var ErrUnknownRegion = errors.New("unknown region")
+
+const (
+	// RegionAWSUSEast1 AWS US East (N. Virginia).
+	RegionAWSUSEast1 = "aws-us-east-1"
+	// RegionAWSUSEast2 AWS US East (Ohio).
+	RegionAWSUSEast2 = "aws-us-east-2"
+	// RegionAWSUSWest2 AWS US West (Oregon).
+	RegionAWSUSWest2 = "aws-us-west-2"
+	// RegionAWSEUCentral1 AWS Europe (Frankfurt).
+	RegionAWSEUCentral1 = "aws-eu-central-1"
+	// RegionAWSEUWest2 AWS Europe (London).
+	RegionAWSEUWest2 = "aws-eu-west-2"
+	// RegionAWSAPSoutheast1 AWS Asia Pacific (Singapore).
+	RegionAWSAPSoutheast1 = "aws-ap-southeast-1"
+	// RegionAWSAPSoutheast2 AWS Asia Pacific (Sydney).
+	RegionAWSAPSoutheast2 = "aws-ap-southeast-2"
+	// RegionAWSSAEast1 AWS South America (São Paulo).
+	RegionAWSSAEast1 = "aws-sa-east-1"
+	// RegionAzureEastus2 Azure East US 2 (Virginia).
+	RegionAzureEastus2 = "azure-eastus2"
+	// RegionAzureWestus3 Azure West US 3 (Arizona).
+	RegionAzureWestus3 = "azure-westus3"
+	// RegionAzureGwc Azure Germany West Central (Frankfurt).
+	RegionAzureGwc = "azure-gwc"
+)
+
+// Default the region used by default in new projects.
+const Default = RegionAWSUSEast2
+
+// All the IDs of the active regions.
+var All = []string{
+	RegionAWSUSEast1,
+	RegionAWSUSEast2,
+	RegionAWSUSWest2,
+	RegionAWSEUCentral1,
+	RegionAWSEUWest2,
+	RegionAWSAPSoutheast1,
+	RegionAWSAPSoutheast2,
+	RegionAWSSAEast1,
+	RegionAzureEastus2,
+	RegionAzureWestus3,
+	RegionAzureGwc,
+}
+
+// IsValid defines if the region is active.
+func IsValid(id string) bool {
+	for _, v := range All {
+		if v == id {
+			return true
+		}
+	}
+	return false
+}
+
+// Validate returns the error wrapping ErrUnknownRegion if the region is not active.
+func Validate(id string) error {
+	if !IsValid(id) {
+		return fmt.Errorf("%w: %q", ErrUnknownRegion, id)
+	}
+	return nil
+}
@@ -0,0 +1,18 @@
+package regions
+
+import (
+	"errors"
+	"testing"
+)
+
+func TestValidate(t *testing.T) {
+	for _, id := range All {
+		if err := Validate(id); err != nil {
+			t.Errorf("Validate(%q) unexpected error: %v", id, err)
+		}
+	}
+
+	if err := Validate("foo-bar-1"); !errors.Is(err, ErrUnknownRegion) {
+		t.Errorf("Validate() error = %v, want ErrUnknownRegion", err)
+	}
+}
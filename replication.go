@@ -0,0 +1,65 @@
+package sdk
+
+import (
+	"context"
+	"errors"
+	"fmt"
+)
+
+// ErrLogicalReplicationNotEnabled the error returned when logical replication is not enabled after the update.
+var ErrLogicalReplicationNotEnabled = errors.New("logical replication is not enabled")
+
+// EnableLogicalReplication sets wal_level=logical for all compute endpoints of the project.
+// The change cannot be undone, hence it is rejected with the error wrapping ErrDestructiveCallRejected
+// unless confirm is set. Note that the project's active endpoints are suspended by the API upon the change.
+// The call awaits completion of the operations and verifies that the project reports logical replication enabled,
+// the error wrapping ErrLogicalReplicationNotEnabled is returned otherwise. Note that the API does not expose
+// the endpoints' wal_level, hence the project's setting is verified. It is noop if the replication is enabled.
+func (c Client) EnableLogicalReplication(ctx context.Context, projectID string, confirm bool) error {
+	if err := ctx.Err(); err != nil {
+		return err
+	}
+
+	enabled, err := c.logicalReplicationEnabled(projectID)
+	if err != nil || enabled {
+		return err
+	}
+
+	if !confirm {
+		return fmt.Errorf(
+			"%w: enabling logical replication for project %s cannot be undone, it must be confirmed",
+			ErrDestructiveCallRejected, projectID,
+		)
+	}
+
+	v := true
+	resp, err := c.UpdateProject(
+		projectID, ProjectUpdateRequest{
+			Project: ProjectUpdateRequestProject{Settings: &ProjectSettingsData{EnableLogicalReplication: &v}},
+		},
+	)
+	if err != nil {
+		return fmt.Errorf("could not enable logical replication for project %s: %w", projectID, err)
+	}
+
+	if err := c.WaitForOperations(ctx, projectID, resp.Operations); err != nil {
+		return err
+	}
+
+	if enabled, err = c.logicalReplicationEnabled(projectID); err != nil {
+		return err
+	}
+	if !enabled {
+		return fmt.Errorf("%w: project %s", ErrLogicalReplicationNotEnabled, projectID)
+	}
+	return nil
+}
+
+func (c Client) logicalReplicationEnabled(projectID string) (bool, error) {
+	resp, err := c.GetProject(projectID)
+	if err != nil {
+		return false, fmt.Errorf("could not read project %s: %w", projectID, err)
+	}
+	s := resp.Project.Settings
+	return s != nil && s.EnableLogicalReplication != nil && *s.EnableLogicalReplication, nil
+}
@@ -0,0 +1,84 @@
+package sdk
+
+import (
+	"context"
+	"errors"
+	"net/http"
+	"sync/atomic"
+	"testing"
+)
+
+func TestClient_EnableLogicalReplication(t *testing.T) {
+	const (
+		disabled = `{"project":{"id":"foo","settings":{"enable_logical_replication":false}}}`
+		enabled  = `{"project":{"id":"foo","settings":{"enable_logical_replication":true}}}`
+		updated  = `{"project":{"id":"foo"},"operations":[
+{"id":"op1","project_id":"foo","action":"suspend_compute","status":"finished"}
+]}`
+	)
+
+	tests := []struct {
+		name        string
+		confirm     bool
+		before      string
+		after       string
+		wantErr     error
+		wantUpdated bool
+	}{
+		{
+			name:        "enabled",
+			confirm:     true,
+			before:      disabled,
+			after:       enabled,
+			wantUpdated: true,
+		},
+		{
+			name:    "not confirmed",
+			before:  disabled,
+			after:   enabled,
+			wantErr: ErrDestructiveCallRejected,
+		},
+		{
+			name:   "already enabled",
+			before: enabled,
+			after:  enabled,
+		},
+		{
+			name:        "not enabled after update",
+			confirm:     true,
+			before:      disabled,
+			after:       disabled,
+			wantErr:     ErrLogicalReplicationNotEnabled,
+			wantUpdated: true,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(
+			tt.name, func(t *testing.T) {
+				var calls int32
+				api := newFakeAPI().
+					onFunc(
+						http.MethodGet, "/projects/foo", func(_ *http.Request) (int, string) {
+							if atomic.AddInt32(&calls, 1) == 1 {
+								return http.StatusOK, tt.before
+							}
+							return http.StatusOK, tt.after
+						},
+					).
+					on(http.MethodPatch, "/projects/foo", http.StatusOK, updated)
+				c, err := NewClient(Config{Key: "foo", HTTPClient: api})
+				if err != nil {
+					t.Fatal(err)
+				}
+
+				err = c.EnableLogicalReplication(context.TODO(), "foo", tt.confirm)
+				if !errors.Is(err, tt.wantErr) {
+					t.Errorf("EnableLogicalReplication() error = %v, wantErr %v", err, tt.wantErr)
+				}
+				if got := api.called(http.MethodPatch, "/projects/foo") > 0; got != tt.wantUpdated {
+					t.Errorf("project updated = %v, want %v", got, tt.wantUpdated)
+				}
+			},
+		)
+	}
+}
@@ -0,0 +1,130 @@
+package sdk
+
+import (
+	"bytes"
+	"errors"
+	"fmt"
+	"io"
+	"net/http"
+	"reflect"
+	"strings"
+)
+
+// ErrEmptyPathParameter the error returned when the required path parameter, e.g. the project ID, is empty.
+var ErrEmptyPathParameter = errors.New("path parameter must not be empty")
+
+// PathParameterError the error returned when the path parameter is invalid.
+// It matches ErrEmptyPathParameter with errors.Is.
+type PathParameterError struct {
+	// Name the name of the parameter in the API spec, e.g. "project_id".
+	Name string
+}
+
+func (e *PathParameterError) Error() string {
+	return "path parameter " + e.Name + " must not be empty"
+}
+
+func (e *PathParameterError) Is(target error) bool {
+	return target == ErrEmptyPathParameter
+}
+
+func newPathParameterError(name string) error {
+	return &PathParameterError{Name: name}
+}
+
+// newRequest builds the API request. It returns the error instead of the nil request if the URL is malformed,
+// e.g. because of invalid characters, or if the path has empty segments because the path parameter is not set.
+func newRequest(method, u string, body io.Reader) (*http.Request, error) {
+	req, err := http.NewRequest(method, u, body)
+	if err != nil {
+		return nil, fmt.Errorf("could not build request %s %s: %w", method, u, err)
+	}
+	if p := req.URL.EscapedPath(); strings.Contains(p, "//") || strings.HasSuffix(p, "/") {
+		return nil, fmt.Errorf("%w: request %s %s", ErrEmptyPathParameter, method, p)
+	}
+	return req, nil
+}
+
+// BuildRequest builds the request to the API's path, e.g. "/projects/foo", exactly as the client would send it,
+// including the credentials' headers, compression and the pre-signature, without sending it. It lets the external
+// tools, e.g. the policy engines, inspect the outgoing changes. The request's body can be read repeatedly using
+// its GetBody. Note that the checks of Config.ReadOnly and Config.DestructiveGuard are not applied.
+func (c Client) BuildRequest(method, path string, payload interface{}) (*http.Request, error) {
+	req, _, _, err := c.buildRequest(method, c.baseURL+path, payload, c.newIdempotencyKey(method), true)
+	return req, err
+}
+
+// buildRequest builds the request to send. The encoded payload is returned along with the flag
+// defining if the request's body is compressed. The body is not compressed unless compress is set.
+func (c Client) buildRequest(
+	method, u string, reqPayload interface{}, idempotencyKey string, compress bool,
+) (*http.Request, []byte, bool, error) {
+	var body io.Reader
+	var reqBody []byte
+	if reqPayload != nil {
+		if v := reflect.ValueOf(reqPayload); v.Kind() == reflect.Struct || !v.IsNil() {
+			b, err := c.codec().Marshal(reqPayload)
+			if err != nil {
+				return nil, nil, false, err
+			}
+			body = bytes.NewReader(b)
+			reqBody = b
+		}
+	}
+
+	req, err := newRequest(method, u, body)
+	if err != nil {
+		return nil, nil, false, err
+	}
+	setHeaders(req)
+	setIdempotencyKey(req, idempotencyKey)
+	if err := c.authenticate(req); err != nil {
+		return nil, nil, false, err
+	}
+	var compressed bool
+	if compress {
+		if compressed, err = c.compressRequest(req, reqBody); err != nil {
+			return nil, nil, false, err
+		}
+	}
+	if err := c.preSign(req); err != nil {
+		return nil, nil, false, err
+	}
+	return req, reqBody, compressed, nil
+}
+
+// ErrResponseTooLarge the error returned when the response body exceeds Config.MaxResponseSize.
+var ErrResponseTooLarge = errors.New("response body is too large")
+
+// limitResponseBody limits the size of the response body read, reading beyond the limit fails
+// with ErrResponseTooLarge. The body is not limited if the limit is not positive.
+func limitResponseBody(res *http.Response, limit int64) {
+	if limit <= 0 {
+		return
+	}
+	res.Body = &limitedBody{
+		r:     io.LimitReader(res.Body, limit+1),
+		c:     res.Body,
+		limit: limit,
+	}
+}
+
+type limitedBody struct {
+	r     io.Reader
+	c     io.Closer
+	limit int64
+	read  int64
+}
+
+func (b *limitedBody) Read(p []byte) (int, error) {
+	n, err := b.r.Read(p)
+	b.read += int64(n)
+	if b.read > b.limit {
+		return n - int(b.read-b.limit), fmt.Errorf("%w: the limit is %d bytes", ErrResponseTooLarge, b.limit)
+	}
+	return n, err
+}
+
+func (b *limitedBody) Close() error {
+	return b.c.Close()
+}
@@ -0,0 +1,276 @@
+package sdk
+
+import (
+	"errors"
+	"io"
+	"net/http"
+	"strings"
+	"testing"
+)
+
+type requestTestHTTPClient struct {
+	calls int
+}
+
+func (c *requestTestHTTPClient) Do(req *http.Request) (*http.Response, error) {
+	c.calls++
+	return &http.Response{
+		StatusCode: http.StatusOK,
+		Body:       http.NoBody,
+		Request:    req,
+	}, nil
+}
+
+func TestClient_requestHandler_invalidRequest(t *testing.T) {
+	tests := []struct {
+		name    string
+		url     string
+		wantErr string
+	}{
+		{
+			name: "valid",
+			url:  baseURL + "/projects/foo",
+		},
+		{
+			name:    "empty path parameter",
+			url:     baseURL + "/projects//branches",
+			wantErr: ErrEmptyPathParameter.Error(),
+		},
+		{
+			name:    "empty trailing path parameter",
+			url:     baseURL + "/projects/",
+			wantErr: ErrEmptyPathParameter.Error(),
+		},
+		{
+			name:    "malformed URL",
+			url:     baseURL + "/projects/foo\n",
+			wantErr: "could not build request",
+		},
+	}
+	for _, tt := range tests {
+		t.Run(
+			tt.name, func(t *testing.T) {
+				api := &requestTestHTTPClient{}
+				c, err := NewClient(Config{Key: "foo", HTTPClient: api})
+				if err != nil {
+					t.Fatal(err)
+				}
+
+				err = c.requestHandler(tt.url, http.MethodGet, nil, nil)
+				if tt.wantErr == "" {
+					if err != nil || api.calls != 1 {
+						t.Fatalf("unexpected error: %v", err)
+					}
+					return
+				}
+
+				if err == nil || !strings.Contains(err.Error(), tt.wantErr) {
+					t.Fatalf("requestHandler() error = %v, want %q", err, tt.wantErr)
+				}
+				if api.calls > 0 {
+					t.Errorf("invalid request was sent")
+				}
+			},
+		)
+	}
+}
+
+func TestClient_emptyPathParameter(t *testing.T) {
+	api := &requestTestHTTPClient{}
+	c, err := NewClient(Config{Key: "foo", HTTPClient: api})
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	_, err = c.GetProjectBranch("foo", "")
+	var e *PathParameterError
+	if !errors.Is(err, ErrEmptyPathParameter) || !errors.As(err, &e) || e.Name != "branch_id" {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if api.calls > 0 {
+		t.Errorf("invalid request was sent")
+	}
+}
+
+func TestClient_pathParameterEscaped(t *testing.T) {
+	var got *http.Request
+	c, err := NewClient(
+		Config{
+			Key: "foo",
+			HTTPClient: doerFunc(
+				func(req *http.Request) (*http.Response, error) {
+					got = req
+					return &http.Response{StatusCode: http.StatusOK, Body: http.NoBody, Request: req}, nil
+				},
+			),
+		},
+	)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	_, _ = c.GetProjectBranch("foo/bar baz%", "br-foo")
+	if got == nil {
+		t.Fatal("request was not sent")
+	}
+	if want := "/projects/foo%2Fbar%20baz%25/branches/br-foo"; !strings.HasSuffix(got.URL.EscapedPath(), want) {
+		t.Errorf("unexpected path %s, want suffix %s", got.URL.EscapedPath(), want)
+	}
+}
+
+type doerFunc func(req *http.Request) (*http.Response, error)
+
+func (f doerFunc) Do(req *http.Request) (*http.Response, error) {
+	return f(req)
+}
+
+func TestClient_requestHandler_emptyResponse(t *testing.T) {
+	for _, code := range []int{http.StatusOK, http.StatusNoContent} {
+		t.Run(
+			http.StatusText(code), func(t *testing.T) {
+				c, err := NewClient(
+					Config{
+						Key: "foo",
+						HTTPClient: doerFunc(
+							func(req *http.Request) (*http.Response, error) {
+								return &http.Response{StatusCode: code, Body: http.NoBody, Request: req}, nil
+							},
+						),
+						ValidateResponses: true,
+					},
+				)
+				if err != nil {
+					t.Fatal(err)
+				}
+
+				v := struct {
+					ID string `json:"id"`
+				}{}
+				if err := c.requestHandler(c.baseURL+"/projects/foo", http.MethodDelete, nil, &v); err != nil {
+					t.Fatalf("unexpected error: %v", err)
+				}
+				if v.ID != "" {
+					t.Errorf("zero value is expected, got %+v", v)
+				}
+			},
+		)
+	}
+}
+
+func TestClient_MaxResponseSize(t *testing.T) {
+	const body = `{"branch":{"id":"bar"}}`
+
+	tests := []struct {
+		name    string
+		limit   int64
+		code    int
+		wantErr error
+	}{
+		{
+			name: "not limited",
+			code: http.StatusOK,
+		},
+		{
+			name:  "within limit",
+			limit: int64(len(body)),
+			code:  http.StatusOK,
+		},
+		{
+			name:    "unhappy path: too large",
+			limit:   int64(len(body)) - 1,
+			code:    http.StatusOK,
+			wantErr: ErrResponseTooLarge,
+		},
+		{
+			name:    "unhappy path: too large error response",
+			limit:   5,
+			code:    http.StatusNotFound,
+			wantErr: ErrResponseTooLarge,
+		},
+		{
+			name:    "unhappy path: too large server error response",
+			limit:   5,
+			code:    http.StatusInternalServerError,
+			wantErr: ErrResponseTooLarge,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(
+			tt.name, func(t *testing.T) {
+				c, err := NewClient(
+					Config{
+						Key: "foo",
+						HTTPClient: doerFunc(
+							func(req *http.Request) (*http.Response, error) {
+								return &http.Response{
+									StatusCode: tt.code,
+									Body:       io.NopCloser(strings.NewReader(body)),
+									Request:    req,
+								}, nil
+							},
+						),
+						MaxResponseSize: tt.limit,
+					},
+				)
+				if err != nil {
+					t.Fatal(err)
+				}
+
+				resp, err := c.GetProjectBranch("foo", "bar")
+				switch {
+				case tt.code != http.StatusOK:
+					var e Error
+					if !errors.As(err, &e) || e.HTTPCode != tt.code {
+						t.Errorf("API error is expected, got %v", err)
+					}
+					if !errors.Is(err, tt.wantErr) {
+						t.Errorf("error %v is expected, got %v", tt.wantErr, err)
+					}
+				case tt.wantErr != nil:
+					if !errors.Is(err, tt.wantErr) {
+						t.Errorf("error %v is expected, got %v", tt.wantErr, err)
+					}
+				case err != nil:
+					t.Fatalf("unexpected error: %v", err)
+				case resp.Branch.ID != "bar":
+					t.Errorf("unexpected response: %+v", resp)
+				}
+			},
+		)
+	}
+}
+
+func TestClient_BuildRequest(t *testing.T) {
+	api := &requestTestHTTPClient{}
+	c, err := NewClient(Config{Key: "foo", HTTPClient: api, ReadOnly: true})
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	req, err := c.BuildRequest(http.MethodPatch, "/projects/foo", map[string]string{"name": "bar"})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if req.Method != http.MethodPatch || req.URL.String() != baseURL+"/projects/foo" {
+		t.Errorf("unexpected request: %s %s", req.Method, req.URL)
+	}
+	if v := req.Header.Get("Authorization"); v != "Bearer foo" {
+		t.Errorf("unexpected Authorization header: %s", v)
+	}
+	for i := 0; i < 2; i++ {
+		body, err := req.GetBody()
+		if err != nil {
+			t.Fatal(err)
+		}
+		if b, _ := io.ReadAll(body); string(b) != `{"name":"bar"}` {
+			t.Errorf("unexpected body: %s", b)
+		}
+	}
+	if api.calls != 0 {
+		t.Error("request is not expected to be sent")
+	}
+
+	if _, err := c.BuildRequest(http.MethodGet, "/projects//branches", nil); !errors.Is(err, ErrEmptyPathParameter) {
+		t.Errorf("unexpected error: %v", err)
+	}
+}
@@ -0,0 +1,96 @@
+package sdk
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"sync"
+)
+
+// ErrAmbiguousName the error returned when the name cannot be resolved because multiple resources have it.
+var ErrAmbiguousName = errors.New("multiple resources have the name")
+
+// Resolver resolves the names of the projects and branches to their IDs, and caches the IDs for the session.
+// The branch names which follow the format of the IDs, see ParseResourceID, are returned as is.
+type Resolver struct {
+	client Client
+	orgID  *string
+
+	mu       sync.Mutex
+	projects map[string]string
+	branches map[string]string
+}
+
+// NewResolver initialises the Resolver. The projects are searched in the organization if orgID is set.
+func NewResolver(c Client, orgID *string) *Resolver {
+	return &Resolver{client: c, orgID: orgID, projects: map[string]string{}, branches: map[string]string{}}
+}
+
+// ResolveProject returns the ID of the project with the name, or the ID if nameOrID is the project's ID.
+// The name is looked up first because the names such as "api-v2" follow the format of the IDs.
+// If no project has the name, nameOrID is returned as is when it follows the format of the project's ID.
+// It returns the error wrapping ErrNotFound if no project has the name,
+// and the error wrapping ErrAmbiguousName if multiple projects have the name.
+func (r *Resolver) ResolveProject(ctx context.Context, nameOrID string) (string, error) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	if id, ok := r.projects[nameOrID]; ok {
+		return id, nil
+	}
+
+	projects, err := r.client.listAllProjects(ctx, &nameOrID, r.orgID)
+	if err != nil {
+		return "", err
+	}
+	var ids []string
+	for _, p := range projects {
+		if p.Name == nameOrID {
+			ids = append(ids, p.ID)
+		}
+	}
+	switch {
+	case len(ids) == 0 && IsProjectID(nameOrID):
+		r.projects[nameOrID] = nameOrID
+		return nameOrID, nil
+	case len(ids) == 0:
+		return "", fmt.Errorf("%w: project %s", ErrNotFound, nameOrID)
+	case len(ids) == 1:
+		r.projects[nameOrID] = ids[0]
+		return ids[0], nil
+	default:
+		return "", fmt.Errorf("%w: project %s has the IDs %v", ErrAmbiguousName, nameOrID, ids)
+	}
+}
+
+// ResolveBranch returns the ID of the project's branch with the name, or the ID if nameOrID is the branch's ID.
+// It returns the error wrapping ErrNotFound if no branch has the name.
+func (r *Resolver) ResolveBranch(ctx context.Context, projectID, nameOrID string) (string, error) {
+	if IsBranchID(nameOrID) {
+		return nameOrID, nil
+	}
+
+	k := projectID + "/" + nameOrID
+
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	if id, ok := r.branches[k]; ok {
+		return id, nil
+	}
+
+	b, err := r.client.FindBranchByName(ctx, projectID, nameOrID)
+	if err != nil {
+		return "", err
+	}
+	r.branches[k] = b.ID
+	return b.ID, nil
+}
+
+// Forget removes all IDs from the cache, e.g. after the resources were renamed.
+func (r *Resolver) Forget() {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	r.projects = map[string]string{}
+	r.branches = map[string]string{}
+}
@@ -0,0 +1,98 @@
+package sdk
+
+import (
+	"context"
+	"errors"
+	"net/http"
+	"testing"
+)
+
+func TestResolver_ResolveProject(t *testing.T) {
+	api := newFakeAPI().onFunc(
+		http.MethodGet, "/projects", func(req *http.Request) (int, string) {
+			switch req.URL.Query().Get("search") {
+			case "myapp":
+				return http.StatusOK, `{"projects":[{"id":"bold-cloud-468218","name":"myapp"},` +
+					`{"id":"shiny-wind-028834","name":"myapp-staging"}]}`
+			case "api-v2":
+				return http.StatusOK, `{"projects":[{"id":"floral-king-961888","name":"api-v2"}]}`
+			case "dup":
+				return http.StatusOK, `{"projects":[{"id":"bold-cloud-468218","name":"dup"},` +
+					`{"id":"shiny-wind-028834","name":"dup"}]}`
+			default:
+				return http.StatusOK, `{"projects":[]}`
+			}
+		},
+	)
+	c, err := NewClient(Config{Key: "foo", HTTPClient: api})
+	if err != nil {
+		t.Fatal(err)
+	}
+	r := NewResolver(*c, nil)
+
+	for i := 0; i < 2; i++ {
+		got, err := r.ResolveProject(context.TODO(), "myapp")
+		if err != nil {
+			t.Fatal(err)
+		}
+		if got != "bold-cloud-468218" {
+			t.Errorf("ResolveProject() = %s", got)
+		}
+	}
+	if n := api.called(http.MethodGet, "/projects"); n != 1 {
+		t.Errorf("resolved ID is expected to be cached, projects listed %d times", n)
+	}
+
+	if got, err := r.ResolveProject(context.TODO(), "floral-king-961888"); err != nil || got != "floral-king-961888" {
+		t.Errorf("ID is expected to be returned as is, got %s, error: %v", got, err)
+	}
+	if got, err := r.ResolveProject(context.TODO(), "api-v2"); err != nil || got != "floral-king-961888" {
+		t.Errorf("name following the ID's format is expected to be resolved, got %s, error: %v", got, err)
+	}
+	if _, err := r.ResolveProject(context.TODO(), "dup"); !errors.Is(err, ErrAmbiguousName) {
+		t.Errorf("unexpected error: %v", err)
+	}
+	if _, err := r.ResolveProject(context.TODO(), "missing"); !errors.Is(err, ErrNotFound) {
+		t.Errorf("unexpected error: %v", err)
+	}
+}
+
+func TestResolver_ResolveBranch(t *testing.T) {
+	api := newFakeAPI().on(
+		http.MethodGet, "/projects/foo/branches", http.StatusOK,
+		`{"branches":[{"id":"br-aged-salad-637688","name":"main"},{"id":"br-icy-dream-250089","name":"main-copy"}]}`,
+	)
+	c, err := NewClient(Config{Key: "foo", HTTPClient: api})
+	if err != nil {
+		t.Fatal(err)
+	}
+	r := NewResolver(*c, nil)
+
+	for i := 0; i < 2; i++ {
+		got, err := r.ResolveBranch(context.TODO(), "foo", "main")
+		if err != nil {
+			t.Fatal(err)
+		}
+		if got != "br-aged-salad-637688" {
+			t.Errorf("ResolveBranch() = %s", got)
+		}
+	}
+	if n := api.called(http.MethodGet, "/projects/foo/branches"); n != 1 {
+		t.Errorf("resolved ID is expected to be cached, branches listed %d times", n)
+	}
+
+	r.Forget()
+	if _, err := r.ResolveBranch(context.TODO(), "foo", "main"); err != nil {
+		t.Fatal(err)
+	}
+	if n := api.called(http.MethodGet, "/projects/foo/branches"); n != 2 {
+		t.Errorf("ID is expected to be resolved after the cache is cleared, branches listed %d times", n)
+	}
+
+	if got, _ := r.ResolveBranch(context.TODO(), "foo", "br-raspy-hill-832856"); got != "br-raspy-hill-832856" {
+		t.Errorf("ID is expected to be returned as is, got %s", got)
+	}
+	if _, err := r.ResolveBranch(context.TODO(), "foo", "dev"); !errors.Is(err, ErrNotFound) {
+		t.Errorf("unexpected error: %v", err)
+	}
+}
@@ -0,0 +1,68 @@
+package sdk
+
+import (
+	"errors"
+	"fmt"
+	"regexp"
+	"strings"
+)
+
+// Prefixes of the resources' IDs.
+const (
+	BranchIDPrefix   = "br-"
+	EndpointIDPrefix = "ep-"
+)
+
+// ErrInvalidResourceID the error returned when the string does not follow the format of the resource's ID,
+// e.g. the branch's name is passed instead of its ID.
+var ErrInvalidResourceID = errors.New("invalid resource ID")
+
+// ResourceType the type of the resource identified by the ID.
+type ResourceType string
+
+const (
+	ResourceTypeProject  ResourceType = "project"
+	ResourceTypeBranch   ResourceType = "branch"
+	ResourceTypeEndpoint ResourceType = "endpoint"
+)
+
+// resourceIDBody the ID without the prefix: the hyphen-separated words followed by the suffix containing
+// at least one digit, e.g. "aged-salad-637688".
+var resourceIDBody = regexp.MustCompile(`^[a-z]+(-[a-z]+)*-[a-z0-9]*[0-9][a-z0-9]*$`)
+
+// ParseResourceID returns the type of the resource identified by the ID, e.g. ResourceTypeBranch
+// for "br-aged-salad-637688". Note that the format is checked, not the resource's existence.
+// The returned error wraps ErrInvalidResourceID.
+func ParseResourceID(id string) (ResourceType, error) {
+	t, body := ResourceTypeProject, id
+	switch {
+	case strings.HasPrefix(id, BranchIDPrefix):
+		t, body = ResourceTypeBranch, id[len(BranchIDPrefix):]
+	case strings.HasPrefix(id, EndpointIDPrefix):
+		t, body = ResourceTypeEndpoint, id[len(EndpointIDPrefix):]
+	}
+	if !resourceIDBody.MatchString(body) {
+		return "", fmt.Errorf("%w: %q", ErrInvalidResourceID, id)
+	}
+	return t, nil
+}
+
+// IsProjectID defines if the string follows the format of the project's ID, e.g. "bold-cloud-468218".
+func IsProjectID(id string) bool {
+	return isResourceID(id, ResourceTypeProject)
+}
+
+// IsBranchID defines if the string follows the format of the branch's ID, e.g. "br-aged-salad-637688".
+func IsBranchID(id string) bool {
+	return isResourceID(id, ResourceTypeBranch)
+}
+
+// IsEndpointID defines if the string follows the format of the endpoint's ID, e.g. "ep-little-smoke-851426".
+func IsEndpointID(id string) bool {
+	return isResourceID(id, ResourceTypeEndpoint)
+}
+
+func isResourceID(id string, want ResourceType) bool {
+	t, err := ParseResourceID(id)
+	return err == nil && t == want
+}
@@ -0,0 +1,53 @@
+package sdk
+
+import (
+	"errors"
+	"testing"
+)
+
+func TestParseResourceID(t *testing.T) {
+	tests := []struct {
+		id      string
+		want    ResourceType
+		wantErr bool
+	}{
+		{id: "bold-cloud-468218", want: ResourceTypeProject},
+		{id: "br-aged-salad-637688", want: ResourceTypeBranch},
+		{id: "br-cool-darkness-a1b2c3d4", want: ResourceTypeBranch},
+		{id: "ep-little-smoke-851426", want: ResourceTypeEndpoint},
+		{id: "main", wantErr: true},
+		{id: "feature-login", wantErr: true},
+		{id: "br-main", wantErr: true},
+		{id: "Bold-Cloud-468218", wantErr: true},
+		{id: "468218", wantErr: true},
+		{id: "", wantErr: true},
+	}
+	for _, tt := range tests {
+		t.Run(
+			tt.id, func(t *testing.T) {
+				got, err := ParseResourceID(tt.id)
+				if (err != nil) != tt.wantErr {
+					t.Fatalf("ParseResourceID() error = %v, wantErr %v", err, tt.wantErr)
+				}
+				if err != nil && !errors.Is(err, ErrInvalidResourceID) {
+					t.Errorf("unexpected error: %v", err)
+				}
+				if got != tt.want {
+					t.Errorf("ParseResourceID() = %v, want %v", got, tt.want)
+				}
+			},
+		)
+	}
+}
+
+func TestIsBranchID(t *testing.T) {
+	if !IsBranchID("br-aged-salad-637688") || IsBranchID("aged-salad-637688") || IsBranchID("dev") {
+		t.Error("unexpected branch ID check")
+	}
+	if !IsEndpointID("ep-little-smoke-851426") || IsEndpointID("br-aged-salad-637688") {
+		t.Error("unexpected endpoint ID check")
+	}
+	if !IsProjectID("bold-cloud-468218") || IsProjectID("ep-little-smoke-851426") {
+		t.Error("unexpected project ID check")
+	}
+}
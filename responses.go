@@ -0,0 +1,43 @@
+package sdk
+
+// FirstConnectionURI returns the first connection URI, and false if the response contains none.
+func (r ConnectionURIsResponse) FirstConnectionURI() (string, bool) {
+	if len(r.ConnectionURIs) == 0 {
+		return "", false
+	}
+	return r.ConnectionURIs[0].ConnectionURI, true
+}
+
+// FirstConnectionURI returns the first connection URI, and false if the response contains none.
+// Note that the connection URI is omitted when the branch is created from the parent
+// with more than one role, or database.
+func (r ConnectionURIsOptionalResponse) FirstConnectionURI() (string, bool) {
+	if r.ConnectionURIs == nil || len(*r.ConnectionURIs) == 0 {
+		return "", false
+	}
+	return (*r.ConnectionURIs)[0].ConnectionURI, true
+}
+
+// FirstDatabase returns the first database, and false if the response contains none.
+func (r DatabasesResponse) FirstDatabase() (Database, bool) {
+	if len(r.Databases) == 0 {
+		return Database{}, false
+	}
+	return r.Databases[0], true
+}
+
+// FirstEndpoint returns the first compute endpoint, and false if the response contains none.
+func (r EndpointsResponse) FirstEndpoint() (Endpoint, bool) {
+	if len(r.Endpoints) == 0 {
+		return Endpoint{}, false
+	}
+	return r.Endpoints[0], true
+}
+
+// FirstRole returns the first role, and false if the response contains none.
+func (r RolesResponse) FirstRole() (Role, bool) {
+	if len(r.Roles) == 0 {
+		return Role{}, false
+	}
+	return r.Roles[0], true
+}
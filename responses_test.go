@@ -0,0 +1,80 @@
+package sdk
+
+import (
+	"encoding/json"
+	"testing"
+)
+
+func TestCreatedProject_accessors(t *testing.T) {
+	tests := []struct {
+		name   string
+		body   string
+		wantOK bool
+	}{
+		{
+			name: "all members present",
+			body: `{"connection_uris":[{"connection_uri":"postgresql://foo"}],"databases":[{"name":"neondb"}],
+"endpoints":[{"id":"ep-foo"}],"roles":[{"name":"foo"}]}`,
+			wantOK: true,
+		},
+		{
+			name:   "members absent",
+			body:   `{"connection_uris":[],"databases":[]}`,
+			wantOK: false,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(
+			tt.name, func(t *testing.T) {
+				var v CreatedProject
+				if err := json.Unmarshal([]byte(tt.body), &v); err != nil {
+					t.Fatal(err)
+				}
+				if uri, ok := v.FirstConnectionURI(); ok != tt.wantOK || (ok && uri != "postgresql://foo") {
+					t.Errorf("FirstConnectionURI() = %v, %v", uri, ok)
+				}
+				if db, ok := v.FirstDatabase(); ok != tt.wantOK || (ok && db.Name != "neondb") {
+					t.Errorf("FirstDatabase() = %v, %v", db, ok)
+				}
+				if ep, ok := v.FirstEndpoint(); ok != tt.wantOK || (ok && ep.ID != "ep-foo") {
+					t.Errorf("FirstEndpoint() = %v, %v", ep, ok)
+				}
+				if role, ok := v.FirstRole(); ok != tt.wantOK || (ok && role.Name != "foo") {
+					t.Errorf("FirstRole() = %v, %v", role, ok)
+				}
+			},
+		)
+	}
+}
+
+func TestCreatedBranch_FirstConnectionURI(t *testing.T) {
+	tests := []struct {
+		name    string
+		body    string
+		wantURI string
+		wantOK  bool
+	}{
+		{
+			name:    "connection URI present",
+			body:    `{"connection_uris":[{"connection_uri":"postgresql://foo"}]}`,
+			wantURI: "postgresql://foo",
+			wantOK:  true,
+		},
+		{name: "connection URIs omitted", body: `{}`},
+		{name: "connection URIs empty", body: `{"connection_uris":[]}`},
+	}
+	for _, tt := range tests {
+		t.Run(
+			tt.name, func(t *testing.T) {
+				var v CreatedBranch
+				if err := json.Unmarshal([]byte(tt.body), &v); err != nil {
+					t.Fatal(err)
+				}
+				got, ok := v.FirstConnectionURI()
+				if got != tt.wantURI || ok != tt.wantOK {
+					t.Errorf("FirstConnectionURI() = %v, %v, want %v, %v", got, ok, tt.wantURI, tt.wantOK)
+				}
+			},
+		)
+	}
+}
@@ -0,0 +1,121 @@
+package sdk
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"net/http"
+	"sync"
+)
+
+// ErrPasswordNotStored the error returned when the role's password cannot be revealed
+// because the project does not store passwords.
+var ErrPasswordNotStored = errors.New("role password is not stored")
+
+// RolePasswords reveals the roles' passwords and caches them for the session.
+type RolePasswords struct {
+	client           Client
+	resetIfNotStored bool
+
+	mu    sync.Mutex
+	cache map[string]string
+	// locks serialise the reveal of the same role's password, hence it is reset once
+	locks map[string]*sync.Mutex
+}
+
+// NewRolePasswords initialises the RolePasswords. If resetIfNotStored is set, the role's password is reset
+// when it cannot be revealed because the project does not store passwords, i.e. store_passwords is false.
+// Note that the reset invalidates the password used by the existing clients.
+func NewRolePasswords(c Client, resetIfNotStored bool) *RolePasswords {
+	return &RolePasswords{
+		client: c, resetIfNotStored: resetIfNotStored, cache: map[string]string{}, locks: map[string]*sync.Mutex{},
+	}
+}
+
+func rolePasswordKey(projectID, branchID, roleName string) string {
+	return projectID + "/" + branchID + "/" + roleName
+}
+
+// Get returns the role's password from the cache, or reveals it. The concurrent calls for the same role
+// await the first one, the calls for other roles are not blocked.
+// It returns the error wrapping ErrPasswordNotStored if the password cannot be revealed and reset is not allowed.
+func (r *RolePasswords) Get(ctx context.Context, projectID, branchID, roleName string) (string, error) {
+	k := rolePasswordKey(projectID, branchID, roleName)
+
+	v, ok, lock := r.cached(k)
+	if ok {
+		return v, nil
+	}
+
+	lock.Lock()
+	defer lock.Unlock()
+
+	// the password could be revealed by the concurrent call
+	if v, ok, _ := r.cached(k); ok {
+		return v, nil
+	}
+
+	v, err := r.reveal(ctx, projectID, branchID, roleName)
+	if err != nil {
+		return "", err
+	}
+
+	r.mu.Lock()
+	r.cache[k] = v
+	r.mu.Unlock()
+	return v, nil
+}
+
+// cached returns the cached password, and the lock of the key to reveal the password if it is not cached.
+func (r *RolePasswords) cached(k string) (string, bool, *sync.Mutex) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	if v, ok := r.cache[k]; ok {
+		return v, true, nil
+	}
+	lock, ok := r.locks[k]
+	if !ok {
+		lock = &sync.Mutex{}
+		r.locks[k] = lock
+	}
+	return "", false, lock
+}
+
+// reveal reads the role's password, or resets it if the project does not store passwords and reset is allowed.
+func (r *RolePasswords) reveal(ctx context.Context, projectID, branchID, roleName string) (string, error) {
+	resp, err := r.client.GetProjectBranchRolePassword(projectID, branchID, roleName)
+	switch {
+	case err == nil:
+		return resp.Password, nil
+	case !isPasswordNotStored(err):
+		return "", fmt.Errorf("could not reveal password of role %s: %w", roleName, err)
+	case !r.resetIfNotStored:
+		return "", fmt.Errorf("%w: role %s of project %s", ErrPasswordNotStored, roleName, projectID)
+	}
+
+	reset, err := r.client.ResetProjectBranchRolePassword(projectID, branchID, roleName)
+	if err != nil {
+		return "", fmt.Errorf("could not reset password of role %s: %w", roleName, err)
+	}
+	if err := r.client.WaitForOperations(ctx, projectID, reset.Operations); err != nil {
+		return "", fmt.Errorf("could not reset password of role %s: %w", roleName, err)
+	}
+	if reset.Role.Password == nil {
+		return "", fmt.Errorf("password of role %s is missing in the reset response", roleName)
+	}
+	return *reset.Role.Password, nil
+}
+
+// Forget removes the role's password from the cache.
+func (r *RolePasswords) Forget(projectID, branchID, roleName string) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	delete(r.cache, rolePasswordKey(projectID, branchID, roleName))
+}
+
+// isPasswordNotStored defines if the error is the API error caused by disabled storing of passwords.
+func isPasswordNotStored(err error) bool {
+	var e Error
+	return errors.As(err, &e) && e.HTTPCode == http.StatusPreconditionFailed
+}
@@ -0,0 +1,150 @@
+package sdk
+
+import (
+	"context"
+	"errors"
+	"net/http"
+	"sync"
+	"testing"
+)
+
+func TestRolePasswords_Get(t *testing.T) {
+	const (
+		revealPath = "/projects/foo/branches/br-foo/roles/bar/reveal_password"
+		resetPath  = "/projects/foo/branches/br-foo/roles/bar/reset_password"
+	)
+
+	tests := []struct {
+		name             string
+		api              *fakeAPI
+		resetIfNotStored bool
+		want             string
+		wantErr          error
+		wantResetCalls   int
+	}{
+		{
+			name: "password revealed",
+			api:  newFakeAPI().on(http.MethodGet, revealPath, http.StatusOK, `{"password":"qux"}`),
+			want: "qux",
+		},
+		{
+			name: "password not stored",
+			api: newFakeAPI().on(
+				http.MethodGet, revealPath, http.StatusPreconditionFailed, `{"message":"storing passwords is disabled"}`,
+			),
+			wantErr: ErrPasswordNotStored,
+		},
+		{
+			name: "password not stored, reset",
+			api: newFakeAPI().
+				on(
+					http.MethodGet, revealPath, http.StatusPreconditionFailed,
+					`{"message":"storing passwords is disabled"}`,
+				).
+				on(
+					http.MethodPost, resetPath, http.StatusOK,
+					`{"role":{"name":"bar","password":"quux"},"operations":[{"id":"op1","status":"finished"}]}`,
+				),
+			resetIfNotStored: true,
+			want:             "quux",
+			wantResetCalls:   1,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(
+			tt.name, func(t *testing.T) {
+				c, err := NewClient(Config{Key: "foo", HTTPClient: tt.api})
+				if err != nil {
+					t.Fatal(err)
+				}
+				r := NewRolePasswords(*c, tt.resetIfNotStored)
+
+				for i := 0; i < 2; i++ {
+					got, err := r.Get(context.TODO(), "foo", "br-foo", "bar")
+					if !errors.Is(err, tt.wantErr) {
+						t.Fatalf("Get() error = %v, wantErr %v", err, tt.wantErr)
+					}
+					if got != tt.want {
+						t.Errorf("Get() got = %v, want %v", got, tt.want)
+					}
+				}
+
+				if tt.wantErr == nil {
+					if n := tt.api.called(http.MethodGet, revealPath); n != 1 {
+						t.Errorf("password shall be cached, got %d reveal calls", n)
+					}
+				}
+				if n := tt.api.called(http.MethodPost, resetPath); n != tt.wantResetCalls {
+					t.Errorf("unexpected number of reset calls: %d", n)
+				}
+			},
+		)
+	}
+}
+
+func TestRolePasswords_Forget(t *testing.T) {
+	const revealPath = "/projects/foo/branches/br-foo/roles/bar/reveal_password"
+
+	api := newFakeAPI().on(http.MethodGet, revealPath, http.StatusOK, `{"password":"qux"}`)
+	c, err := NewClient(Config{Key: "foo", HTTPClient: api})
+	if err != nil {
+		t.Fatal(err)
+	}
+	r := NewRolePasswords(*c, false)
+
+	for i := 0; i < 2; i++ {
+		if _, err := r.Get(context.TODO(), "foo", "br-foo", "bar"); err != nil {
+			t.Fatal(err)
+		}
+		r.Forget("foo", "br-foo", "bar")
+	}
+	if n := api.called(http.MethodGet, revealPath); n != 2 {
+		t.Errorf("unexpected number of reveal calls: %d", n)
+	}
+}
+
+func TestRolePasswords_Get_concurrent(t *testing.T) {
+	const slowPath = "/projects/slow/branches/br-foo/roles/bar/reveal_password"
+
+	var (
+		started = make(chan struct{})
+		release = make(chan struct{})
+	)
+	api := newFakeAPI().
+		onFunc(
+			http.MethodGet, slowPath, func(*http.Request) (int, string) {
+				close(started)
+				<-release
+				return http.StatusOK, `{"password":"slow"}`
+			},
+		).
+		on(http.MethodGet, "/projects/foo/branches/br-foo/roles/bar/reveal_password", http.StatusOK, `{"password":"qux"}`)
+	c, err := NewClient(Config{Key: "foo", HTTPClient: api})
+	if err != nil {
+		t.Fatal(err)
+	}
+	r := NewRolePasswords(*c, false)
+
+	var wg sync.WaitGroup
+	wg.Add(2)
+	for i := 0; i < 2; i++ {
+		go func() {
+			defer wg.Done()
+			if got, err := r.Get(context.TODO(), "slow", "br-foo", "bar"); err != nil || got != "slow" {
+				t.Errorf("Get() got = %v, error = %v", got, err)
+			}
+		}()
+	}
+	<-started
+
+	// the other role's password is revealed while the slow one is pending
+	if got, err := r.Get(context.TODO(), "foo", "br-foo", "bar"); err != nil || got != "qux" {
+		t.Errorf("Get() got = %v, error = %v", got, err)
+	}
+
+	close(release)
+	wg.Wait()
+	if n := api.called(http.MethodGet, slowPath); n != 1 {
+		t.Errorf("password is expected to be revealed once, got %d reveal calls", n)
+	}
+}
@@ -0,0 +1,104 @@
+package sdk
+
+import (
+	"context"
+	"errors"
+	"fmt"
+)
+
+// RoleCredentials the role's credentials.
+type RoleCredentials struct {
+	ProjectID string
+	BranchID  string
+	RoleName  string
+	Password  string
+}
+
+// SecretWriter stores the role's credentials, e.g. in the secrets manager.
+type SecretWriter interface {
+	WriteSecret(ctx context.Context, v RoleCredentials) error
+}
+
+// SecretWriterFunc the function implementing SecretWriter.
+type SecretWriterFunc func(ctx context.Context, v RoleCredentials) error
+
+// WriteSecret calls f(ctx, v).
+func (f SecretWriterFunc) WriteSecret(ctx context.Context, v RoleCredentials) error {
+	return f(ctx, v)
+}
+
+// RoleFilter defines if the branch's role is selected.
+type RoleFilter func(b Branch, r Role) bool
+
+// RotateRolePasswordsOptions defines how the passwords are rotated.
+type RotateRolePasswordsOptions struct {
+	// Writer stores the new credentials, it is required.
+	Writer SecretWriter
+	// Parallelism the maximum number of roles processed concurrently, the default is used if it is not positive.
+	Parallelism int
+}
+
+// RotateAllRolePasswords resets the passwords of the roles of all project's branches selected by the filter,
+// all roles are selected if the filter is nil. The system-protected roles are skipped. The new credentials
+// are written by the options' Writer as soon as the reset is accepted, i.e. before the operations complete,
+// hence the new password is not lost if awaiting fails. The failures of the roles are aggregated into *BatchError
+// keyed by "{{branch ID}}/{{role name}}", i.e. the failure of a role does not stop the rotation of the other roles.
+func (c Client) RotateAllRolePasswords(
+	ctx context.Context, projectID string, filter RoleFilter, opts RotateRolePasswordsOptions,
+) error {
+	if opts.Writer == nil {
+		return errors.New("secret writer must be set")
+	}
+	if err := ctx.Err(); err != nil {
+		return err
+	}
+
+	branches, err := c.ListProjectBranches(projectID, nil)
+	if err != nil {
+		return fmt.Errorf("could not list branches of project %s: %w", projectID, err)
+	}
+
+	var roles []RoleCredentials
+	for _, b := range branches.Branches {
+		resp, err := c.ListProjectBranchRoles(projectID, b.ID)
+		if err != nil {
+			return fmt.Errorf("could not list roles of branch %s: %w", b.ID, err)
+		}
+		for _, r := range resp.Roles {
+			if r.Protected != nil && *r.Protected {
+				continue
+			}
+			if filter == nil || filter(b, r) {
+				roles = append(roles, RoleCredentials{ProjectID: projectID, BranchID: b.ID, RoleName: r.Name})
+			}
+		}
+	}
+
+	return runBatch(
+		ctx, roles, BatchOptions{Parallelism: opts.Parallelism, ContinueOnError: true},
+		func(v RoleCredentials) string { return v.BranchID + "/" + v.RoleName },
+		func(ctx context.Context, v RoleCredentials) error {
+			return c.rotateRolePassword(ctx, v, opts.Writer)
+		},
+	)
+}
+
+func (c Client) rotateRolePassword(ctx context.Context, v RoleCredentials, w SecretWriter) error {
+	resp, err := callUnlessLocked(
+		ctx, func() (RoleOperations, error) {
+			return c.ResetProjectBranchRolePassword(v.ProjectID, v.BranchID, v.RoleName)
+		},
+	)
+	if err != nil {
+		return fmt.Errorf("could not reset password of role %s: %w", v.RoleName, err)
+	}
+	if resp.Role.Password == nil {
+		return fmt.Errorf("password of role %s is missing in the reset response", v.RoleName)
+	}
+
+	v.Password = *resp.Role.Password
+	if err := w.WriteSecret(ctx, v); err != nil {
+		return fmt.Errorf("could not write password of role %s: %w", v.RoleName, err)
+	}
+	return c.WaitForOperations(ctx, v.ProjectID, resp.Operations)
+}
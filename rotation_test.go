@@ -0,0 +1,82 @@
+package sdk
+
+import (
+	"context"
+	"errors"
+	"net/http"
+	"reflect"
+	"sync"
+	"testing"
+)
+
+func TestClient_RotateAllRolePasswords(t *testing.T) {
+	api := newFakeAPI().
+		on(
+			http.MethodGet, "/projects/foo/branches", http.StatusOK,
+			`{"branches":[{"id":"br-main","name":"main"},{"id":"br-dev","name":"dev"}]}`,
+		).
+		on(
+			http.MethodGet, "/projects/foo/branches/br-main/roles", http.StatusOK,
+			`{"roles":[{"name":"app"},{"name":"readonly"},{"name":"neon_superuser","protected":true}]}`,
+		).
+		on(
+			http.MethodGet, "/projects/foo/branches/br-dev/roles", http.StatusOK,
+			`{"roles":[{"name":"app"},{"name":"readonly"}]}`,
+		).
+		on(
+			http.MethodPost, "/projects/foo/branches/br-main/roles/app/reset_password", http.StatusOK,
+			`{"role":{"name":"app","password":"new-main"},"operations":[]}`,
+		).
+		on(
+			http.MethodPost, "/projects/foo/branches/br-dev/roles/app/reset_password", http.StatusInternalServerError,
+			`{"code":"","message":"foo"}`,
+		)
+
+	c, err := NewClient(Config{Key: "foo", HTTPClient: api})
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	var (
+		mu  sync.Mutex
+		got []RoleCredentials
+	)
+	err = c.RotateAllRolePasswords(
+		context.TODO(), "foo",
+		func(_ Branch, r Role) bool { return r.Name != "readonly" },
+		RotateRolePasswordsOptions{
+			Writer: SecretWriterFunc(
+				func(_ context.Context, v RoleCredentials) error {
+					mu.Lock()
+					defer mu.Unlock()
+					got = append(got, v)
+					return nil
+				},
+			),
+			Parallelism: 2,
+		},
+	)
+
+	var e *BatchError
+	if !errors.As(err, &e) || len(e.Errors) != 1 || e.Errors["br-dev/app"] == nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	want := []RoleCredentials{{ProjectID: "foo", BranchID: "br-main", RoleName: "app", Password: "new-main"}}
+	if !reflect.DeepEqual(got, want) {
+		t.Errorf("unexpected credentials written: %+v, want %+v", got, want)
+	}
+	if n := api.called(http.MethodPost, "/projects/foo/branches/br-main/roles/neon_superuser/reset_password"); n != 0 {
+		t.Errorf("password of the protected role is not expected to be reset")
+	}
+}
+
+func TestClient_RotateAllRolePasswords_noWriter(t *testing.T) {
+	c, err := NewClient(Config{Key: "foo", HTTPClient: newFakeAPI()})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if err := c.RotateAllRolePasswords(context.TODO(), "foo", nil, RotateRolePasswordsOptions{}); err == nil {
+		t.Error("error is expected")
+	}
+}
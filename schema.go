@@ -0,0 +1,84 @@
+package sdk
+
+import (
+	"context"
+	"fmt"
+	"net/url"
+	"os"
+	"path/filepath"
+	"strings"
+	"sync"
+)
+
+// DumpBranchSchemas fetches the schemas of all branch's databases concurrently.
+// It returns the map of the database name to its schema SQL.
+// The parallelism defines the maximum number of concurrent requests, the default is used if it is not positive.
+func (c Client) DumpBranchSchemas(
+	ctx context.Context, projectID, branchID string, parallelism int,
+) (map[string]string, error) {
+	dbs, err := c.ListProjectBranchDatabases(projectID, branchID)
+	if err != nil {
+		return nil, fmt.Errorf("could not list databases of branch %s: %w", branchID, err)
+	}
+
+	var (
+		mu sync.Mutex
+		o  = make(map[string]string, len(dbs.Databases))
+	)
+	if err := forEach(
+		ctx, len(dbs.Databases), parallelism, func(_ context.Context, i int) error {
+			dbName := dbs.Databases[i].Name
+			resp, err := c.GetProjectBranchSchema(projectID, branchID, dbName, nil, nil)
+			if err != nil {
+				return fmt.Errorf("could not fetch schema of database %s: %w", dbName, err)
+			}
+
+			var sql string
+			if resp.Sql != nil {
+				sql = *resp.Sql
+			}
+
+			mu.Lock()
+			o[dbName] = sql
+			mu.Unlock()
+			return nil
+		},
+	); err != nil {
+		return nil, err
+	}
+
+	return o, nil
+}
+
+// DumpBranchSchemasToDir fetches the schemas of all branch's databases concurrently,
+// and writes every schema to the file {{dir}}/{{database name}}.sql. The database name is escaped
+// to be the file name as the URL path segment, and its leading dot is escaped as "%2E",
+// i.e. it can be restored with url.PathUnescape.
+func (c Client) DumpBranchSchemasToDir(ctx context.Context, projectID, branchID, dir string, parallelism int) error {
+	schemas, err := c.DumpBranchSchemas(ctx, projectID, branchID, parallelism)
+	if err != nil {
+		return err
+	}
+
+	if err := os.MkdirAll(dir, 0o755); err != nil {
+		return err
+	}
+
+	for dbName, sql := range schemas {
+		p := filepath.Join(dir, schemaFileName(dbName))
+		if err := os.WriteFile(p, []byte(sql), 0o644); err != nil {
+			return fmt.Errorf("could not write schema of database %s: %w", dbName, err)
+		}
+	}
+
+	return nil
+}
+
+// schemaFileName returns the name of the file to store the database's schema.
+func schemaFileName(dbName string) string {
+	name := url.PathEscape(dbName)
+	if strings.HasPrefix(name, ".") {
+		name = "%2E" + name[1:]
+	}
+	return name + ".sql"
+}
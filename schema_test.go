@@ -0,0 +1,125 @@
+package sdk
+
+import (
+	"context"
+	"net/http"
+	"net/url"
+	"os"
+	"path/filepath"
+	"reflect"
+	"strings"
+	"testing"
+)
+
+func newSchemaFakeAPI() *fakeAPI {
+	return newFakeAPI().
+		on(
+			http.MethodGet, "/projects/foo/branches/br-foo/databases", http.StatusOK,
+			`{"databases":[{"id":1,"name":"main"},{"id":2,"name":"mydb"}]}`,
+		).
+		onFunc(
+			http.MethodGet, "/projects/foo/branches/br-foo/schema", func(req *http.Request) (int, string) {
+				return http.StatusOK, `{"sql":"-- ` + req.URL.Query().Get("db_name") + `"}`
+			},
+		)
+}
+
+func TestClient_DumpBranchSchemas(t *testing.T) {
+	tests := []struct {
+		name     string
+		api      *fakeAPI
+		branchID string
+		want     map[string]string
+		wantErr  bool
+	}{
+		{
+			name:     "happy path",
+			api:      newSchemaFakeAPI(),
+			branchID: "br-foo",
+			want: map[string]string{
+				"main": "-- main",
+				"mydb": "-- mydb",
+			},
+		},
+		{
+			name: "unhappy path: schema cannot be fetched",
+			api: newSchemaFakeAPI().on(
+				http.MethodGet, "/projects/foo/branches/br-foo/schema", http.StatusInternalServerError, `{}`,
+			),
+			branchID: "br-foo",
+			wantErr:  true,
+		},
+		{
+			name:     "unhappy path: branch not found",
+			api:      newSchemaFakeAPI(),
+			branchID: "br-bar",
+			wantErr:  true,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(
+			tt.name, func(t *testing.T) {
+				c, err := NewClient(Config{Key: "foo", HTTPClient: tt.api})
+				if err != nil {
+					t.Fatal(err)
+				}
+
+				got, err := c.DumpBranchSchemas(context.TODO(), "foo", tt.branchID, 2)
+				if (err != nil) != tt.wantErr {
+					t.Fatalf("DumpBranchSchemas() error = %v, wantErr %v", err, tt.wantErr)
+				}
+				if !reflect.DeepEqual(got, tt.want) {
+					t.Errorf("DumpBranchSchemas() got = %v, want %v", got, tt.want)
+				}
+			},
+		)
+	}
+}
+
+func TestClient_DumpBranchSchemasToDir(t *testing.T) {
+	c, err := NewClient(Config{Key: "foo", HTTPClient: newSchemaFakeAPI()})
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	dir := t.TempDir()
+	if err := c.DumpBranchSchemasToDir(context.TODO(), "foo", "br-foo", dir, 0); err != nil {
+		t.Fatal(err)
+	}
+
+	got, err := os.ReadFile(filepath.Join(dir, "mydb.sql"))
+	if err != nil {
+		t.Fatal(err)
+	}
+	if string(got) != "-- mydb" {
+		t.Errorf("unexpected schema: %s", got)
+	}
+}
+
+func Test_schemaFileName(t *testing.T) {
+	tests := []struct {
+		dbName string
+		want   string
+	}{
+		{dbName: "mydb", want: "mydb.sql"},
+		{dbName: "a/x", want: "a%2Fx.sql"},
+		{dbName: "b/x", want: "b%2Fx.sql"},
+		{dbName: "a%2Fx", want: "a%252Fx.sql"},
+		{dbName: ".", want: "%2E.sql"},
+		{dbName: "..", want: "%2E..sql"},
+		{dbName: "/", want: "%2F.sql"},
+	}
+	for _, tt := range tests {
+		t.Run(
+			tt.dbName, func(t *testing.T) {
+				got := schemaFileName(tt.dbName)
+				if got != tt.want {
+					t.Errorf("schemaFileName() = %v, want %v", got, tt.want)
+				}
+				if name, err := url.PathUnescape(strings.TrimSuffix(got, ".sql")); err != nil || name != tt.dbName {
+					t.Errorf("file name %s cannot be unescaped to %s: %v", got, tt.dbName, err)
+				}
+			},
+		)
+	}
+}
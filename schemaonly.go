@@ -0,0 +1,96 @@
+package sdk
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"net/http"
+	"strings"
+)
+
+// SchemaInitializationTypeEmpty the schema initialization type of the branch created with the parent's schema,
+// but without the parent's data.
+const SchemaInitializationTypeEmpty = "empty"
+
+// ErrSchemaOnlyBranchNotSupported the error returned when the API rejects the schema-only branch,
+// e.g. because the feature is not available on the project's plan.
+var ErrSchemaOnlyBranchNotSupported = errors.New("schema-only branch is not supported")
+
+// CreateSchemaOnlyBranch creates the branch with the parent branch's schema, but without its data,
+// e.g. to develop against the production schema without exposing the production data.
+// The branch is created with the read-write compute endpoint, and it is returned when it is ready for querying.
+// The plan support is validated by the API: the rejection is returned as the error matching
+// ErrSchemaOnlyBranchNotSupported with errors.Is, and unwrapping to the API error. Note that the feature is under active development by Neon.
+func (c Client) CreateSchemaOnlyBranch(ctx context.Context, projectID, parentID string) (CreatedBranch, error) {
+	if err := ctx.Err(); err != nil {
+		return CreatedBranch{}, err
+	}
+
+	schemaInitializationType := SchemaInitializationTypeEmpty
+	resp, err := callUnlessLocked(
+		ctx, func() (CreatedBranch, error) {
+			return c.CreateProjectBranch(
+				projectID, &CreateProjectBranchReqObj{
+					BranchCreateRequest: BranchCreateRequest{
+						Branch: &BranchCreateRequestBranch{
+							ParentID:                 &parentID,
+							SchemaInitializationType: &schemaInitializationType,
+						},
+						Endpoints: &[]BranchCreateRequestEndpointOptions{{Type: EndpointTypeReadWrite}},
+					},
+				},
+			)
+		},
+	)
+	switch {
+	case isSchemaOnlyBranchRejected(err):
+		return CreatedBranch{}, &schemaOnlyBranchError{projectID: projectID, err: err}
+	case err != nil:
+		return CreatedBranch{}, fmt.Errorf("could not create schema-only branch in project %s: %w", projectID, err)
+	}
+
+	if err := c.WaitForOperations(ctx, projectID, resp.Operations); err != nil {
+		return resp, err
+	}
+	b, err := c.WaitForBranchState(ctx, projectID, resp.Branch.ID, BranchStateReady)
+	if err != nil {
+		return resp, err
+	}
+	resp.Branch = b
+	return resp, nil
+}
+
+type schemaOnlyBranchError struct {
+	projectID string
+	err       error
+}
+
+func (e *schemaOnlyBranchError) Error() string {
+	return ErrSchemaOnlyBranchNotSupported.Error() + ": project " + e.projectID + ": " + e.err.Error()
+}
+
+func (e *schemaOnlyBranchError) Is(target error) bool {
+	return target == ErrSchemaOnlyBranchNotSupported
+}
+
+func (e *schemaOnlyBranchError) Unwrap() error {
+	return e.err
+}
+
+// isSchemaOnlyBranchRejected defines if the API error is caused by the lack of the schema-only branches' support:
+// the payment is required, or the request is rejected because of the schema initialization type.
+func isSchemaOnlyBranchRejected(err error) bool {
+	var e Error
+	if !errors.As(err, &e) {
+		return false
+	}
+	switch e.HTTPCode {
+	case http.StatusPaymentRequired:
+		return true
+	case http.StatusBadRequest, http.StatusForbidden, http.StatusUnprocessableEntity:
+		msg := strings.ToLower(e.Message)
+		return strings.Contains(msg, "schema_initialization_type") || strings.Contains(msg, "schema-only")
+	default:
+		return false
+	}
+}
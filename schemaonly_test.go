@@ -0,0 +1,109 @@
+package sdk
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"io"
+	"net/http"
+	"testing"
+)
+
+func TestClient_CreateSchemaOnlyBranch(t *testing.T) {
+	tests := []struct {
+		name     string
+		code     int
+		resp     string
+		wantErr  error
+		wantFail bool
+	}{
+		{
+			name: "created",
+			code: http.StatusCreated,
+			resp: `{"branch":{"id":"br-bar","current_state":"init","pending_state":"ready"},"operations":[]}`,
+		},
+		{
+			name:    "not supported",
+			code:    http.StatusBadRequest,
+			resp:    `{"code":"","message":"schema_initialization_type is not available on your plan"}`,
+			wantErr: ErrSchemaOnlyBranchNotSupported,
+		},
+		{
+			name:    "payment required",
+			code:    http.StatusPaymentRequired,
+			resp:    `{"code":"","message":"upgrade your plan"}`,
+			wantErr: ErrSchemaOnlyBranchNotSupported,
+		},
+		{
+			name:    "forbidden schema-only branch",
+			code:    http.StatusForbidden,
+			resp:    `{"code":"","message":"schema-only branches are not available"}`,
+			wantErr: ErrSchemaOnlyBranchNotSupported,
+		},
+		{
+			name:     "forbidden",
+			code:     http.StatusForbidden,
+			resp:     `{"code":"","message":"permission denied"}`,
+			wantFail: true,
+		},
+		{
+			name:     "failed",
+			code:     http.StatusInternalServerError,
+			resp:     `{"code":"","message":"internal error"}`,
+			wantFail: true,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(
+			tt.name, func(t *testing.T) {
+				api := newFakeAPI().
+					onFunc(
+						http.MethodPost, "/projects/foo/branches", func(req *http.Request) (int, string) {
+							var v CreateProjectBranchReqObj
+							b, _ := io.ReadAll(req.Body)
+							if err := json.Unmarshal(b, &v); err != nil || v.Branch == nil ||
+								v.Branch.SchemaInitializationType == nil ||
+								*v.Branch.SchemaInitializationType != SchemaInitializationTypeEmpty ||
+								v.Branch.ParentID == nil || *v.Branch.ParentID != "br-main" {
+								return http.StatusBadRequest, `{"code":"","message":"unexpected request"}`
+							}
+							return tt.code, tt.resp
+						},
+					).
+					on(
+						http.MethodGet, "/projects/foo/branches/br-bar", http.StatusOK,
+						`{"branch":{"id":"br-bar","current_state":"ready"}}`,
+					)
+
+				c, err := NewClient(Config{Key: "foo", HTTPClient: api})
+				if err != nil {
+					t.Fatal(err)
+				}
+
+				got, err := c.CreateSchemaOnlyBranch(context.TODO(), "foo", "br-main")
+				switch {
+				case tt.wantErr != nil:
+					if !errors.Is(err, tt.wantErr) {
+						t.Fatalf("CreateSchemaOnlyBranch() error = %v, want %v", err, tt.wantErr)
+					}
+					var e Error
+					if !errors.As(err, &e) || e.HTTPCode != tt.code {
+						t.Errorf("CreateSchemaOnlyBranch() error = %v, want API error", err)
+					}
+					return
+				case tt.wantFail:
+					if err == nil || errors.Is(err, ErrSchemaOnlyBranchNotSupported) {
+						t.Fatalf("CreateSchemaOnlyBranch() unexpected error = %v", err)
+					}
+					return
+				case err != nil:
+					t.Fatal(err)
+				}
+
+				if got.Branch.ID != "br-bar" || got.Branch.CurrentState != BranchStateReady {
+					t.Errorf("unexpected branch: %+v", got.Branch)
+				}
+			},
+		)
+	}
+}
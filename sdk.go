@@ -2,11 +2,11 @@ package sdk
 
 import (
 	"bytes"
-	"encoding/json"
+	"crypto/tls"
 	"errors"
 	"io"
 	"net/http"
-	"reflect"
+	"net/url"
 	"strconv"
 	"strings"
 	"time"
@@ -14,11 +14,14 @@ import (
 
 // NewClient initialised the Client to communicate to the Neon Platform.
 func NewClient(cfg Config) (*Client, error) {
-	if _, ok := (cfg.HTTPClient).(mockHTTPClient); !ok && cfg.Key == "" {
+	if _, ok := (cfg.HTTPClient).(mockHTTPClient); !ok && cfg.Key == "" && cfg.Authenticator == nil {
 		return nil, errors.New(
 			"authorization key must be provided: https://neon.tech/docs/reference/api-reference/#authentication",
 		)
 	}
+	if cfg.Key != "" && cfg.Authenticator != nil {
+		return nil, errors.New("Key and Authenticator cannot be used together")
+	}
 
 	c := &Client{
 		baseURL: baseURL,
@@ -26,7 +29,9 @@ func NewClient(cfg Config) (*Client, error) {
 	}
 
 	if c.cfg.HTTPClient == nil {
-		c.cfg.HTTPClient = &http.Client{Timeout: defaultTimeout}
+		c.cfg.HTTPClient = &http.Client{Timeout: defaultTimeout, Transport: c.cfg.transport()}
+	} else if c.cfg.TLSConfig != nil || c.cfg.ProxyURL != nil || c.cfg.ConcurrencyLimit != nil {
+		return nil, errors.New("TLSConfig, ProxyURL and ConcurrencyLimit cannot be used with the custom HTTPClient")
 	}
 
 	return c, nil
@@ -37,8 +42,77 @@ type Config struct {
 	// Key defines the access API key.
 	Key string
 
+	// Authenticator sets the requests' credentials instead of the Key when set, e.g. to use the token
+	// exchanged for the marketplace-managed identity. It cannot be used with the Key.
+	Authenticator Authenticator
+
 	// HTTPClient HTTP client to communicate with the API.
 	HTTPClient HTTPClient
+
+	// DestructiveGuard requires confirmation of destructive calls, e.g. DeleteProject, when set.
+	DestructiveGuard *DestructiveGuard
+
+	// ReadOnly rejects the calls with the methods other than GET with ErrReadOnlyClient before they are sent,
+	// e.g. for the dashboards which must never change the resources regardless of the key's permissions.
+	ReadOnly bool
+
+	// Codec encodes the requests' and decodes the responses' payload, encoding/json is used if it is not set.
+	// Note that the API errors are decoded with encoding/json.
+	Codec Codec
+
+	// DebugDump writes the sanitized requests and responses to the writer when set and enabled.
+	DebugDump *DebugDump
+
+	// CircuitBreaker short-circuits the calls with ErrCircuitOpen after consecutive failures when set.
+	CircuitBreaker *CircuitBreaker
+
+	// RequestCompressionThreshold the minimum size in bytes of the request body compressed with gzip,
+	// the request bodies are not compressed if it is not positive. The uncompressed request is resent
+	// if the API rejects the encoding with 415 Unsupported Media Type.
+	RequestCompressionThreshold int
+
+	// EventSink receives the lifecycle events, e.g. the project's creation, when set.
+	EventSink EventSink
+
+	// Audit records every call, its resources and outcome to the audit sink when set.
+	Audit *AuditLog
+
+	// Outbox records the mutating calls which failed because the API was unavailable when set,
+	// the calls are resent by ReplayOutbox. The mutating calls carry the Idempotency-Key header.
+	Outbox Outbox
+
+	// MetaCache caches the API's metadata returned by Meta when set, e.g. to share it by the long-lived clients.
+	MetaCache *MetaCache
+
+	// TLSConfig the TLS configuration of the default HTTP client, e.g. the CA bundle of the TLS-intercepting proxy,
+	// or the client certificates. It cannot be used with the custom HTTPClient.
+	TLSConfig *tls.Config
+
+	// ProxyURL the proxy of the default HTTP client, the proxy is defined by the environment variables
+	// HTTPS_PROXY and NO_PROXY if it is not set. It cannot be used with the custom HTTPClient.
+	ProxyURL *url.URL
+
+	// ConcurrencyLimit limits the in-flight requests of the default HTTP client, overall and per project,
+	// when set. It cannot be used with the custom HTTPClient, see NewConcurrencyLimitedTransport.
+	ConcurrencyLimit *ConcurrencyLimit
+
+	// PreSign is invoked with every fully-built request before it is sent when set,
+	// e.g. to sign the request for the internal API gateway.
+	PreSign PreSignFunc
+
+	// ValidateResponses validates the successful responses against the schemas of the API spec when set,
+	// e.g. in tests, to catch the drift between the SDK and the API. The response not matching the schema
+	// is rejected with *ResponseValidationError.
+	ValidateResponses bool
+
+	// OnDeprecation is invoked when the API reports the deprecation of the called endpoint
+	// with the Deprecation, or Sunset response header when set, see LogDeprecation.
+	OnDeprecation DeprecationFunc
+
+	// MaxResponseSize the maximum size in bytes of the response body, the call fails with ErrResponseTooLarge
+	// if the response is larger, e.g. to prevent the memory exhaustion in small containers.
+	// The size is not limited if it is not positive.
+	MaxResponseSize int64
 }
 
 const (
@@ -51,6 +125,9 @@ type Client struct {
 	cfg Config
 
 	baseURL string
+
+	// metadata the destination of the successful responses' metadata, see WithResponseMetadata.
+	metadata *ResponseMetadata
 }
 
 // HTTPClient client to handle http requests.
@@ -58,39 +135,50 @@ type HTTPClient interface {
 	Do(req *http.Request) (*http.Response, error)
 }
 
-func setHeaders(req *http.Request, token string) {
+func setHeaders(req *http.Request) {
 	req.Header.Add("Accept", "application/json")
 	req.Header.Add("Content-Type", "application/json")
-	if token != "" {
-		req.Header.Add("Authorization", "Bearer "+token)
-	}
 }
 
 func (c Client) requestHandler(url string, t string, reqPayload interface{}, responsePayload interface{}) error {
-	var body io.Reader
-	var err error
+	start := time.Now()
+	key := c.newIdempotencyKey(t)
+	err := c.sendRequest(url, t, reqPayload, responsePayload, key)
+	c.cfg.Audit.record(t, url, start, err)
+	return c.recordInOutbox(key, t, url, reqPayload, err)
+}
 
-	if reqPayload != nil {
-		if v := reflect.ValueOf(reqPayload); v.Kind() == reflect.Struct || !v.IsNil() {
-			b, err := json.Marshal(reqPayload)
-			if err != nil {
-				return err
-			}
-			body = bytes.NewReader(b)
-		}
+func (c Client) sendRequest(
+	url string, t string, reqPayload interface{}, responsePayload interface{}, idempotencyKey string,
+) error {
+	if err := checkReadOnly(c.cfg.ReadOnly, t, url); err != nil {
+		return err
+	}
+	if err := c.cfg.DestructiveGuard.check(t, url); err != nil {
+		return err
 	}
 
-	req, _ := http.NewRequest(t, url, body)
-	setHeaders(req, c.cfg.Key)
-
-	res, err := c.cfg.HTTPClient.Do(req)
+	res, reqBody, err := c.doRequest(url, t, reqPayload, idempotencyKey)
 	if err != nil {
 		return err
 	}
+	c.notifyDeprecation(t, url, res)
+	limitResponseBody(res, c.cfg.MaxResponseSize)
+
+	if c.cfg.DebugDump.Enabled() {
+		buf, err := io.ReadAll(res.Body)
+		_ = res.Body.Close()
+		if err != nil {
+			return err
+		}
+		c.cfg.DebugDump.dump(t, url, reqBody, res.StatusCode, buf)
+		res.Body = io.NopCloser(bytes.NewReader(buf))
+	}
 
 	if res.StatusCode > 299 {
 		return convertErrorResponse(res)
 	}
+	c.recordMetadata(res)
 
 	if responsePayload != nil {
 		buf, err := io.ReadAll(res.Body)
@@ -98,12 +186,58 @@ func (c Client) requestHandler(url string, t string, reqPayload interface{}, res
 		if err != nil {
 			return err
 		}
-		return json.Unmarshal(buf, responsePayload)
+		if len(bytes.TrimSpace(buf)) == 0 {
+			// the response has no content, e.g. 204 No Content, hence the payload is left with its zero value
+			emitEvents(c.cfg.EventSink, t, url, nil)
+			return nil
+		}
+		if c.cfg.ValidateResponses {
+			if err := c.validateResponse(t, url, res.StatusCode, buf); err != nil {
+				return err
+			}
+		}
+		if err := c.codec().Unmarshal(buf, responsePayload); err != nil {
+			return err
+		}
+		emitEvents(c.cfg.EventSink, t, url, buf)
+		return nil
 	}
 
+	emitEvents(c.cfg.EventSink, t, url, nil)
 	return nil
 }
 
+// doRequest builds and sends the request guarded by the circuit breaker. The request with the compressed body
+// is resent uncompressed if the encoding is not accepted. The encoded payload is returned along with the response.
+func (c Client) doRequest(
+	url string, t string, reqPayload interface{}, idempotencyKey string,
+) (*http.Response, []byte, error) {
+	req, reqBody, compressed, err := c.buildRequest(t, url, reqPayload, idempotencyKey, true)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	if err := c.cfg.CircuitBreaker.allow(url); err != nil {
+		return nil, nil, err
+	}
+
+	res, err := c.cfg.HTTPClient.Do(req)
+	if err == nil && compressed && res.StatusCode == http.StatusUnsupportedMediaType {
+		// the encoding is not accepted, the request is resent uncompressed
+		_ = res.Body.Close()
+		req, reqBody, _, err = c.buildRequest(t, url, reqPayload, idempotencyKey, false)
+		if err != nil {
+			return nil, nil, err
+		}
+		res, err = c.cfg.HTTPClient.Do(req)
+	}
+	c.cfg.CircuitBreaker.record(url, res, err)
+	if err != nil {
+		return nil, nil, err
+	}
+	return res, reqBody, nil
+}
+
 // AddProjectJWKS Add a new JWKS URL to a project, such that it can be used for verifying JWTs used as the authentication mechanism for the specified project.
 // The URL must be a valid HTTPS URL that returns a JSON Web Key Set.
 // The `provider_name` field allows you to specify which authentication provider you're using (e.g., Clerk, Auth0, AWS Cognito, etc.).
@@ -111,8 +245,11 @@ func (c Client) requestHandler(url string, t string, reqPayload interface{}, res
 // The `role_names` can be used to specify for which roles the JWKS URL will be accepted.
 // The `jwt_audience` can be used to specify which "aud" values should be accepted by Neon in the JWTs that are used for authentication.
 func (c Client) AddProjectJWKS(projectID string, cfg AddProjectJWKSRequest) (JWKSCreationOperation, error) {
+	if projectID == "" {
+		return JWKSCreationOperation{}, newPathParameterError("project_id")
+	}
 	var v JWKSCreationOperation
-	if err := c.requestHandler(c.baseURL+"/projects/"+projectID+"/jwks", "POST", cfg, &v); err != nil {
+	if err := c.requestHandler(c.baseURL+"/projects/"+url.PathEscape(projectID)+"/jwks", "POST", cfg, &v); err != nil {
 		return JWKSCreationOperation{}, err
 	}
 	return v, nil
@@ -137,8 +274,11 @@ func (c Client) CreateApiKey(cfg ApiKeyCreateRequest) (ApiKeyCreateResponse, err
 // API keys can also be managed in the Neon Console.
 // See [Manage API keys](https://neon.tech/docs/manage/api-keys/).
 func (c Client) CreateOrgApiKey(orgID string, cfg OrgApiKeyCreateRequest) (OrgApiKeyCreateResponse, error) {
+	if orgID == "" {
+		return OrgApiKeyCreateResponse{}, newPathParameterError("org_id")
+	}
 	var v OrgApiKeyCreateResponse
-	if err := c.requestHandler(c.baseURL+"/organizations/"+orgID+"/api_keys", "POST", cfg, &v); err != nil {
+	if err := c.requestHandler(c.baseURL+"/organizations/"+url.PathEscape(orgID)+"/api_keys", "POST", cfg, &v); err != nil {
 		return OrgApiKeyCreateResponse{}, err
 	}
 	return v, nil
@@ -149,8 +289,11 @@ func (c Client) CreateOrgApiKey(orgID string, cfg OrgApiKeyCreateRequest) (OrgAp
 // If they don't yet have an account, they are invited to create one, after which they become a member.
 // Each invited user receives an email notification.
 func (c Client) CreateOrganizationInvitations(orgID string, cfg OrganizationInvitesCreateRequest) (OrganizationInvitationsResponse, error) {
+	if orgID == "" {
+		return OrganizationInvitationsResponse{}, newPathParameterError("org_id")
+	}
 	var v OrganizationInvitationsResponse
-	if err := c.requestHandler(c.baseURL+"/organizations/"+orgID+"/invitations", "POST", cfg, &v); err != nil {
+	if err := c.requestHandler(c.baseURL+"/organizations/"+url.PathEscape(orgID)+"/invitations", "POST", cfg, &v); err != nil {
 		return OrganizationInvitationsResponse{}, err
 	}
 	return v, nil
@@ -179,8 +322,11 @@ func (c Client) CreateProject(cfg ProjectCreateRequest) (CreatedProject, error)
 // A branch can have multiple read-only endpoints.
 // For related information, see [Manage branches](https://neon.tech/docs/manage/branches/).
 func (c Client) CreateProjectBranch(projectID string, cfg *CreateProjectBranchReqObj) (CreatedBranch, error) {
+	if projectID == "" {
+		return CreatedBranch{}, newPathParameterError("project_id")
+	}
 	var v CreatedBranch
-	if err := c.requestHandler(c.baseURL+"/projects/"+projectID+"/branches", "POST", cfg, &v); err != nil {
+	if err := c.requestHandler(c.baseURL+"/projects/"+url.PathEscape(projectID)+"/branches", "POST", cfg, &v); err != nil {
 		return CreatedBranch{}, err
 	}
 	return v, nil
@@ -192,8 +338,14 @@ func (c Client) CreateProjectBranch(projectID string, cfg *CreateProjectBranchRe
 // You can obtain the `branch_id` by listing the project's branches.
 // For related information, see [Manage databases](https://neon.tech/docs/manage/databases/).
 func (c Client) CreateProjectBranchDatabase(projectID string, branchID string, cfg DatabaseCreateRequest) (DatabaseOperations, error) {
+	if projectID == "" {
+		return DatabaseOperations{}, newPathParameterError("project_id")
+	}
+	if branchID == "" {
+		return DatabaseOperations{}, newPathParameterError("branch_id")
+	}
 	var v DatabaseOperations
-	if err := c.requestHandler(c.baseURL+"/projects/"+projectID+"/branches/"+branchID+"/databases", "POST", cfg, &v); err != nil {
+	if err := c.requestHandler(c.baseURL+"/projects/"+url.PathEscape(projectID)+"/branches/"+url.PathEscape(branchID)+"/databases", "POST", cfg, &v); err != nil {
 		return DatabaseOperations{}, err
 	}
 	return v, nil
@@ -206,8 +358,14 @@ func (c Client) CreateProjectBranchDatabase(projectID string, branchID string, c
 // Connections established to the active compute endpoint will be dropped.
 // If the compute endpoint is idle, the endpoint becomes active for a short period of time and is suspended afterward.
 func (c Client) CreateProjectBranchRole(projectID string, branchID string, cfg RoleCreateRequest) (RoleOperations, error) {
+	if projectID == "" {
+		return RoleOperations{}, newPathParameterError("project_id")
+	}
+	if branchID == "" {
+		return RoleOperations{}, newPathParameterError("branch_id")
+	}
 	var v RoleOperations
-	if err := c.requestHandler(c.baseURL+"/projects/"+projectID+"/branches/"+branchID+"/roles", "POST", cfg, &v); err != nil {
+	if err := c.requestHandler(c.baseURL+"/projects/"+url.PathEscape(projectID)+"/branches/"+url.PathEscape(branchID)+"/roles", "POST", cfg, &v); err != nil {
 		return RoleOperations{}, err
 	}
 	return v, nil
@@ -224,8 +382,11 @@ func (c Client) CreateProjectBranchRole(projectID string, branchID string, cfg R
 // For supported regions and `region_id` values, see [Regions](https://neon.tech/docs/introduction/regions/).
 // For more information about compute endpoints, see [Manage computes](https://neon.tech/docs/manage/endpoints/).
 func (c Client) CreateProjectEndpoint(projectID string, cfg EndpointCreateRequest) (EndpointOperations, error) {
+	if projectID == "" {
+		return EndpointOperations{}, newPathParameterError("project_id")
+	}
 	var v EndpointOperations
-	if err := c.requestHandler(c.baseURL+"/projects/"+projectID+"/endpoints", "POST", cfg, &v); err != nil {
+	if err := c.requestHandler(c.baseURL+"/projects/"+url.PathEscape(projectID)+"/endpoints", "POST", cfg, &v); err != nil {
 		return EndpointOperations{}, err
 	}
 	return v, nil
@@ -236,8 +397,11 @@ func (c Client) CreateProjectEndpoint(projectID string, cfg EndpointCreateReques
 // Deleting a project is a permanent action.
 // Deleting a project also deletes endpoints, branches, databases, and users that belong to the project.
 func (c Client) DeleteProject(projectID string) (ProjectResponse, error) {
+	if projectID == "" {
+		return ProjectResponse{}, newPathParameterError("project_id")
+	}
 	var v ProjectResponse
-	if err := c.requestHandler(c.baseURL+"/projects/"+projectID, "DELETE", nil, &v); err != nil {
+	if err := c.requestHandler(c.baseURL+"/projects/"+url.PathEscape(projectID), "DELETE", nil, &v); err != nil {
 		return ProjectResponse{}, err
 	}
 	return v, nil
@@ -254,8 +418,14 @@ func (c Client) DeleteProject(projectID string) (ProjectResponse, error) {
 // You cannot delete a project's root or default branch, and you cannot delete a branch that has a child branch.
 // A project must have at least one branch.
 func (c Client) DeleteProjectBranch(projectID string, branchID string) (BranchOperations, error) {
+	if projectID == "" {
+		return BranchOperations{}, newPathParameterError("project_id")
+	}
+	if branchID == "" {
+		return BranchOperations{}, newPathParameterError("branch_id")
+	}
 	var v BranchOperations
-	if err := c.requestHandler(c.baseURL+"/projects/"+projectID+"/branches/"+branchID, "DELETE", nil, &v); err != nil {
+	if err := c.requestHandler(c.baseURL+"/projects/"+url.PathEscape(projectID)+"/branches/"+url.PathEscape(branchID), "DELETE", nil, &v); err != nil {
 		return BranchOperations{}, err
 	}
 	return v, nil
@@ -266,8 +436,17 @@ func (c Client) DeleteProjectBranch(projectID string, branchID string) (BranchOp
 // You can obtain the `branch_id` and `database_name` by listing the branch's databases.
 // For related information, see [Manage databases](https://neon.tech/docs/manage/databases/).
 func (c Client) DeleteProjectBranchDatabase(projectID string, branchID string, databaseName string) (DatabaseOperations, error) {
+	if projectID == "" {
+		return DatabaseOperations{}, newPathParameterError("project_id")
+	}
+	if branchID == "" {
+		return DatabaseOperations{}, newPathParameterError("branch_id")
+	}
+	if databaseName == "" {
+		return DatabaseOperations{}, newPathParameterError("database_name")
+	}
 	var v DatabaseOperations
-	if err := c.requestHandler(c.baseURL+"/projects/"+projectID+"/branches/"+branchID+"/databases/"+databaseName, "DELETE", nil, &v); err != nil {
+	if err := c.requestHandler(c.baseURL+"/projects/"+url.PathEscape(projectID)+"/branches/"+url.PathEscape(branchID)+"/databases/"+url.PathEscape(databaseName), "DELETE", nil, &v); err != nil {
 		return DatabaseOperations{}, err
 	}
 	return v, nil
@@ -279,8 +458,17 @@ func (c Client) DeleteProjectBranchDatabase(projectID string, branchID string, d
 // You can obtain the `role_name` by listing the roles for a branch.
 // For related information, see [Manage roles](https://neon.tech/docs/manage/roles/).
 func (c Client) DeleteProjectBranchRole(projectID string, branchID string, roleName string) (RoleOperations, error) {
+	if projectID == "" {
+		return RoleOperations{}, newPathParameterError("project_id")
+	}
+	if branchID == "" {
+		return RoleOperations{}, newPathParameterError("branch_id")
+	}
+	if roleName == "" {
+		return RoleOperations{}, newPathParameterError("role_name")
+	}
 	var v RoleOperations
-	if err := c.requestHandler(c.baseURL+"/projects/"+projectID+"/branches/"+branchID+"/roles/"+roleName, "DELETE", nil, &v); err != nil {
+	if err := c.requestHandler(c.baseURL+"/projects/"+url.PathEscape(projectID)+"/branches/"+url.PathEscape(branchID)+"/roles/"+url.PathEscape(roleName), "DELETE", nil, &v); err != nil {
 		return RoleOperations{}, err
 	}
 	return v, nil
@@ -295,8 +483,14 @@ func (c Client) DeleteProjectBranchRole(projectID string, branchID string, roleN
 // An `endpoint_id` has an `ep-` prefix.
 // For information about compute endpoints, see [Manage computes](https://neon.tech/docs/manage/endpoints/).
 func (c Client) DeleteProjectEndpoint(projectID string, endpointID string) (EndpointOperations, error) {
+	if projectID == "" {
+		return EndpointOperations{}, newPathParameterError("project_id")
+	}
+	if endpointID == "" {
+		return EndpointOperations{}, newPathParameterError("endpoint_id")
+	}
 	var v EndpointOperations
-	if err := c.requestHandler(c.baseURL+"/projects/"+projectID+"/endpoints/"+endpointID, "DELETE", nil, &v); err != nil {
+	if err := c.requestHandler(c.baseURL+"/projects/"+url.PathEscape(projectID)+"/endpoints/"+url.PathEscape(endpointID), "DELETE", nil, &v); err != nil {
 		return EndpointOperations{}, err
 	}
 	return v, nil
@@ -304,8 +498,14 @@ func (c Client) DeleteProjectEndpoint(projectID string, endpointID string) (Endp
 
 // DeleteProjectJWKS Deletes a JWKS URL from the specified project
 func (c Client) DeleteProjectJWKS(projectID string, jwksID string) (JWKS, error) {
+	if projectID == "" {
+		return JWKS{}, newPathParameterError("project_id")
+	}
+	if jwksID == "" {
+		return JWKS{}, newPathParameterError("jwks_id")
+	}
 	var v JWKS
-	if err := c.requestHandler(c.baseURL+"/projects/"+projectID+"/jwks/"+jwksID, "DELETE", nil, &v); err != nil {
+	if err := c.requestHandler(c.baseURL+"/projects/"+url.PathEscape(projectID)+"/jwks/"+url.PathEscape(jwksID), "DELETE", nil, &v); err != nil {
 		return JWKS{}, err
 	}
 	return v, nil
@@ -325,6 +525,9 @@ func (c Client) GetActiveRegions() (ActiveRegionsResponse, error) {
 // You can obtain the `database_name` by listing the databases for a branch.
 // You can obtain a `role_name` by listing the roles for a branch.
 func (c Client) GetConnectionURI(projectID string, branchID *string, endpointID *string, databaseName string, roleName string, pooled *bool) (ConnectionURIResponse, error) {
+	if projectID == "" {
+		return ConnectionURIResponse{}, newPathParameterError("project_id")
+	}
 	var (
 		queryElements []string
 		query         string
@@ -349,7 +552,7 @@ func (c Client) GetConnectionURI(projectID string, branchID *string, endpointID
 		query = "?" + strings.Join(queryElements, "&")
 	}
 	var v ConnectionURIResponse
-	if err := c.requestHandler(c.baseURL+"/projects/"+projectID+"/connection_uri"+query, "GET", nil, &v); err != nil {
+	if err := c.requestHandler(c.baseURL+"/projects/"+url.PathEscape(projectID)+"/connection_uri"+query, "GET", nil, &v); err != nil {
 		return ConnectionURIResponse{}, err
 	}
 	return v, nil
@@ -447,8 +650,11 @@ func (c Client) GetCurrentUserOrganizations() (OrganizationsResponse, error) {
 
 // GetOrganization Retrieves information about the specified organization.
 func (c Client) GetOrganization(orgID string) (Organization, error) {
+	if orgID == "" {
+		return Organization{}, newPathParameterError("org_id")
+	}
 	var v Organization
-	if err := c.requestHandler(c.baseURL+"/organizations/"+orgID, "GET", nil, &v); err != nil {
+	if err := c.requestHandler(c.baseURL+"/organizations/"+url.PathEscape(orgID), "GET", nil, &v); err != nil {
 		return Organization{}, err
 	}
 	return v, nil
@@ -456,8 +662,11 @@ func (c Client) GetOrganization(orgID string) (Organization, error) {
 
 // GetOrganizationInvitations Retrieves information about extended invitations for the specified organization
 func (c Client) GetOrganizationInvitations(orgID string) (OrganizationInvitationsResponse, error) {
+	if orgID == "" {
+		return OrganizationInvitationsResponse{}, newPathParameterError("org_id")
+	}
 	var v OrganizationInvitationsResponse
-	if err := c.requestHandler(c.baseURL+"/organizations/"+orgID+"/invitations", "GET", nil, &v); err != nil {
+	if err := c.requestHandler(c.baseURL+"/organizations/"+url.PathEscape(orgID)+"/invitations", "GET", nil, &v); err != nil {
 		return OrganizationInvitationsResponse{}, err
 	}
 	return v, nil
@@ -465,8 +674,14 @@ func (c Client) GetOrganizationInvitations(orgID string) (OrganizationInvitation
 
 // GetOrganizationMember Retrieves information about the specified organization member.
 func (c Client) GetOrganizationMember(orgID string, memberID string) (Member, error) {
+	if orgID == "" {
+		return Member{}, newPathParameterError("org_id")
+	}
+	if memberID == "" {
+		return Member{}, newPathParameterError("member_id")
+	}
 	var v Member
-	if err := c.requestHandler(c.baseURL+"/organizations/"+orgID+"/members/"+memberID, "GET", nil, &v); err != nil {
+	if err := c.requestHandler(c.baseURL+"/organizations/"+url.PathEscape(orgID)+"/members/"+url.PathEscape(memberID), "GET", nil, &v); err != nil {
 		return Member{}, err
 	}
 	return v, nil
@@ -474,8 +689,11 @@ func (c Client) GetOrganizationMember(orgID string, memberID string) (Member, er
 
 // GetOrganizationMembers Retrieves information about the specified organization members.
 func (c Client) GetOrganizationMembers(orgID string) (OrganizationMembersResponse, error) {
+	if orgID == "" {
+		return OrganizationMembersResponse{}, newPathParameterError("org_id")
+	}
 	var v OrganizationMembersResponse
-	if err := c.requestHandler(c.baseURL+"/organizations/"+orgID+"/members", "GET", nil, &v); err != nil {
+	if err := c.requestHandler(c.baseURL+"/organizations/"+url.PathEscape(orgID)+"/members", "GET", nil, &v); err != nil {
 		return OrganizationMembersResponse{}, err
 	}
 	return v, nil
@@ -485,8 +703,11 @@ func (c Client) GetOrganizationMembers(orgID string) (OrganizationMembersRespons
 // A project is the top-level object in the Neon object hierarchy.
 // You can obtain a `project_id` by listing the projects for your Neon account.
 func (c Client) GetProject(projectID string) (ProjectResponse, error) {
+	if projectID == "" {
+		return ProjectResponse{}, newPathParameterError("project_id")
+	}
 	var v ProjectResponse
-	if err := c.requestHandler(c.baseURL+"/projects/"+projectID, "GET", nil, &v); err != nil {
+	if err := c.requestHandler(c.baseURL+"/projects/"+url.PathEscape(projectID), "GET", nil, &v); err != nil {
 		return ProjectResponse{}, err
 	}
 	return v, nil
@@ -501,8 +722,14 @@ func (c Client) GetProject(projectID string) (ProjectResponse, error) {
 // A parent branch is identified by a `parent_id` value, which is the `id` of the parent branch.
 // For related information, see [Manage branches](https://neon.tech/docs/manage/branches/).
 func (c Client) GetProjectBranch(projectID string, branchID string) (GetProjectBranchRespObj, error) {
+	if projectID == "" {
+		return GetProjectBranchRespObj{}, newPathParameterError("project_id")
+	}
+	if branchID == "" {
+		return GetProjectBranchRespObj{}, newPathParameterError("branch_id")
+	}
 	var v GetProjectBranchRespObj
-	if err := c.requestHandler(c.baseURL+"/projects/"+projectID+"/branches/"+branchID, "GET", nil, &v); err != nil {
+	if err := c.requestHandler(c.baseURL+"/projects/"+url.PathEscape(projectID)+"/branches/"+url.PathEscape(branchID), "GET", nil, &v); err != nil {
 		return GetProjectBranchRespObj{}, err
 	}
 	return v, nil
@@ -513,8 +740,17 @@ func (c Client) GetProjectBranch(projectID string, branchID string) (GetProjectB
 // You can obtain the `branch_id` and `database_name` by listing the branch's databases.
 // For related information, see [Manage databases](https://neon.tech/docs/manage/databases/).
 func (c Client) GetProjectBranchDatabase(projectID string, branchID string, databaseName string) (DatabaseResponse, error) {
+	if projectID == "" {
+		return DatabaseResponse{}, newPathParameterError("project_id")
+	}
+	if branchID == "" {
+		return DatabaseResponse{}, newPathParameterError("branch_id")
+	}
+	if databaseName == "" {
+		return DatabaseResponse{}, newPathParameterError("database_name")
+	}
 	var v DatabaseResponse
-	if err := c.requestHandler(c.baseURL+"/projects/"+projectID+"/branches/"+branchID+"/databases/"+databaseName, "GET", nil, &v); err != nil {
+	if err := c.requestHandler(c.baseURL+"/projects/"+url.PathEscape(projectID)+"/branches/"+url.PathEscape(branchID)+"/databases/"+url.PathEscape(databaseName), "GET", nil, &v); err != nil {
 		return DatabaseResponse{}, err
 	}
 	return v, nil
@@ -527,8 +763,17 @@ func (c Client) GetProjectBranchDatabase(projectID string, branchID string, data
 // In Neon, the terms "role" and "user" are synonymous.
 // For related information, see [Manage roles](https://neon.tech/docs/manage/roles/).
 func (c Client) GetProjectBranchRole(projectID string, branchID string, roleName string) (RoleResponse, error) {
+	if projectID == "" {
+		return RoleResponse{}, newPathParameterError("project_id")
+	}
+	if branchID == "" {
+		return RoleResponse{}, newPathParameterError("branch_id")
+	}
+	if roleName == "" {
+		return RoleResponse{}, newPathParameterError("role_name")
+	}
 	var v RoleResponse
-	if err := c.requestHandler(c.baseURL+"/projects/"+projectID+"/branches/"+branchID+"/roles/"+roleName, "GET", nil, &v); err != nil {
+	if err := c.requestHandler(c.baseURL+"/projects/"+url.PathEscape(projectID)+"/branches/"+url.PathEscape(branchID)+"/roles/"+url.PathEscape(roleName), "GET", nil, &v); err != nil {
 		return RoleResponse{}, err
 	}
 	return v, nil
@@ -540,8 +785,17 @@ func (c Client) GetProjectBranchRole(projectID string, branchID string, roleName
 // You can obtain the `role_name` by listing the roles for a branch.
 // For related information, see [Manage roles](https://neon.tech/docs/manage/roles/).
 func (c Client) GetProjectBranchRolePassword(projectID string, branchID string, roleName string) (RolePasswordResponse, error) {
+	if projectID == "" {
+		return RolePasswordResponse{}, newPathParameterError("project_id")
+	}
+	if branchID == "" {
+		return RolePasswordResponse{}, newPathParameterError("branch_id")
+	}
+	if roleName == "" {
+		return RolePasswordResponse{}, newPathParameterError("role_name")
+	}
 	var v RolePasswordResponse
-	if err := c.requestHandler(c.baseURL+"/projects/"+projectID+"/branches/"+branchID+"/roles/"+roleName+"/reveal_password", "GET", nil, &v); err != nil {
+	if err := c.requestHandler(c.baseURL+"/projects/"+url.PathEscape(projectID)+"/branches/"+url.PathEscape(branchID)+"/roles/"+url.PathEscape(roleName)+"/reveal_password", "GET", nil, &v); err != nil {
 		return RolePasswordResponse{}, err
 	}
 	return v, nil
@@ -549,6 +803,12 @@ func (c Client) GetProjectBranchRolePassword(projectID string, branchID string,
 
 // GetProjectBranchSchema Retrieves the schema from the specified database. The `lsn` and `timestamp` values cannot be specified at the same time. If both are omitted, the database schema is retrieved from database's head.
 func (c Client) GetProjectBranchSchema(projectID string, branchID string, dbName string, lsn *string, timestamp *time.Time) (BranchSchemaResponse, error) {
+	if projectID == "" {
+		return BranchSchemaResponse{}, newPathParameterError("project_id")
+	}
+	if branchID == "" {
+		return BranchSchemaResponse{}, newPathParameterError("branch_id")
+	}
 	var (
 		queryElements []string
 		query         string
@@ -564,7 +824,7 @@ func (c Client) GetProjectBranchSchema(projectID string, branchID string, dbName
 		query = "?" + strings.Join(queryElements, "&")
 	}
 	var v BranchSchemaResponse
-	if err := c.requestHandler(c.baseURL+"/projects/"+projectID+"/branches/"+branchID+"/schema"+query, "GET", nil, &v); err != nil {
+	if err := c.requestHandler(c.baseURL+"/projects/"+url.PathEscape(projectID)+"/branches/"+url.PathEscape(branchID)+"/schema"+query, "GET", nil, &v); err != nil {
 		return BranchSchemaResponse{}, err
 	}
 	return v, nil
@@ -577,8 +837,14 @@ func (c Client) GetProjectBranchSchema(projectID string, branchID string, dbName
 // An `endpoint_id` has an `ep-` prefix.
 // For information about compute endpoints, see [Manage computes](https://neon.tech/docs/manage/endpoints/).
 func (c Client) GetProjectEndpoint(projectID string, endpointID string) (EndpointResponse, error) {
+	if projectID == "" {
+		return EndpointResponse{}, newPathParameterError("project_id")
+	}
+	if endpointID == "" {
+		return EndpointResponse{}, newPathParameterError("endpoint_id")
+	}
 	var v EndpointResponse
-	if err := c.requestHandler(c.baseURL+"/projects/"+projectID+"/endpoints/"+endpointID, "GET", nil, &v); err != nil {
+	if err := c.requestHandler(c.baseURL+"/projects/"+url.PathEscape(projectID)+"/endpoints/"+url.PathEscape(endpointID), "GET", nil, &v); err != nil {
 		return EndpointResponse{}, err
 	}
 	return v, nil
@@ -586,8 +852,11 @@ func (c Client) GetProjectEndpoint(projectID string, endpointID string) (Endpoin
 
 // GetProjectJWKS Returns all the available JWKS URLs that can be used for verifying JWTs used as the authentication mechanism for the specified project.
 func (c Client) GetProjectJWKS(projectID string) (ProjectJWKSResponse, error) {
+	if projectID == "" {
+		return ProjectJWKSResponse{}, newPathParameterError("project_id")
+	}
 	var v ProjectJWKSResponse
-	if err := c.requestHandler(c.baseURL+"/projects/"+projectID+"/jwks", "GET", nil, &v); err != nil {
+	if err := c.requestHandler(c.baseURL+"/projects/"+url.PathEscape(projectID)+"/jwks", "GET", nil, &v); err != nil {
 		return ProjectJWKSResponse{}, err
 	}
 	return v, nil
@@ -598,8 +867,14 @@ func (c Client) GetProjectJWKS(projectID string) (ProjectJWKSResponse, error) {
 // You can obtain a `project_id` by listing the projects for your Neon account.
 // You can obtain a `operation_id` by listing operations for the project.
 func (c Client) GetProjectOperation(projectID string, operationID string) (OperationResponse, error) {
+	if projectID == "" {
+		return OperationResponse{}, newPathParameterError("project_id")
+	}
+	if operationID == "" {
+		return OperationResponse{}, newPathParameterError("operation_id")
+	}
 	var v OperationResponse
-	if err := c.requestHandler(c.baseURL+"/projects/"+projectID+"/operations/"+operationID, "GET", nil, &v); err != nil {
+	if err := c.requestHandler(c.baseURL+"/projects/"+url.PathEscape(projectID)+"/operations/"+url.PathEscape(operationID), "GET", nil, &v); err != nil {
 		return OperationResponse{}, err
 	}
 	return v, nil
@@ -607,8 +882,11 @@ func (c Client) GetProjectOperation(projectID string, operationID string) (Opera
 
 // GrantPermissionToProject Grants project access to the account associated with the specified email address
 func (c Client) GrantPermissionToProject(projectID string, cfg GrantPermissionToProjectRequest) (ProjectPermission, error) {
+	if projectID == "" {
+		return ProjectPermission{}, newPathParameterError("project_id")
+	}
 	var v ProjectPermission
-	if err := c.requestHandler(c.baseURL+"/projects/"+projectID+"/permissions", "POST", cfg, &v); err != nil {
+	if err := c.requestHandler(c.baseURL+"/projects/"+url.PathEscape(projectID)+"/permissions", "POST", cfg, &v); err != nil {
 		return ProjectPermission{}, err
 	}
 	return v, nil
@@ -631,8 +909,11 @@ func (c Client) ListApiKeys() ([]ApiKeysListResponseItem, error) {
 // API keys can also be managed in the Neon Console.
 // For more information, see [Manage API keys](https://neon.tech/docs/manage/api-keys/).
 func (c Client) ListOrgApiKeys(orgID string) ([]OrgApiKeysListResponseItem, error) {
+	if orgID == "" {
+		return nil, newPathParameterError("org_id")
+	}
 	var v []OrgApiKeysListResponseItem
-	if err := c.requestHandler(c.baseURL+"/organizations/"+orgID+"/api_keys", "GET", nil, &v); err != nil {
+	if err := c.requestHandler(c.baseURL+"/organizations/"+url.PathEscape(orgID)+"/api_keys", "GET", nil, &v); err != nil {
 		return nil, err
 	}
 	return v, nil
@@ -644,8 +925,14 @@ func (c Client) ListOrgApiKeys(orgID string) ([]OrgApiKeysListResponseItem, erro
 // You can obtain the `branch_id` by listing the project's branches.
 // For related information, see [Manage databases](https://neon.tech/docs/manage/databases/).
 func (c Client) ListProjectBranchDatabases(projectID string, branchID string) (DatabasesResponse, error) {
+	if projectID == "" {
+		return DatabasesResponse{}, newPathParameterError("project_id")
+	}
+	if branchID == "" {
+		return DatabasesResponse{}, newPathParameterError("branch_id")
+	}
 	var v DatabasesResponse
-	if err := c.requestHandler(c.baseURL+"/projects/"+projectID+"/branches/"+branchID+"/databases", "GET", nil, &v); err != nil {
+	if err := c.requestHandler(c.baseURL+"/projects/"+url.PathEscape(projectID)+"/branches/"+url.PathEscape(branchID)+"/databases", "GET", nil, &v); err != nil {
 		return DatabasesResponse{}, err
 	}
 	return v, nil
@@ -657,8 +944,14 @@ func (c Client) ListProjectBranchDatabases(projectID string, branchID string) (D
 // You can obtain a `project_id` by listing the projects for your Neon account.
 // You can obtain the `branch_id` by listing the project's branches.
 func (c Client) ListProjectBranchEndpoints(projectID string, branchID string) (EndpointsResponse, error) {
+	if projectID == "" {
+		return EndpointsResponse{}, newPathParameterError("project_id")
+	}
+	if branchID == "" {
+		return EndpointsResponse{}, newPathParameterError("branch_id")
+	}
 	var v EndpointsResponse
-	if err := c.requestHandler(c.baseURL+"/projects/"+projectID+"/branches/"+branchID+"/endpoints", "GET", nil, &v); err != nil {
+	if err := c.requestHandler(c.baseURL+"/projects/"+url.PathEscape(projectID)+"/branches/"+url.PathEscape(branchID)+"/endpoints", "GET", nil, &v); err != nil {
 		return EndpointsResponse{}, err
 	}
 	return v, nil
@@ -669,8 +962,14 @@ func (c Client) ListProjectBranchEndpoints(projectID string, branchID string) (E
 // You can obtain the `branch_id` by listing the project's branches.
 // For related information, see [Manage roles](https://neon.tech/docs/manage/roles/).
 func (c Client) ListProjectBranchRoles(projectID string, branchID string) (RolesResponse, error) {
+	if projectID == "" {
+		return RolesResponse{}, newPathParameterError("project_id")
+	}
+	if branchID == "" {
+		return RolesResponse{}, newPathParameterError("branch_id")
+	}
 	var v RolesResponse
-	if err := c.requestHandler(c.baseURL+"/projects/"+projectID+"/branches/"+branchID+"/roles", "GET", nil, &v); err != nil {
+	if err := c.requestHandler(c.baseURL+"/projects/"+url.PathEscape(projectID)+"/branches/"+url.PathEscape(branchID)+"/roles", "GET", nil, &v); err != nil {
 		return RolesResponse{}, err
 	}
 	return v, nil
@@ -684,6 +983,9 @@ func (c Client) ListProjectBranchRoles(projectID string, branchID string) (Roles
 // A parent branch is identified by the `parent_id` value, which is the `id` of the parent branch.
 // For related information, see [Manage branches](https://neon.tech/docs/manage/branches/).
 func (c Client) ListProjectBranches(projectID string, search *string) (ListProjectBranchesRespObj, error) {
+	if projectID == "" {
+		return ListProjectBranchesRespObj{}, newPathParameterError("project_id")
+	}
 	var (
 		queryElements []string
 		query         string
@@ -695,7 +997,7 @@ func (c Client) ListProjectBranches(projectID string, search *string) (ListProje
 		query = "?" + strings.Join(queryElements, "&")
 	}
 	var v ListProjectBranchesRespObj
-	if err := c.requestHandler(c.baseURL+"/projects/"+projectID+"/branches"+query, "GET", nil, &v); err != nil {
+	if err := c.requestHandler(c.baseURL+"/projects/"+url.PathEscape(projectID)+"/branches"+query, "GET", nil, &v); err != nil {
 		return ListProjectBranchesRespObj{}, err
 	}
 	return v, nil
@@ -706,8 +1008,11 @@ func (c Client) ListProjectBranches(projectID string, search *string) (ListProje
 // You can obtain a `project_id` by listing the projects for your Neon account.
 // For information about compute endpoints, see [Manage computes](https://neon.tech/docs/manage/endpoints/).
 func (c Client) ListProjectEndpoints(projectID string) (EndpointsResponse, error) {
+	if projectID == "" {
+		return EndpointsResponse{}, newPathParameterError("project_id")
+	}
 	var v EndpointsResponse
-	if err := c.requestHandler(c.baseURL+"/projects/"+projectID+"/endpoints", "GET", nil, &v); err != nil {
+	if err := c.requestHandler(c.baseURL+"/projects/"+url.PathEscape(projectID)+"/endpoints", "GET", nil, &v); err != nil {
 		return EndpointsResponse{}, err
 	}
 	return v, nil
@@ -719,6 +1024,9 @@ func (c Client) ListProjectEndpoints(projectID string) (EndpointsResponse, error
 // To paginate the response, issue an initial request with a `limit` value.
 // Then, add the `cursor` value that was returned in the response to the next request.
 func (c Client) ListProjectOperations(projectID string, cursor *string, limit *int) (ListOperations, error) {
+	if projectID == "" {
+		return ListOperations{}, newPathParameterError("project_id")
+	}
 	var (
 		queryElements []string
 		query         string
@@ -733,7 +1041,7 @@ func (c Client) ListProjectOperations(projectID string, cursor *string, limit *i
 		query = "?" + strings.Join(queryElements, "&")
 	}
 	var v ListOperations
-	if err := c.requestHandler(c.baseURL+"/projects/"+projectID+"/operations"+query, "GET", nil, &v); err != nil {
+	if err := c.requestHandler(c.baseURL+"/projects/"+url.PathEscape(projectID)+"/operations"+query, "GET", nil, &v); err != nil {
 		return ListOperations{}, err
 	}
 	return v, nil
@@ -741,8 +1049,11 @@ func (c Client) ListProjectOperations(projectID string, cursor *string, limit *i
 
 // ListProjectPermissions Retrieves details about users who have access to the project, including the permission `id`, the granted-to email address, and the date project access was granted.
 func (c Client) ListProjectPermissions(projectID string) (ProjectPermissions, error) {
+	if projectID == "" {
+		return ProjectPermissions{}, newPathParameterError("project_id")
+	}
 	var v ProjectPermissions
-	if err := c.requestHandler(c.baseURL+"/projects/"+projectID+"/permissions", "GET", nil, &v); err != nil {
+	if err := c.requestHandler(c.baseURL+"/projects/"+url.PathEscape(projectID)+"/permissions", "GET", nil, &v); err != nil {
 		return ProjectPermissions{}, err
 	}
 	return v, nil
@@ -809,8 +1120,14 @@ func (c Client) ListSharedProjects(cursor *string, limit *int, search *string) (
 // Only an admin of the organization can perform this action.
 // If another admin is being removed, it will not be allows in case it is the only admin left in the organization.
 func (c Client) RemoveOrganizationMember(orgID string, memberID string) (EmptyResponse, error) {
+	if orgID == "" {
+		return EmptyResponse{}, newPathParameterError("org_id")
+	}
+	if memberID == "" {
+		return EmptyResponse{}, newPathParameterError("member_id")
+	}
 	var v EmptyResponse
-	if err := c.requestHandler(c.baseURL+"/organizations/"+orgID+"/members/"+memberID, "DELETE", nil, &v); err != nil {
+	if err := c.requestHandler(c.baseURL+"/organizations/"+url.PathEscape(orgID)+"/members/"+url.PathEscape(memberID), "DELETE", nil, &v); err != nil {
 		return EmptyResponse{}, err
 	}
 	return v, nil
@@ -826,8 +1143,17 @@ func (c Client) RemoveOrganizationMember(orgID string, memberID string) (EmptyRe
 // You can obtain the `role_name` by listing the roles for a branch.
 // For related information, see [Manage roles](https://neon.tech/docs/manage/roles/).
 func (c Client) ResetProjectBranchRolePassword(projectID string, branchID string, roleName string) (RoleOperations, error) {
+	if projectID == "" {
+		return RoleOperations{}, newPathParameterError("project_id")
+	}
+	if branchID == "" {
+		return RoleOperations{}, newPathParameterError("branch_id")
+	}
+	if roleName == "" {
+		return RoleOperations{}, newPathParameterError("role_name")
+	}
 	var v RoleOperations
-	if err := c.requestHandler(c.baseURL+"/projects/"+projectID+"/branches/"+branchID+"/roles/"+roleName+"/reset_password", "POST", nil, &v); err != nil {
+	if err := c.requestHandler(c.baseURL+"/projects/"+url.PathEscape(projectID)+"/branches/"+url.PathEscape(branchID)+"/roles/"+url.PathEscape(roleName)+"/reset_password", "POST", nil, &v); err != nil {
 		return RoleOperations{}, err
 	}
 	return v, nil
@@ -839,8 +1165,14 @@ func (c Client) ResetProjectBranchRolePassword(projectID string, branchID string
 // An `endpoint_id` has an `ep-` prefix.
 // For information about compute endpoints, see [Manage computes](https://neon.tech/docs/manage/endpoints/).
 func (c Client) RestartProjectEndpoint(projectID string, endpointID string) (EndpointOperations, error) {
+	if projectID == "" {
+		return EndpointOperations{}, newPathParameterError("project_id")
+	}
+	if endpointID == "" {
+		return EndpointOperations{}, newPathParameterError("endpoint_id")
+	}
 	var v EndpointOperations
-	if err := c.requestHandler(c.baseURL+"/projects/"+projectID+"/endpoints/"+endpointID+"/restart", "POST", nil, &v); err != nil {
+	if err := c.requestHandler(c.baseURL+"/projects/"+url.PathEscape(projectID)+"/endpoints/"+url.PathEscape(endpointID)+"/restart", "POST", nil, &v); err != nil {
 		return EndpointOperations{}, err
 	}
 	return v, nil
@@ -848,8 +1180,14 @@ func (c Client) RestartProjectEndpoint(projectID string, endpointID string) (End
 
 // RestoreProjectBranch Restores a branch to an earlier state in its own or another branch's history
 func (c Client) RestoreProjectBranch(projectID string, branchID string, cfg BranchRestoreRequest) (BranchOperations, error) {
+	if projectID == "" {
+		return BranchOperations{}, newPathParameterError("project_id")
+	}
+	if branchID == "" {
+		return BranchOperations{}, newPathParameterError("branch_id")
+	}
 	var v BranchOperations
-	if err := c.requestHandler(c.baseURL+"/projects/"+projectID+"/branches/"+branchID+"/restore", "POST", cfg, &v); err != nil {
+	if err := c.requestHandler(c.baseURL+"/projects/"+url.PathEscape(projectID)+"/branches/"+url.PathEscape(branchID)+"/restore", "POST", cfg, &v); err != nil {
 		return BranchOperations{}, err
 	}
 	return v, nil
@@ -876,8 +1214,11 @@ func (c Client) RevokeApiKey(keyID int64) (ApiKeyRevokeResponse, error) {
 // API keys can also be managed in the Neon Console.
 // See [Manage API keys](https://neon.tech/docs/manage/api-keys/).
 func (c Client) RevokeOrgApiKey(orgID string, keyID int64) (OrgApiKeyRevokeResponse, error) {
+	if orgID == "" {
+		return OrgApiKeyRevokeResponse{}, newPathParameterError("org_id")
+	}
 	var v OrgApiKeyRevokeResponse
-	if err := c.requestHandler(c.baseURL+"/organizations/"+orgID+"/api_keys/"+strconv.FormatInt(keyID, 10), "DELETE", nil, &v); err != nil {
+	if err := c.requestHandler(c.baseURL+"/organizations/"+url.PathEscape(orgID)+"/api_keys/"+strconv.FormatInt(keyID, 10), "DELETE", nil, &v); err != nil {
 		return OrgApiKeyRevokeResponse{}, err
 	}
 	return v, nil
@@ -885,8 +1226,14 @@ func (c Client) RevokeOrgApiKey(orgID string, keyID int64) (OrgApiKeyRevokeRespo
 
 // RevokePermissionFromProject Revokes project access from the user associted with the specified permisison `id`. You can retrieve a user's permission `id` by listing project access.
 func (c Client) RevokePermissionFromProject(projectID string, permissionID string) (ProjectPermission, error) {
+	if projectID == "" {
+		return ProjectPermission{}, newPathParameterError("project_id")
+	}
+	if permissionID == "" {
+		return ProjectPermission{}, newPathParameterError("permission_id")
+	}
 	var v ProjectPermission
-	if err := c.requestHandler(c.baseURL+"/projects/"+projectID+"/permissions/"+permissionID, "DELETE", nil, &v); err != nil {
+	if err := c.requestHandler(c.baseURL+"/projects/"+url.PathEscape(projectID)+"/permissions/"+url.PathEscape(permissionID), "DELETE", nil, &v); err != nil {
 		return ProjectPermission{}, err
 	}
 	return v, nil
@@ -898,8 +1245,14 @@ func (c Client) RevokePermissionFromProject(projectID string, permissionID strin
 // You can obtain the `branch_id` by listing the project's branches.
 // For more information, see [Manage branches](https://neon.tech/docs/manage/branches/).
 func (c Client) SetDefaultProjectBranch(projectID string, branchID string) (BranchOperations, error) {
+	if projectID == "" {
+		return BranchOperations{}, newPathParameterError("project_id")
+	}
+	if branchID == "" {
+		return BranchOperations{}, newPathParameterError("branch_id")
+	}
 	var v BranchOperations
-	if err := c.requestHandler(c.baseURL+"/projects/"+projectID+"/branches/"+branchID+"/set_as_default", "POST", nil, &v); err != nil {
+	if err := c.requestHandler(c.baseURL+"/projects/"+url.PathEscape(projectID)+"/branches/"+url.PathEscape(branchID)+"/set_as_default", "POST", nil, &v); err != nil {
 		return BranchOperations{}, err
 	}
 	return v, nil
@@ -912,8 +1265,14 @@ func (c Client) SetDefaultProjectBranch(projectID string, branchID string) (Bran
 // An `endpoint_id` has an `ep-` prefix.
 // For information about compute endpoints, see [Manage computes](https://neon.tech/docs/manage/endpoints/).
 func (c Client) StartProjectEndpoint(projectID string, endpointID string) (EndpointOperations, error) {
+	if projectID == "" {
+		return EndpointOperations{}, newPathParameterError("project_id")
+	}
+	if endpointID == "" {
+		return EndpointOperations{}, newPathParameterError("endpoint_id")
+	}
 	var v EndpointOperations
-	if err := c.requestHandler(c.baseURL+"/projects/"+projectID+"/endpoints/"+endpointID+"/start", "POST", nil, &v); err != nil {
+	if err := c.requestHandler(c.baseURL+"/projects/"+url.PathEscape(projectID)+"/endpoints/"+url.PathEscape(endpointID)+"/start", "POST", nil, &v); err != nil {
 		return EndpointOperations{}, err
 	}
 	return v, nil
@@ -925,8 +1284,14 @@ func (c Client) StartProjectEndpoint(projectID string, endpointID string) (Endpo
 // An `endpoint_id` has an `ep-` prefix.
 // For information about compute endpoints, see [Manage computes](https://neon.tech/docs/manage/endpoints/).
 func (c Client) SuspendProjectEndpoint(projectID string, endpointID string) (EndpointOperations, error) {
+	if projectID == "" {
+		return EndpointOperations{}, newPathParameterError("project_id")
+	}
+	if endpointID == "" {
+		return EndpointOperations{}, newPathParameterError("endpoint_id")
+	}
 	var v EndpointOperations
-	if err := c.requestHandler(c.baseURL+"/projects/"+projectID+"/endpoints/"+endpointID+"/suspend", "POST", nil, &v); err != nil {
+	if err := c.requestHandler(c.baseURL+"/projects/"+url.PathEscape(projectID)+"/endpoints/"+url.PathEscape(endpointID)+"/suspend", "POST", nil, &v); err != nil {
 		return EndpointOperations{}, err
 	}
 	return v, nil
@@ -943,8 +1308,14 @@ func (c Client) TransferProjectsFromUserToOrg(cfg TransferProjectsToOrganization
 
 // UpdateOrganizationMember Only an admin can perform this action.
 func (c Client) UpdateOrganizationMember(orgID string, memberID string, cfg OrganizationMemberUpdateRequest) (Member, error) {
+	if orgID == "" {
+		return Member{}, newPathParameterError("org_id")
+	}
+	if memberID == "" {
+		return Member{}, newPathParameterError("member_id")
+	}
 	var v Member
-	if err := c.requestHandler(c.baseURL+"/organizations/"+orgID+"/members/"+memberID, "PATCH", cfg, &v); err != nil {
+	if err := c.requestHandler(c.baseURL+"/organizations/"+url.PathEscape(orgID)+"/members/"+url.PathEscape(memberID), "PATCH", cfg, &v); err != nil {
 		return Member{}, err
 	}
 	return v, nil
@@ -954,8 +1325,11 @@ func (c Client) UpdateOrganizationMember(orgID string, memberID string, cfg Orga
 // You can obtain a `project_id` by listing the projects for your Neon account.
 // Neon permits updating the project name only.
 func (c Client) UpdateProject(projectID string, cfg ProjectUpdateRequest) (UpdateProjectRespObj, error) {
+	if projectID == "" {
+		return UpdateProjectRespObj{}, newPathParameterError("project_id")
+	}
 	var v UpdateProjectRespObj
-	if err := c.requestHandler(c.baseURL+"/projects/"+projectID, "PATCH", cfg, &v); err != nil {
+	if err := c.requestHandler(c.baseURL+"/projects/"+url.PathEscape(projectID), "PATCH", cfg, &v); err != nil {
 		return UpdateProjectRespObj{}, err
 	}
 	return v, nil
@@ -966,8 +1340,14 @@ func (c Client) UpdateProject(projectID string, cfg ProjectUpdateRequest) (Updat
 // You can obtain the `branch_id` by listing the project's branches.
 // For more information, see [Manage branches](https://neon.tech/docs/manage/branches/).
 func (c Client) UpdateProjectBranch(projectID string, branchID string, cfg BranchUpdateRequest) (BranchOperations, error) {
+	if projectID == "" {
+		return BranchOperations{}, newPathParameterError("project_id")
+	}
+	if branchID == "" {
+		return BranchOperations{}, newPathParameterError("branch_id")
+	}
 	var v BranchOperations
-	if err := c.requestHandler(c.baseURL+"/projects/"+projectID+"/branches/"+branchID, "PATCH", cfg, &v); err != nil {
+	if err := c.requestHandler(c.baseURL+"/projects/"+url.PathEscape(projectID)+"/branches/"+url.PathEscape(branchID), "PATCH", cfg, &v); err != nil {
 		return BranchOperations{}, err
 	}
 	return v, nil
@@ -978,8 +1358,17 @@ func (c Client) UpdateProjectBranch(projectID string, branchID string, cfg Branc
 // You can obtain the `branch_id` and `database_name` by listing the branch's databases.
 // For related information, see [Manage databases](https://neon.tech/docs/manage/databases/).
 func (c Client) UpdateProjectBranchDatabase(projectID string, branchID string, databaseName string, cfg DatabaseUpdateRequest) (DatabaseOperations, error) {
+	if projectID == "" {
+		return DatabaseOperations{}, newPathParameterError("project_id")
+	}
+	if branchID == "" {
+		return DatabaseOperations{}, newPathParameterError("branch_id")
+	}
+	if databaseName == "" {
+		return DatabaseOperations{}, newPathParameterError("database_name")
+	}
 	var v DatabaseOperations
-	if err := c.requestHandler(c.baseURL+"/projects/"+projectID+"/branches/"+branchID+"/databases/"+databaseName, "PATCH", cfg, &v); err != nil {
+	if err := c.requestHandler(c.baseURL+"/projects/"+url.PathEscape(projectID)+"/branches/"+url.PathEscape(branchID)+"/databases/"+url.PathEscape(databaseName), "PATCH", cfg, &v); err != nil {
 		return DatabaseOperations{}, err
 	}
 	return v, nil
@@ -995,8 +1384,14 @@ func (c Client) UpdateProjectBranchDatabase(projectID string, branchID string, d
 // If the compute endpoint was idle before the update, it becomes active for a short period of time,
 // and the control plane suspends it again after the update.
 func (c Client) UpdateProjectEndpoint(projectID string, endpointID string, cfg EndpointUpdateRequest) (EndpointOperations, error) {
+	if projectID == "" {
+		return EndpointOperations{}, newPathParameterError("project_id")
+	}
+	if endpointID == "" {
+		return EndpointOperations{}, newPathParameterError("endpoint_id")
+	}
 	var v EndpointOperations
-	if err := c.requestHandler(c.baseURL+"/projects/"+projectID+"/endpoints/"+endpointID, "PATCH", cfg, &v); err != nil {
+	if err := c.requestHandler(c.baseURL+"/projects/"+url.PathEscape(projectID)+"/endpoints/"+url.PathEscape(endpointID), "PATCH", cfg, &v); err != nil {
 		return EndpointOperations{}, err
 	}
 	return v, nil
@@ -1058,7 +1453,7 @@ type AnnotationsMapResponse struct {
 	Annotations AnnotationsMapResponseAnnotations `json:"annotations"`
 }
 
-type AnnotationsMapResponseAnnotations map[string]interface{}
+type AnnotationsMapResponseAnnotations map[string]AnnotationData
 
 type ApiKeyCreateRequest struct {
 	// KeyName A user-specified API key name. This value is required when creating an API key.
@@ -319,6 +319,55 @@ func Test_convertErrorResponse(t *testing.T) {
 				},
 			},
 		},
+		{
+			name: "happy path: validation errors keyed by field",
+			args: args{
+				res: &http.Response{
+					StatusCode: http.StatusUnprocessableEntity,
+					Body: io.NopCloser(
+						strings.NewReader(`{"code":"","message":"invalid","errors":{"name":["too long"],"region_id":"unknown"}}`),
+					),
+				},
+			},
+			wantErr: &ValidationError{
+				Fields: map[string][]string{"name": {"too long"}, "region_id": {"unknown"}},
+				err: Error{
+					HTTPCode:  http.StatusUnprocessableEntity,
+					errorResp: errorResp{Message: "invalid"},
+				},
+			},
+		},
+		{
+			name: "happy path: validation errors list",
+			args: args{
+				res: &http.Response{
+					StatusCode: http.StatusUnprocessableEntity,
+					Body: io.NopCloser(
+						strings.NewReader(`{"code":"","message":"invalid","errors":[{"field":"name","message":"too long"}]}`),
+					),
+				},
+			},
+			wantErr: &ValidationError{
+				Fields: map[string][]string{"name": {"too long"}},
+				err: Error{
+					HTTPCode:  http.StatusUnprocessableEntity,
+					errorResp: errorResp{Message: "invalid"},
+				},
+			},
+		},
+		{
+			name: "happy path: unprocessable entity without field errors",
+			args: args{
+				res: &http.Response{
+					StatusCode: http.StatusUnprocessableEntity,
+					Body:       io.NopCloser(strings.NewReader(`{"code":"","message":"invalid"}`)),
+				},
+			},
+			wantErr: Error{
+				HTTPCode:  http.StatusUnprocessableEntity,
+				errorResp: errorResp{Message: "invalid"},
+			},
+		},
 		{
 			name: "unhappy path: faulty body content",
 			args: args{
@@ -332,6 +381,7 @@ func Test_convertErrorResponse(t *testing.T) {
 				errorResp: errorResp{
 					Message: "cannot read response bytes",
 				},
+				err: errors.New("foo"),
 			},
 		},
 		{
@@ -361,6 +411,22 @@ func Test_convertErrorResponse(t *testing.T) {
 	}
 }
 
+func TestValidationError(t *testing.T) {
+	var err error = &ValidationError{
+		Fields: map[string][]string{"region_id": {"unknown"}, "name": {"too long", "invalid characters"}},
+		err:    Error{HTTPCode: http.StatusUnprocessableEntity, errorResp: errorResp{Code: "foo", Message: "invalid"}},
+	}
+	want := "[HTTP Code: 422][Error Code: foo] invalid (name: too long, invalid characters; region_id: unknown)"
+	if err.Error() != want {
+		t.Errorf("Error() = %s, want %s", err.Error(), want)
+	}
+
+	var e Error
+	if !errors.As(err, &e) || e.HTTPCode != http.StatusUnprocessableEntity {
+		t.Errorf("ValidationError is expected to unwrap to Error")
+	}
+}
+
 func TestError_Error(t *testing.T) {
 	type fields struct {
 		HTTPCode  int
@@ -493,7 +559,7 @@ func Test_client_AddProjectJWKS(t *testing.T) {
 	for _, tt := range tests {
 		t.Run(
 			tt.name, func(t *testing.T) {
-				c, err := NewClient(Config{tt.apiKey, NewMockHTTPClient()})
+				c, err := NewClient(Config{Key: tt.apiKey, HTTPClient: NewMockHTTPClient()})
 				if err != nil {
 					panic(err)
 				}
@@ -550,7 +616,7 @@ func Test_client_CreateApiKey(t *testing.T) {
 	for _, tt := range tests {
 		t.Run(
 			tt.name, func(t *testing.T) {
-				c, err := NewClient(Config{tt.apiKey, NewMockHTTPClient()})
+				c, err := NewClient(Config{Key: tt.apiKey, HTTPClient: NewMockHTTPClient()})
 				if err != nil {
 					panic(err)
 				}
@@ -610,7 +676,7 @@ func Test_client_CreateOrgApiKey(t *testing.T) {
 	for _, tt := range tests {
 		t.Run(
 			tt.name, func(t *testing.T) {
-				c, err := NewClient(Config{tt.apiKey, NewMockHTTPClient()})
+				c, err := NewClient(Config{Key: tt.apiKey, HTTPClient: NewMockHTTPClient()})
 				if err != nil {
 					panic(err)
 				}
@@ -670,7 +736,7 @@ func Test_client_CreateOrganizationInvitations(t *testing.T) {
 	for _, tt := range tests {
 		t.Run(
 			tt.name, func(t *testing.T) {
-				c, err := NewClient(Config{tt.apiKey, NewMockHTTPClient()})
+				c, err := NewClient(Config{Key: tt.apiKey, HTTPClient: NewMockHTTPClient()})
 				if err != nil {
 					panic(err)
 				}
@@ -727,7 +793,7 @@ func Test_client_CreateProject(t *testing.T) {
 	for _, tt := range tests {
 		t.Run(
 			tt.name, func(t *testing.T) {
-				c, err := NewClient(Config{tt.apiKey, NewMockHTTPClient()})
+				c, err := NewClient(Config{Key: tt.apiKey, HTTPClient: NewMockHTTPClient()})
 				if err != nil {
 					panic(err)
 				}
@@ -787,7 +853,7 @@ func Test_client_CreateProjectBranch(t *testing.T) {
 	for _, tt := range tests {
 		t.Run(
 			tt.name, func(t *testing.T) {
-				c, err := NewClient(Config{tt.apiKey, NewMockHTTPClient()})
+				c, err := NewClient(Config{Key: tt.apiKey, HTTPClient: NewMockHTTPClient()})
 				if err != nil {
 					panic(err)
 				}
@@ -850,7 +916,7 @@ func Test_client_CreateProjectBranchDatabase(t *testing.T) {
 	for _, tt := range tests {
 		t.Run(
 			tt.name, func(t *testing.T) {
-				c, err := NewClient(Config{tt.apiKey, NewMockHTTPClient()})
+				c, err := NewClient(Config{Key: tt.apiKey, HTTPClient: NewMockHTTPClient()})
 				if err != nil {
 					panic(err)
 				}
@@ -913,7 +979,7 @@ func Test_client_CreateProjectBranchRole(t *testing.T) {
 	for _, tt := range tests {
 		t.Run(
 			tt.name, func(t *testing.T) {
-				c, err := NewClient(Config{tt.apiKey, NewMockHTTPClient()})
+				c, err := NewClient(Config{Key: tt.apiKey, HTTPClient: NewMockHTTPClient()})
 				if err != nil {
 					panic(err)
 				}
@@ -973,7 +1039,7 @@ func Test_client_CreateProjectEndpoint(t *testing.T) {
 	for _, tt := range tests {
 		t.Run(
 			tt.name, func(t *testing.T) {
-				c, err := NewClient(Config{tt.apiKey, NewMockHTTPClient()})
+				c, err := NewClient(Config{Key: tt.apiKey, HTTPClient: NewMockHTTPClient()})
 				if err != nil {
 					panic(err)
 				}
@@ -1030,7 +1096,7 @@ func Test_client_DeleteProject(t *testing.T) {
 	for _, tt := range tests {
 		t.Run(
 			tt.name, func(t *testing.T) {
-				c, err := NewClient(Config{tt.apiKey, NewMockHTTPClient()})
+				c, err := NewClient(Config{Key: tt.apiKey, HTTPClient: NewMockHTTPClient()})
 				if err != nil {
 					panic(err)
 				}
@@ -1090,7 +1156,7 @@ func Test_client_DeleteProjectBranch(t *testing.T) {
 	for _, tt := range tests {
 		t.Run(
 			tt.name, func(t *testing.T) {
-				c, err := NewClient(Config{tt.apiKey, NewMockHTTPClient()})
+				c, err := NewClient(Config{Key: tt.apiKey, HTTPClient: NewMockHTTPClient()})
 				if err != nil {
 					panic(err)
 				}
@@ -1153,7 +1219,7 @@ func Test_client_DeleteProjectBranchDatabase(t *testing.T) {
 	for _, tt := range tests {
 		t.Run(
 			tt.name, func(t *testing.T) {
-				c, err := NewClient(Config{tt.apiKey, NewMockHTTPClient()})
+				c, err := NewClient(Config{Key: tt.apiKey, HTTPClient: NewMockHTTPClient()})
 				if err != nil {
 					panic(err)
 				}
@@ -1216,7 +1282,7 @@ func Test_client_DeleteProjectBranchRole(t *testing.T) {
 	for _, tt := range tests {
 		t.Run(
 			tt.name, func(t *testing.T) {
-				c, err := NewClient(Config{tt.apiKey, NewMockHTTPClient()})
+				c, err := NewClient(Config{Key: tt.apiKey, HTTPClient: NewMockHTTPClient()})
 				if err != nil {
 					panic(err)
 				}
@@ -1276,7 +1342,7 @@ func Test_client_DeleteProjectEndpoint(t *testing.T) {
 	for _, tt := range tests {
 		t.Run(
 			tt.name, func(t *testing.T) {
-				c, err := NewClient(Config{tt.apiKey, NewMockHTTPClient()})
+				c, err := NewClient(Config{Key: tt.apiKey, HTTPClient: NewMockHTTPClient()})
 				if err != nil {
 					panic(err)
 				}
@@ -1336,7 +1402,7 @@ func Test_client_DeleteProjectJWKS(t *testing.T) {
 	for _, tt := range tests {
 		t.Run(
 			tt.name, func(t *testing.T) {
-				c, err := NewClient(Config{tt.apiKey, NewMockHTTPClient()})
+				c, err := NewClient(Config{Key: tt.apiKey, HTTPClient: NewMockHTTPClient()})
 				if err != nil {
 					panic(err)
 				}
@@ -1383,7 +1449,7 @@ func Test_client_GetActiveRegions(t *testing.T) {
 	for _, tt := range tests {
 		t.Run(
 			tt.name, func(t *testing.T) {
-				c, err := NewClient(Config{tt.apiKey, NewMockHTTPClient()})
+				c, err := NewClient(Config{Key: tt.apiKey, HTTPClient: NewMockHTTPClient()})
 				if err != nil {
 					panic(err)
 				}
@@ -1455,7 +1521,7 @@ func Test_client_GetConnectionURI(t *testing.T) {
 	for _, tt := range tests {
 		t.Run(
 			tt.name, func(t *testing.T) {
-				c, err := NewClient(Config{tt.apiKey, NewMockHTTPClient()})
+				c, err := NewClient(Config{Key: tt.apiKey, HTTPClient: NewMockHTTPClient()})
 				if err != nil {
 					panic(err)
 				}
@@ -1524,7 +1590,7 @@ func Test_client_GetConsumptionHistoryPerAccount(t *testing.T) {
 	for _, tt := range tests {
 		t.Run(
 			tt.name, func(t *testing.T) {
-				c, err := NewClient(Config{tt.apiKey, NewMockHTTPClient()})
+				c, err := NewClient(Config{Key: tt.apiKey, HTTPClient: NewMockHTTPClient()})
 				if err != nil {
 					panic(err)
 				}
@@ -1602,7 +1668,7 @@ func Test_client_GetConsumptionHistoryPerProject(t *testing.T) {
 	for _, tt := range tests {
 		t.Run(
 			tt.name, func(t *testing.T) {
-				c, err := NewClient(Config{tt.apiKey, NewMockHTTPClient()})
+				c, err := NewClient(Config{Key: tt.apiKey, HTTPClient: NewMockHTTPClient()})
 				if err != nil {
 					panic(err)
 				}
@@ -1649,7 +1715,7 @@ func Test_client_GetCurrentUserInfo(t *testing.T) {
 	for _, tt := range tests {
 		t.Run(
 			tt.name, func(t *testing.T) {
-				c, err := NewClient(Config{tt.apiKey, NewMockHTTPClient()})
+				c, err := NewClient(Config{Key: tt.apiKey, HTTPClient: NewMockHTTPClient()})
 				if err != nil {
 					panic(err)
 				}
@@ -1696,7 +1762,7 @@ func Test_client_GetCurrentUserOrganizations(t *testing.T) {
 	for _, tt := range tests {
 		t.Run(
 			tt.name, func(t *testing.T) {
-				c, err := NewClient(Config{tt.apiKey, NewMockHTTPClient()})
+				c, err := NewClient(Config{Key: tt.apiKey, HTTPClient: NewMockHTTPClient()})
 				if err != nil {
 					panic(err)
 				}
@@ -1753,7 +1819,7 @@ func Test_client_GetOrganization(t *testing.T) {
 	for _, tt := range tests {
 		t.Run(
 			tt.name, func(t *testing.T) {
-				c, err := NewClient(Config{tt.apiKey, NewMockHTTPClient()})
+				c, err := NewClient(Config{Key: tt.apiKey, HTTPClient: NewMockHTTPClient()})
 				if err != nil {
 					panic(err)
 				}
@@ -1810,7 +1876,7 @@ func Test_client_GetOrganizationInvitations(t *testing.T) {
 	for _, tt := range tests {
 		t.Run(
 			tt.name, func(t *testing.T) {
-				c, err := NewClient(Config{tt.apiKey, NewMockHTTPClient()})
+				c, err := NewClient(Config{Key: tt.apiKey, HTTPClient: NewMockHTTPClient()})
 				if err != nil {
 					panic(err)
 				}
@@ -1870,7 +1936,7 @@ func Test_client_GetOrganizationMember(t *testing.T) {
 	for _, tt := range tests {
 		t.Run(
 			tt.name, func(t *testing.T) {
-				c, err := NewClient(Config{tt.apiKey, NewMockHTTPClient()})
+				c, err := NewClient(Config{Key: tt.apiKey, HTTPClient: NewMockHTTPClient()})
 				if err != nil {
 					panic(err)
 				}
@@ -1927,7 +1993,7 @@ func Test_client_GetOrganizationMembers(t *testing.T) {
 	for _, tt := range tests {
 		t.Run(
 			tt.name, func(t *testing.T) {
-				c, err := NewClient(Config{tt.apiKey, NewMockHTTPClient()})
+				c, err := NewClient(Config{Key: tt.apiKey, HTTPClient: NewMockHTTPClient()})
 				if err != nil {
 					panic(err)
 				}
@@ -1984,7 +2050,7 @@ func Test_client_GetProject(t *testing.T) {
 	for _, tt := range tests {
 		t.Run(
 			tt.name, func(t *testing.T) {
-				c, err := NewClient(Config{tt.apiKey, NewMockHTTPClient()})
+				c, err := NewClient(Config{Key: tt.apiKey, HTTPClient: NewMockHTTPClient()})
 				if err != nil {
 					panic(err)
 				}
@@ -2044,7 +2110,7 @@ func Test_client_GetProjectBranch(t *testing.T) {
 	for _, tt := range tests {
 		t.Run(
 			tt.name, func(t *testing.T) {
-				c, err := NewClient(Config{tt.apiKey, NewMockHTTPClient()})
+				c, err := NewClient(Config{Key: tt.apiKey, HTTPClient: NewMockHTTPClient()})
 				if err != nil {
 					panic(err)
 				}
@@ -2107,7 +2173,7 @@ func Test_client_GetProjectBranchDatabase(t *testing.T) {
 	for _, tt := range tests {
 		t.Run(
 			tt.name, func(t *testing.T) {
-				c, err := NewClient(Config{tt.apiKey, NewMockHTTPClient()})
+				c, err := NewClient(Config{Key: tt.apiKey, HTTPClient: NewMockHTTPClient()})
 				if err != nil {
 					panic(err)
 				}
@@ -2170,7 +2236,7 @@ func Test_client_GetProjectBranchRole(t *testing.T) {
 	for _, tt := range tests {
 		t.Run(
 			tt.name, func(t *testing.T) {
-				c, err := NewClient(Config{tt.apiKey, NewMockHTTPClient()})
+				c, err := NewClient(Config{Key: tt.apiKey, HTTPClient: NewMockHTTPClient()})
 				if err != nil {
 					panic(err)
 				}
@@ -2233,7 +2299,7 @@ func Test_client_GetProjectBranchRolePassword(t *testing.T) {
 	for _, tt := range tests {
 		t.Run(
 			tt.name, func(t *testing.T) {
-				c, err := NewClient(Config{tt.apiKey, NewMockHTTPClient()})
+				c, err := NewClient(Config{Key: tt.apiKey, HTTPClient: NewMockHTTPClient()})
 				if err != nil {
 					panic(err)
 				}
@@ -2302,7 +2368,7 @@ func Test_client_GetProjectBranchSchema(t *testing.T) {
 	for _, tt := range tests {
 		t.Run(
 			tt.name, func(t *testing.T) {
-				c, err := NewClient(Config{tt.apiKey, NewMockHTTPClient()})
+				c, err := NewClient(Config{Key: tt.apiKey, HTTPClient: NewMockHTTPClient()})
 				if err != nil {
 					panic(err)
 				}
@@ -2362,7 +2428,7 @@ func Test_client_GetProjectEndpoint(t *testing.T) {
 	for _, tt := range tests {
 		t.Run(
 			tt.name, func(t *testing.T) {
-				c, err := NewClient(Config{tt.apiKey, NewMockHTTPClient()})
+				c, err := NewClient(Config{Key: tt.apiKey, HTTPClient: NewMockHTTPClient()})
 				if err != nil {
 					panic(err)
 				}
@@ -2419,7 +2485,7 @@ func Test_client_GetProjectJWKS(t *testing.T) {
 	for _, tt := range tests {
 		t.Run(
 			tt.name, func(t *testing.T) {
-				c, err := NewClient(Config{tt.apiKey, NewMockHTTPClient()})
+				c, err := NewClient(Config{Key: tt.apiKey, HTTPClient: NewMockHTTPClient()})
 				if err != nil {
 					panic(err)
 				}
@@ -2479,7 +2545,7 @@ func Test_client_GetProjectOperation(t *testing.T) {
 	for _, tt := range tests {
 		t.Run(
 			tt.name, func(t *testing.T) {
-				c, err := NewClient(Config{tt.apiKey, NewMockHTTPClient()})
+				c, err := NewClient(Config{Key: tt.apiKey, HTTPClient: NewMockHTTPClient()})
 				if err != nil {
 					panic(err)
 				}
@@ -2526,7 +2592,7 @@ func Test_client_ListApiKeys(t *testing.T) {
 	for _, tt := range tests {
 		t.Run(
 			tt.name, func(t *testing.T) {
-				c, err := NewClient(Config{tt.apiKey, NewMockHTTPClient()})
+				c, err := NewClient(Config{Key: tt.apiKey, HTTPClient: NewMockHTTPClient()})
 				if err != nil {
 					panic(err)
 				}
@@ -2583,7 +2649,7 @@ func Test_client_ListOrgApiKeys(t *testing.T) {
 	for _, tt := range tests {
 		t.Run(
 			tt.name, func(t *testing.T) {
-				c, err := NewClient(Config{tt.apiKey, NewMockHTTPClient()})
+				c, err := NewClient(Config{Key: tt.apiKey, HTTPClient: NewMockHTTPClient()})
 				if err != nil {
 					panic(err)
 				}
@@ -2643,7 +2709,7 @@ func Test_client_ListProjectBranchDatabases(t *testing.T) {
 	for _, tt := range tests {
 		t.Run(
 			tt.name, func(t *testing.T) {
-				c, err := NewClient(Config{tt.apiKey, NewMockHTTPClient()})
+				c, err := NewClient(Config{Key: tt.apiKey, HTTPClient: NewMockHTTPClient()})
 				if err != nil {
 					panic(err)
 				}
@@ -2703,7 +2769,7 @@ func Test_client_ListProjectBranchEndpoints(t *testing.T) {
 	for _, tt := range tests {
 		t.Run(
 			tt.name, func(t *testing.T) {
-				c, err := NewClient(Config{tt.apiKey, NewMockHTTPClient()})
+				c, err := NewClient(Config{Key: tt.apiKey, HTTPClient: NewMockHTTPClient()})
 				if err != nil {
 					panic(err)
 				}
@@ -2763,7 +2829,7 @@ func Test_client_ListProjectBranchRoles(t *testing.T) {
 	for _, tt := range tests {
 		t.Run(
 			tt.name, func(t *testing.T) {
-				c, err := NewClient(Config{tt.apiKey, NewMockHTTPClient()})
+				c, err := NewClient(Config{Key: tt.apiKey, HTTPClient: NewMockHTTPClient()})
 				if err != nil {
 					panic(err)
 				}
@@ -2823,7 +2889,7 @@ func Test_client_ListProjectBranches(t *testing.T) {
 	for _, tt := range tests {
 		t.Run(
 			tt.name, func(t *testing.T) {
-				c, err := NewClient(Config{tt.apiKey, NewMockHTTPClient()})
+				c, err := NewClient(Config{Key: tt.apiKey, HTTPClient: NewMockHTTPClient()})
 				if err != nil {
 					panic(err)
 				}
@@ -2880,7 +2946,7 @@ func Test_client_ListProjectEndpoints(t *testing.T) {
 	for _, tt := range tests {
 		t.Run(
 			tt.name, func(t *testing.T) {
-				c, err := NewClient(Config{tt.apiKey, NewMockHTTPClient()})
+				c, err := NewClient(Config{Key: tt.apiKey, HTTPClient: NewMockHTTPClient()})
 				if err != nil {
 					panic(err)
 				}
@@ -2943,7 +3009,7 @@ func Test_client_ListProjectOperations(t *testing.T) {
 	for _, tt := range tests {
 		t.Run(
 			tt.name, func(t *testing.T) {
-				c, err := NewClient(Config{tt.apiKey, NewMockHTTPClient()})
+				c, err := NewClient(Config{Key: tt.apiKey, HTTPClient: NewMockHTTPClient()})
 				if err != nil {
 					panic(err)
 				}
@@ -3009,7 +3075,7 @@ func Test_client_ListProjects(t *testing.T) {
 	for _, tt := range tests {
 		t.Run(
 			tt.name, func(t *testing.T) {
-				c, err := NewClient(Config{tt.apiKey, NewMockHTTPClient()})
+				c, err := NewClient(Config{Key: tt.apiKey, HTTPClient: NewMockHTTPClient()})
 				if err != nil {
 					panic(err)
 				}
@@ -3072,7 +3138,7 @@ func Test_client_ListSharedProjects(t *testing.T) {
 	for _, tt := range tests {
 		t.Run(
 			tt.name, func(t *testing.T) {
-				c, err := NewClient(Config{tt.apiKey, NewMockHTTPClient()})
+				c, err := NewClient(Config{Key: tt.apiKey, HTTPClient: NewMockHTTPClient()})
 				if err != nil {
 					panic(err)
 				}
@@ -3132,7 +3198,7 @@ func Test_client_RemoveOrganizationMember(t *testing.T) {
 	for _, tt := range tests {
 		t.Run(
 			tt.name, func(t *testing.T) {
-				c, err := NewClient(Config{tt.apiKey, NewMockHTTPClient()})
+				c, err := NewClient(Config{Key: tt.apiKey, HTTPClient: NewMockHTTPClient()})
 				if err != nil {
 					panic(err)
 				}
@@ -3195,7 +3261,7 @@ func Test_client_ResetProjectBranchRolePassword(t *testing.T) {
 	for _, tt := range tests {
 		t.Run(
 			tt.name, func(t *testing.T) {
-				c, err := NewClient(Config{tt.apiKey, NewMockHTTPClient()})
+				c, err := NewClient(Config{Key: tt.apiKey, HTTPClient: NewMockHTTPClient()})
 				if err != nil {
 					panic(err)
 				}
@@ -3255,7 +3321,7 @@ func Test_client_RestartProjectEndpoint(t *testing.T) {
 	for _, tt := range tests {
 		t.Run(
 			tt.name, func(t *testing.T) {
-				c, err := NewClient(Config{tt.apiKey, NewMockHTTPClient()})
+				c, err := NewClient(Config{Key: tt.apiKey, HTTPClient: NewMockHTTPClient()})
 				if err != nil {
 					panic(err)
 				}
@@ -3318,7 +3384,7 @@ func Test_client_RestoreProjectBranch(t *testing.T) {
 	for _, tt := range tests {
 		t.Run(
 			tt.name, func(t *testing.T) {
-				c, err := NewClient(Config{tt.apiKey, NewMockHTTPClient()})
+				c, err := NewClient(Config{Key: tt.apiKey, HTTPClient: NewMockHTTPClient()})
 				if err != nil {
 					panic(err)
 				}
@@ -3375,7 +3441,7 @@ func Test_client_RevokeApiKey(t *testing.T) {
 	for _, tt := range tests {
 		t.Run(
 			tt.name, func(t *testing.T) {
-				c, err := NewClient(Config{tt.apiKey, NewMockHTTPClient()})
+				c, err := NewClient(Config{Key: tt.apiKey, HTTPClient: NewMockHTTPClient()})
 				if err != nil {
 					panic(err)
 				}
@@ -3435,7 +3501,7 @@ func Test_client_RevokeOrgApiKey(t *testing.T) {
 	for _, tt := range tests {
 		t.Run(
 			tt.name, func(t *testing.T) {
-				c, err := NewClient(Config{tt.apiKey, NewMockHTTPClient()})
+				c, err := NewClient(Config{Key: tt.apiKey, HTTPClient: NewMockHTTPClient()})
 				if err != nil {
 					panic(err)
 				}
@@ -3495,7 +3561,7 @@ func Test_client_SetDefaultProjectBranch(t *testing.T) {
 	for _, tt := range tests {
 		t.Run(
 			tt.name, func(t *testing.T) {
-				c, err := NewClient(Config{tt.apiKey, NewMockHTTPClient()})
+				c, err := NewClient(Config{Key: tt.apiKey, HTTPClient: NewMockHTTPClient()})
 				if err != nil {
 					panic(err)
 				}
@@ -3555,7 +3621,7 @@ func Test_client_StartProjectEndpoint(t *testing.T) {
 	for _, tt := range tests {
 		t.Run(
 			tt.name, func(t *testing.T) {
-				c, err := NewClient(Config{tt.apiKey, NewMockHTTPClient()})
+				c, err := NewClient(Config{Key: tt.apiKey, HTTPClient: NewMockHTTPClient()})
 				if err != nil {
 					panic(err)
 				}
@@ -3615,7 +3681,7 @@ func Test_client_SuspendProjectEndpoint(t *testing.T) {
 	for _, tt := range tests {
 		t.Run(
 			tt.name, func(t *testing.T) {
-				c, err := NewClient(Config{tt.apiKey, NewMockHTTPClient()})
+				c, err := NewClient(Config{Key: tt.apiKey, HTTPClient: NewMockHTTPClient()})
 				if err != nil {
 					panic(err)
 				}
@@ -3672,7 +3738,7 @@ func Test_client_TransferProjectsFromUserToOrg(t *testing.T) {
 	for _, tt := range tests {
 		t.Run(
 			tt.name, func(t *testing.T) {
-				c, err := NewClient(Config{tt.apiKey, NewMockHTTPClient()})
+				c, err := NewClient(Config{Key: tt.apiKey, HTTPClient: NewMockHTTPClient()})
 				if err != nil {
 					panic(err)
 				}
@@ -3735,7 +3801,7 @@ func Test_client_UpdateOrganizationMember(t *testing.T) {
 	for _, tt := range tests {
 		t.Run(
 			tt.name, func(t *testing.T) {
-				c, err := NewClient(Config{tt.apiKey, NewMockHTTPClient()})
+				c, err := NewClient(Config{Key: tt.apiKey, HTTPClient: NewMockHTTPClient()})
 				if err != nil {
 					panic(err)
 				}
@@ -3795,7 +3861,7 @@ func Test_client_UpdateProject(t *testing.T) {
 	for _, tt := range tests {
 		t.Run(
 			tt.name, func(t *testing.T) {
-				c, err := NewClient(Config{tt.apiKey, NewMockHTTPClient()})
+				c, err := NewClient(Config{Key: tt.apiKey, HTTPClient: NewMockHTTPClient()})
 				if err != nil {
 					panic(err)
 				}
@@ -3858,7 +3924,7 @@ func Test_client_UpdateProjectBranch(t *testing.T) {
 	for _, tt := range tests {
 		t.Run(
 			tt.name, func(t *testing.T) {
-				c, err := NewClient(Config{tt.apiKey, NewMockHTTPClient()})
+				c, err := NewClient(Config{Key: tt.apiKey, HTTPClient: NewMockHTTPClient()})
 				if err != nil {
 					panic(err)
 				}
@@ -3924,7 +3990,7 @@ func Test_client_UpdateProjectBranchDatabase(t *testing.T) {
 	for _, tt := range tests {
 		t.Run(
 			tt.name, func(t *testing.T) {
-				c, err := NewClient(Config{tt.apiKey, NewMockHTTPClient()})
+				c, err := NewClient(Config{Key: tt.apiKey, HTTPClient: NewMockHTTPClient()})
 				if err != nil {
 					panic(err)
 				}
@@ -3987,7 +4053,7 @@ func Test_client_UpdateProjectEndpoint(t *testing.T) {
 	for _, tt := range tests {
 		t.Run(
 			tt.name, func(t *testing.T) {
-				c, err := NewClient(Config{tt.apiKey, NewMockHTTPClient()})
+				c, err := NewClient(Config{Key: tt.apiKey, HTTPClient: NewMockHTTPClient()})
 				if err != nil {
 					panic(err)
 				}
@@ -4013,13 +4079,14 @@ func TestTypes(t *testing.T) {
 
 	// THEN
 	// optional fields of the complex types
-	// are expected to be pointers to the underlying structs
-	if reflect.TypeOf(EndpointCreateRequestEndpoint{}.Settings).Kind() != reflect.Ptr {
-		t.Errorf("EndpointCreateRequestEndpoint{}.Settings must be pointer")
+	// are expected to be pointers to the underlying structs, or Optional if requested
+	optionalKind, optionalKindName := reflect.Ptr, "pointer"
+	if reflect.TypeOf(EndpointCreateRequestEndpoint{}.Settings).Kind() != optionalKind {
+		t.Errorf("EndpointCreateRequestEndpoint{}.Settings must be %s", optionalKindName)
 	}
 
-	if reflect.TypeOf(EndpointUpdateRequestEndpoint{}.Settings).Kind() != reflect.Ptr {
-		t.Errorf("EndpointUpdateRequestEndpoint{}.Settings must be pointer")
+	if reflect.TypeOf(EndpointUpdateRequestEndpoint{}.Settings).Kind() != optionalKind {
+		t.Errorf("EndpointUpdateRequestEndpoint{}.Settings must be %s", optionalKindName)
 	}
 }
 
@@ -0,0 +1,164 @@
+package sdk
+
+import (
+	"context"
+	"fmt"
+	"sort"
+)
+
+// SettingsDrift the difference between the desired and the actual value of the project's setting.
+type SettingsDrift struct {
+	// Setting the JSON path of the setting, e.g. "quota.active_time_seconds".
+	Setting string
+	// Desired the desired value of the setting.
+	Desired interface{}
+	// Actual the actual value of the setting, nil if not set.
+	Actual interface{}
+}
+
+func (d SettingsDrift) String() string {
+	return fmt.Sprintf("%s: desired %v, actual %v", d.Setting, d.Desired, d.Actual)
+}
+
+// DetectSettingsDrift compares the project's settings with the desired settings and returns the differences.
+// Only the settings set in desired are compared, e.g. the quota is not checked if desired.Quota is nil.
+// The lists of allowed IPs and of the maintenance window's weekdays are compared regardless of the elements' order.
+// The boolean and numeric settings not set in the project are compared as the API default, i.e. false and zero.
+func (c Client) DetectSettingsDrift(
+	ctx context.Context, projectID string, desired ProjectSettingsData,
+) ([]SettingsDrift, error) {
+	if err := ctx.Err(); err != nil {
+		return nil, err
+	}
+
+	resp, err := c.GetProject(projectID)
+	if err != nil {
+		return nil, fmt.Errorf("could not read project %s: %w", projectID, err)
+	}
+
+	actual := ProjectSettingsData{}
+	if resp.Project.Settings != nil {
+		actual = *resp.Project.Settings
+	}
+	return diffProjectSettings(desired, actual), nil
+}
+
+func diffProjectSettings(desired, actual ProjectSettingsData) []SettingsDrift {
+	var o []SettingsDrift
+	add := func(setting string, desired, actual interface{}) {
+		o = append(o, SettingsDrift{Setting: setting, Desired: desired, Actual: actual})
+	}
+
+	if desired.AllowedIps != nil {
+		var a AllowedIps
+		if actual.AllowedIps != nil {
+			a = *actual.AllowedIps
+		}
+		if d := desired.AllowedIps.Ips; d != nil {
+			var ips []string
+			if a.Ips != nil {
+				ips = *a.Ips
+			}
+			if !equalStringSets(*d, ips) {
+				add("allowed_ips.ips", *d, ips)
+			}
+		}
+		diffBool(
+			add, "allowed_ips.protected_branches_only", desired.AllowedIps.ProtectedBranchesOnly,
+			a.ProtectedBranchesOnly,
+		)
+	}
+
+	diffBool(add, "block_public_connections", desired.BlockPublicConnections, actual.BlockPublicConnections)
+	diffBool(add, "block_vpc_connections", desired.BlockVpcConnections, actual.BlockVpcConnections)
+	diffBool(add, "enable_logical_replication", desired.EnableLogicalReplication, actual.EnableLogicalReplication)
+
+	if d := desired.MaintenanceWindow; d != nil {
+		a := actual.MaintenanceWindow
+		if a == nil {
+			add("maintenance_window", *d, nil)
+		} else {
+			if d.StartTime != a.StartTime {
+				add("maintenance_window.start_time", d.StartTime, a.StartTime)
+			}
+			if d.EndTime != a.EndTime {
+				add("maintenance_window.end_time", d.EndTime, a.EndTime)
+			}
+			if !equalIntSets(d.Weekdays, a.Weekdays) {
+				add("maintenance_window.weekdays", d.Weekdays, a.Weekdays)
+			}
+		}
+	}
+
+	if d := desired.Quota; d != nil {
+		var a ProjectQuota
+		if actual.Quota != nil {
+			a = *actual.Quota
+		}
+		diffInt64(add, "quota.active_time_seconds", d.ActiveTimeSeconds, a.ActiveTimeSeconds)
+		diffInt64(add, "quota.compute_time_seconds", d.ComputeTimeSeconds, a.ComputeTimeSeconds)
+		diffInt64(add, "quota.data_transfer_bytes", d.DataTransferBytes, a.DataTransferBytes)
+		diffInt64(add, "quota.logical_size_bytes", d.LogicalSizeBytes, a.LogicalSizeBytes)
+		diffInt64(add, "quota.written_data_bytes", d.WrittenDataBytes, a.WrittenDataBytes)
+	}
+
+	return o
+}
+
+func diffBool(add func(string, interface{}, interface{}), setting string, desired, actual *bool) {
+	if desired == nil {
+		return
+	}
+	switch {
+	case actual == nil:
+		if *desired {
+			add(setting, *desired, nil)
+		}
+	case *desired != *actual:
+		add(setting, *desired, *actual)
+	}
+}
+
+func diffInt64(add func(string, interface{}, interface{}), setting string, desired, actual *int64) {
+	if desired == nil {
+		return
+	}
+	switch {
+	case actual == nil:
+		if *desired != 0 {
+			add(setting, *desired, nil)
+		}
+	case *desired != *actual:
+		add(setting, *desired, *actual)
+	}
+}
+
+func equalStringSets(a, b []string) bool {
+	if len(a) != len(b) {
+		return false
+	}
+	a, b = append([]string(nil), a...), append([]string(nil), b...)
+	sort.Strings(a)
+	sort.Strings(b)
+	for i := range a {
+		if a[i] != b[i] {
+			return false
+		}
+	}
+	return true
+}
+
+func equalIntSets(a, b []int) bool {
+	if len(a) != len(b) {
+		return false
+	}
+	a, b = append([]int(nil), a...), append([]int(nil), b...)
+	sort.Ints(a)
+	sort.Ints(b)
+	for i := range a {
+		if a[i] != b[i] {
+			return false
+		}
+	}
+	return true
+}
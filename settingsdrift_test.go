@@ -0,0 +1,84 @@
+package sdk
+
+import (
+	"context"
+	"net/http"
+	"reflect"
+	"testing"
+)
+
+func TestClient_DetectSettingsDrift(t *testing.T) {
+	api := newFakeAPI().on(
+		http.MethodGet, "/projects/foo", http.StatusOK, `{"project": {"id": "foo", "settings": {
+"allowed_ips": {"ips": ["10.0.0.2", "10.0.0.1"], "protected_branches_only": false},
+"enable_logical_replication": false,
+"maintenance_window": {"start_time": "01:00", "end_time": "02:00", "weekdays": [7, 1]},
+"quota": {"active_time_seconds": 100}
+}}}`,
+	)
+	c, err := NewClient(Config{Key: "foo", HTTPClient: api})
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	var (
+		yes, no  = true, false
+		zero     = int64(0)
+		active   = int64(100)
+		compute  = int64(50)
+		ipsEqual = []string{"10.0.0.1", "10.0.0.2"}
+		ipsDrift = []string{"10.0.0.1"}
+	)
+
+	tests := []struct {
+		name    string
+		desired ProjectSettingsData
+		want    []SettingsDrift
+	}{
+		{
+			name: "no drift",
+			desired: ProjectSettingsData{
+				AllowedIps:               &AllowedIps{Ips: &ipsEqual, ProtectedBranchesOnly: &no},
+				BlockPublicConnections:   &no,
+				EnableLogicalReplication: &no,
+				MaintenanceWindow:        &MaintenanceWindow{StartTime: "01:00", EndTime: "02:00", Weekdays: []int{1, 7}},
+				Quota:                    &ProjectQuota{ActiveTimeSeconds: &active, ComputeTimeSeconds: &zero},
+			},
+		},
+		{
+			name:    "unset settings are not compared",
+			desired: ProjectSettingsData{},
+		},
+		{
+			name: "drift",
+			desired: ProjectSettingsData{
+				AllowedIps:               &AllowedIps{Ips: &ipsDrift},
+				BlockPublicConnections:   &yes,
+				EnableLogicalReplication: &yes,
+				MaintenanceWindow:        &MaintenanceWindow{StartTime: "03:00", EndTime: "02:00", Weekdays: []int{1}},
+				Quota:                    &ProjectQuota{ActiveTimeSeconds: &active, ComputeTimeSeconds: &compute},
+			},
+			want: []SettingsDrift{
+				{Setting: "allowed_ips.ips", Desired: ipsDrift, Actual: []string{"10.0.0.2", "10.0.0.1"}},
+				{Setting: "block_public_connections", Desired: true, Actual: nil},
+				{Setting: "enable_logical_replication", Desired: true, Actual: false},
+				{Setting: "maintenance_window.start_time", Desired: "03:00", Actual: "01:00"},
+				{Setting: "maintenance_window.weekdays", Desired: []int{1}, Actual: []int{7, 1}},
+				{Setting: "quota.compute_time_seconds", Desired: int64(50), Actual: nil},
+			},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(
+			tt.name, func(t *testing.T) {
+				got, err := c.DetectSettingsDrift(context.TODO(), "foo", tt.desired)
+				if err != nil {
+					t.Fatal(err)
+				}
+				if !reflect.DeepEqual(got, tt.want) {
+					t.Errorf("DetectSettingsDrift() got = %v, want %v", got, tt.want)
+				}
+			},
+		)
+	}
+}
@@ -0,0 +1,125 @@
+package sdk
+
+import (
+	"context"
+	"fmt"
+	"sort"
+	"sync"
+)
+
+// defaultSuspendTimeoutSeconds the suspend timeout applied by the API when the endpoint's timeout is 0.
+const defaultSuspendTimeoutSeconds SuspendTimeoutSeconds = 300
+
+// SuspendPolicy defines the bounds of the endpoints' suspend timeout enforced by EnforceSuspendPolicy.
+// The endpoint's timeout 0 is evaluated as the API's default of 300 seconds, and -1, i.e. never suspend,
+// as the timeout exceeding any maximum.
+type SuspendPolicy struct {
+	// Min the minimum timeout, it is not checked if it is not positive.
+	Min SuspendTimeoutSeconds
+	// Max the maximum timeout, it is not checked if it is not positive.
+	Max SuspendTimeoutSeconds
+	// AllowNeverSuspend keeps the endpoints which are never suspended regardless of the maximum.
+	AllowNeverSuspend bool
+	// DryRun reports the changes without applying them.
+	DryRun bool
+	// Batch defines how the projects are processed.
+	Batch BatchOptions
+}
+
+// target returns the timeout to set, and the flag indicating whether the timeout violates the policy.
+func (p SuspendPolicy) target(v SuspendTimeoutSeconds) (SuspendTimeoutSeconds, bool) {
+	effective := v
+	if effective == 0 {
+		effective = defaultSuspendTimeoutSeconds
+	}
+	switch {
+	case effective < 0:
+		if p.Max > 0 && !p.AllowNeverSuspend {
+			return p.Max, true
+		}
+	case p.Min > 0 && effective < p.Min:
+		return p.Min, true
+	case p.Max > 0 && effective > p.Max:
+		return p.Max, true
+	}
+	return v, false
+}
+
+// SuspendPolicyChange the change of the endpoint's suspend timeout.
+type SuspendPolicyChange struct {
+	ProjectID  string                `json:"project_id"`
+	BranchID   string                `json:"branch_id"`
+	EndpointID string                `json:"endpoint_id"`
+	From       SuspendTimeoutSeconds `json:"from"`
+	To         SuspendTimeoutSeconds `json:"to"`
+}
+
+// EnforceSuspendPolicy scans the endpoints of the projects in scope, and sets the suspend timeout of the endpoints
+// violating the policy to the nearest bound. It returns the applied changes sorted by the project and the endpoint,
+// or the planned changes in the dry-run mode. If a change fails, the changes applied until the failure are returned
+// along with the error.
+func (c Client) EnforceSuspendPolicy(
+	ctx context.Context, scope ListAllProjectsOptions, policy SuspendPolicy,
+) ([]SuspendPolicyChange, error) {
+	var (
+		mu sync.Mutex
+		o  []SuspendPolicyChange
+	)
+	err := c.ForEachProject(
+		ctx, scope, policy.Batch, func(ctx context.Context, p ProjectListItem) error {
+			if err := ctx.Err(); err != nil {
+				return err
+			}
+			resp, err := c.ListProjectEndpoints(p.ID)
+			if err != nil {
+				return fmt.Errorf("could not list endpoints of project %s: %w", p.ID, err)
+			}
+
+			for _, ep := range resp.Endpoints {
+				to, violates := policy.target(ep.SuspendTimeoutSeconds)
+				if !violates {
+					continue
+				}
+				change := SuspendPolicyChange{
+					ProjectID: p.ID, BranchID: ep.BranchID, EndpointID: ep.ID, From: ep.SuspendTimeoutSeconds, To: to,
+				}
+				if !policy.DryRun {
+					if err := c.setSuspendTimeout(ctx, p.ID, ep.ID, to); err != nil {
+						return err
+					}
+				}
+				mu.Lock()
+				o = append(o, change)
+				mu.Unlock()
+			}
+			return nil
+		},
+	)
+	sort.Slice(
+		o, func(i, j int) bool {
+			if o[i].ProjectID != o[j].ProjectID {
+				return o[i].ProjectID < o[j].ProjectID
+			}
+			return o[i].EndpointID < o[j].EndpointID
+		},
+	)
+	return o, err
+}
+
+func (c Client) setSuspendTimeout(ctx context.Context, projectID, endpointID string, v SuspendTimeoutSeconds) error {
+	resp, err := callUnlessLocked(
+		ctx, func() (EndpointOperations, error) {
+			return c.UpdateProjectEndpoint(
+				projectID, endpointID,
+				EndpointUpdateRequest{Endpoint: EndpointUpdateRequestEndpoint{SuspendTimeoutSeconds: &v}},
+			)
+		},
+	)
+	if err != nil {
+		return fmt.Errorf("could not update suspend timeout of endpoint %s: %w", endpointID, err)
+	}
+	if err := c.WaitForOperations(ctx, projectID, resp.Operations); err != nil {
+		return fmt.Errorf("endpoint %s: %w", endpointID, err)
+	}
+	return nil
+}
@@ -0,0 +1,147 @@
+package sdk
+
+import (
+	"context"
+	"encoding/json"
+	"io"
+	"net/http"
+	"reflect"
+	"sync"
+	"testing"
+)
+
+func TestSuspendPolicy_target(t *testing.T) {
+	tests := []struct {
+		name         string
+		policy       SuspendPolicy
+		v            SuspendTimeoutSeconds
+		want         SuspendTimeoutSeconds
+		wantViolates bool
+	}{
+		{
+			name:   "within bounds",
+			policy: SuspendPolicy{Min: 60, Max: 600},
+			v:      120,
+			want:   120,
+		},
+		{
+			name:         "below minimum",
+			policy:       SuspendPolicy{Min: 300},
+			v:            60,
+			want:         300,
+			wantViolates: true,
+		},
+		{
+			name:         "default exceeds maximum",
+			policy:       SuspendPolicy{Max: 120},
+			v:            0,
+			want:         120,
+			wantViolates: true,
+		},
+		{
+			name:         "never suspended",
+			policy:       SuspendPolicy{Max: 3600},
+			v:            -1,
+			want:         3600,
+			wantViolates: true,
+		},
+		{
+			name:   "never suspended allowed",
+			policy: SuspendPolicy{Max: 3600, AllowNeverSuspend: true},
+			v:      -1,
+			want:   -1,
+		},
+		{
+			name:   "no bounds",
+			policy: SuspendPolicy{},
+			v:      -1,
+			want:   -1,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(
+			tt.name, func(t *testing.T) {
+				got, violates := tt.policy.target(tt.v)
+				if got != tt.want || violates != tt.wantViolates {
+					t.Errorf("target() = %v, %v, want %v, %v", got, violates, tt.want, tt.wantViolates)
+				}
+			},
+		)
+	}
+}
+
+func TestClient_EnforceSuspendPolicy(t *testing.T) {
+	tests := []struct {
+		name        string
+		dryRun      bool
+		wantUpdates map[string]SuspendTimeoutSeconds
+	}{
+		{
+			name:        "enforced",
+			wantUpdates: map[string]SuspendTimeoutSeconds{"ep-never": 3600, "ep-short": 120},
+		},
+		{
+			name:        "dry run",
+			dryRun:      true,
+			wantUpdates: map[string]SuspendTimeoutSeconds{},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(
+			tt.name, func(t *testing.T) {
+				var mu sync.Mutex
+				gotUpdates := map[string]SuspendTimeoutSeconds{}
+				update := func(endpointID string) func(req *http.Request) (int, string) {
+					return func(req *http.Request) (int, string) {
+						var v EndpointUpdateRequest
+						b, _ := io.ReadAll(req.Body)
+						_ = json.Unmarshal(b, &v)
+						mu.Lock()
+						gotUpdates[endpointID] = *v.Endpoint.SuspendTimeoutSeconds
+						mu.Unlock()
+						return http.StatusOK, `{"endpoint":{"id":"` + endpointID + `"},"operations":[]}`
+					}
+				}
+
+				api := newFakeAPI().
+					on(http.MethodGet, "/projects", http.StatusOK, `{"projects":[{"id":"foo"},{"id":"bar"}]}`).
+					on(
+						http.MethodGet, "/projects/foo/endpoints", http.StatusOK, `{"endpoints":[
+{"id":"ep-never","branch_id":"br-foo","suspend_timeout_seconds":-1},
+{"id":"ep-ok","branch_id":"br-foo","suspend_timeout_seconds":0}
+]}`,
+					).
+					on(
+						http.MethodGet, "/projects/bar/endpoints", http.StatusOK,
+						`{"endpoints":[{"id":"ep-short","branch_id":"br-bar","suspend_timeout_seconds":60}]}`,
+					).
+					onFunc(http.MethodPatch, "/projects/foo/endpoints/ep-never", update("ep-never")).
+					onFunc(http.MethodPatch, "/projects/bar/endpoints/ep-short", update("ep-short"))
+
+				c, err := NewClient(Config{Key: "foo", HTTPClient: api})
+				if err != nil {
+					t.Fatal(err)
+				}
+
+				got, err := c.EnforceSuspendPolicy(
+					context.TODO(), ListAllProjectsOptions{},
+					SuspendPolicy{Min: 120, Max: 3600, DryRun: tt.dryRun},
+				)
+				if err != nil {
+					t.Fatal(err)
+				}
+
+				want := []SuspendPolicyChange{
+					{ProjectID: "bar", BranchID: "br-bar", EndpointID: "ep-short", From: 60, To: 120},
+					{ProjectID: "foo", BranchID: "br-foo", EndpointID: "ep-never", From: -1, To: 3600},
+				}
+				if !reflect.DeepEqual(got, want) {
+					t.Errorf("EnforceSuspendPolicy() = %+v, want %+v", got, want)
+				}
+				if !reflect.DeepEqual(gotUpdates, tt.wantUpdates) {
+					t.Errorf("unexpected updates: %v, want %v", gotUpdates, tt.wantUpdates)
+				}
+			},
+		)
+	}
+}
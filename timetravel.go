@@ -0,0 +1,159 @@
+package sdk
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"net/url"
+	"time"
+)
+
+// ErrInvalidTimeTravelPoint the error returned when the point in time of the time-travel query is not defined.
+var ErrInvalidTimeTravelPoint = errors.New("invalid time-travel point")
+
+// TimeTravelPoint defines the point in the branch's history to query.
+// Exactly one of the attributes must be set.
+type TimeTravelPoint struct {
+	Timestamp *time.Time
+	Lsn       *Lsn
+}
+
+func (p TimeTravelPoint) validate() error {
+	if (p.Timestamp == nil) == (p.Lsn == nil) {
+		return fmt.Errorf("%w: either timestamp, or LSN must be set", ErrInvalidTimeTravelPoint)
+	}
+	return nil
+}
+
+// connectionOption returns the value of the connection's "options" parameter pinning the point in time.
+func (p TimeTravelPoint) connectionOption() string {
+	if p.Lsn != nil {
+		return "neon_lsn:" + p.Lsn.String()
+	}
+	return "neon_timestamp:" + p.Timestamp.UTC().Format(time.RFC3339)
+}
+
+// TimeTravelMechanism defines how the historical state of the branch is queried.
+type TimeTravelMechanism string
+
+const (
+	// TimeTravelAuto selects the mechanism currently supported by Neon, i.e. TimeTravelConnectionOptions.
+	TimeTravelAuto TimeTravelMechanism = ""
+	// TimeTravelConnectionOptions sets the point in time using the connection's "options" parameter.
+	TimeTravelConnectionOptions TimeTravelMechanism = "connection_options"
+	// TimeTravelTemporaryBranch creates the temporary branch with the read-only endpoint at the point in time.
+	TimeTravelTemporaryBranch TimeTravelMechanism = "temporary_branch"
+)
+
+// TimeTravelOptions defines the time-travel query connection.
+type TimeTravelOptions struct {
+	DatabaseName string
+	RoleName     string
+	Mechanism    TimeTravelMechanism
+}
+
+// TimeTravelConnection the connection to query the historical state of the branch.
+// It must be closed when the queries are completed to release the temporary resources.
+type TimeTravelConnection struct {
+	// URI the connection URI.
+	URI string
+	// BranchID the ID of the temporary branch, empty unless the temporary branch was created.
+	BranchID string
+
+	client    Client
+	projectID string
+}
+
+// Close deletes the temporary branch if it was created.
+func (t TimeTravelConnection) Close() error {
+	if t.BranchID == "" {
+		return nil
+	}
+	if _, err := t.client.DeleteProjectBranch(t.projectID, t.BranchID); err != nil && !isNotFound(err) {
+		return fmt.Errorf("could not delete temporary branch %s: %w", t.BranchID, err)
+	}
+	return nil
+}
+
+// GetTimeTravelConnection returns the connection to run the read-only queries against the branch's state
+// at the point in time. Note that the point in time must be within the project's history retention period.
+func (c Client) GetTimeTravelConnection(
+	ctx context.Context, projectID, branchID string, at TimeTravelPoint, opts TimeTravelOptions,
+) (TimeTravelConnection, error) {
+	if err := at.validate(); err != nil {
+		return TimeTravelConnection{}, err
+	}
+	if err := ctx.Err(); err != nil {
+		return TimeTravelConnection{}, err
+	}
+
+	switch opts.Mechanism {
+	case TimeTravelAuto, TimeTravelConnectionOptions:
+		return c.timeTravelConnectionOptions(projectID, branchID, at, opts)
+	case TimeTravelTemporaryBranch:
+		return c.timeTravelTemporaryBranch(ctx, projectID, branchID, at, opts)
+	default:
+		return TimeTravelConnection{}, fmt.Errorf("unknown time-travel mechanism %q", opts.Mechanism)
+	}
+}
+
+func (c Client) timeTravelConnectionOptions(
+	projectID, branchID string, at TimeTravelPoint, opts TimeTravelOptions,
+) (TimeTravelConnection, error) {
+	resp, err := c.GetConnectionURI(projectID, &branchID, nil, opts.DatabaseName, opts.RoleName, nil)
+	if err != nil {
+		return TimeTravelConnection{}, fmt.Errorf("could not read connection URI of branch %s: %w", branchID, err)
+	}
+
+	u, err := url.Parse(resp.URI)
+	if err != nil {
+		return TimeTravelConnection{}, fmt.Errorf("could not parse connection URI of branch %s: %w", branchID, err)
+	}
+	q := u.Query()
+	option := at.connectionOption()
+	if v := q.Get("options"); v != "" {
+		option = v + " " + option
+	}
+	q.Set("options", option)
+	u.RawQuery = q.Encode()
+
+	return TimeTravelConnection{URI: u.String(), client: c, projectID: projectID}, nil
+}
+
+func (c Client) timeTravelTemporaryBranch(
+	ctx context.Context, projectID, branchID string, at TimeTravelPoint, opts TimeTravelOptions,
+) (TimeTravelConnection, error) {
+	branch := BranchCreateRequestBranch{ParentID: &branchID, ParentTimestamp: at.Timestamp}
+	if at.Lsn != nil {
+		lsn := at.Lsn.String()
+		branch.ParentLsn = &lsn
+	}
+
+	created, err := c.CreateProjectBranch(
+		projectID, &CreateProjectBranchReqObj{
+			BranchCreateRequest: BranchCreateRequest{
+				Branch:    &branch,
+				Endpoints: &[]BranchCreateRequestEndpointOptions{{Type: EndpointTypeReadOnly}},
+			},
+		},
+	)
+	if err != nil {
+		return TimeTravelConnection{}, fmt.Errorf("could not create temporary branch: %w", err)
+	}
+
+	o := TimeTravelConnection{BranchID: created.Branch.ID, client: c, projectID: projectID}
+	if err := c.WaitForOperations(ctx, projectID, created.Operations); err != nil {
+		_ = o.Close()
+		return TimeTravelConnection{}, err
+	}
+
+	resp, err := c.GetConnectionURI(projectID, &o.BranchID, nil, opts.DatabaseName, opts.RoleName, nil)
+	if err != nil {
+		_ = o.Close()
+		return TimeTravelConnection{}, fmt.Errorf(
+			"could not read connection URI of temporary branch %s: %w", o.BranchID, err,
+		)
+	}
+	o.URI = resp.URI
+	return o, nil
+}
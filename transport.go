@@ -0,0 +1,47 @@
+package sdk
+
+import (
+	"net"
+	"net/http"
+	"time"
+)
+
+// transport returns the transport of the default HTTP client, nil if the default transport shall be used.
+func (cfg Config) transport() http.RoundTripper {
+	var o http.RoundTripper
+	if cfg.TLSConfig != nil || cfg.ProxyURL != nil {
+		t := cloneDefaultTransport()
+		if cfg.TLSConfig != nil {
+			t.TLSClientConfig = cfg.TLSConfig.Clone()
+		}
+		if cfg.ProxyURL != nil {
+			t.Proxy = http.ProxyURL(cfg.ProxyURL)
+		}
+		o = t
+	}
+
+	if cfg.ConcurrencyLimit != nil && cfg.ConcurrencyLimit.enabled() {
+		o = NewConcurrencyLimitedTransport(o, *cfg.ConcurrencyLimit)
+	}
+	return o
+}
+
+// cloneDefaultTransport returns the copy of http.DefaultTransport, or the transport with the same defaults
+// if http.DefaultTransport was replaced, e.g. by the instrumentation library.
+func cloneDefaultTransport() *http.Transport {
+	if t, ok := http.DefaultTransport.(*http.Transport); ok {
+		return t.Clone()
+	}
+	return &http.Transport{
+		Proxy: http.ProxyFromEnvironment,
+		DialContext: (&net.Dialer{
+			Timeout:   30 * time.Second,
+			KeepAlive: 30 * time.Second,
+		}).DialContext,
+		ForceAttemptHTTP2:     true,
+		MaxIdleConns:          100,
+		IdleConnTimeout:       90 * time.Second,
+		TLSHandshakeTimeout:   10 * time.Second,
+		ExpectContinueTimeout: 1 * time.Second,
+	}
+}
@@ -0,0 +1,88 @@
+package sdk
+
+import (
+	"crypto/tls"
+	"net/http"
+	"net/url"
+	"testing"
+)
+
+func TestNewClient_transport(t *testing.T) {
+	proxyURL, _ := url.Parse("http://proxy.example.com:3128")
+
+	tests := []struct {
+		name      string
+		cfg       Config
+		wantProxy string
+		wantTLS   bool
+		wantErr   bool
+	}{
+		{
+			name: "default transport",
+			cfg:  Config{Key: "foo"},
+		},
+		{
+			name:      "custom TLS and proxy",
+			cfg:       Config{Key: "foo", TLSConfig: &tls.Config{ServerName: "console.neon.tech"}, ProxyURL: proxyURL},
+			wantProxy: proxyURL.String(),
+			wantTLS:   true,
+		},
+		{
+			name:    "unhappy path: custom TLS with custom HTTP client",
+			cfg:     Config{Key: "foo", HTTPClient: &http.Client{}, TLSConfig: &tls.Config{}},
+			wantErr: true,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(
+			tt.name, func(t *testing.T) {
+				c, err := NewClient(tt.cfg)
+				if (err != nil) != tt.wantErr {
+					t.Fatalf("NewClient() error = %v, wantErr %v", err, tt.wantErr)
+				}
+				if tt.wantErr {
+					return
+				}
+
+				httpClient := c.cfg.HTTPClient.(*http.Client)
+				if httpClient.Transport == nil {
+					if tt.wantTLS || tt.wantProxy != "" {
+						t.Fatal("custom transport expected")
+					}
+					return
+				}
+
+				transport := httpClient.Transport.(*http.Transport)
+				if tt.wantTLS && (transport.TLSClientConfig == nil ||
+					transport.TLSClientConfig.ServerName != tt.cfg.TLSConfig.ServerName) {
+					t.Errorf("unexpected TLS config: %v", transport.TLSClientConfig)
+				}
+
+				req, _ := http.NewRequest(http.MethodGet, baseURL, nil)
+				gotProxy, err := transport.Proxy(req)
+				if err != nil {
+					t.Fatal(err)
+				}
+				if gotProxy.String() != tt.wantProxy {
+					t.Errorf("unexpected proxy: %v, want %v", gotProxy, tt.wantProxy)
+				}
+			},
+		)
+	}
+}
+
+func TestNewClient_transport_replacedDefault(t *testing.T) {
+	defaultTransport := http.DefaultTransport
+	defer func() { http.DefaultTransport = defaultTransport }()
+	// the instrumentation libraries replace the default transport by the wrapper
+	http.DefaultTransport = newBlockingRoundTripper()
+
+	c, err := NewClient(Config{Key: "foo", TLSConfig: &tls.Config{ServerName: "console.neon.tech"}})
+	if err != nil {
+		t.Fatal(err)
+	}
+	transport, ok := c.cfg.HTTPClient.(*http.Client).Transport.(*http.Transport)
+	if !ok || transport.TLSClientConfig == nil || transport.TLSClientConfig.ServerName != "console.neon.tech" {
+		t.Errorf("custom transport expected, got %v", c.cfg.HTTPClient.(*http.Client).Transport)
+	}
+}
@@ -0,0 +1,55 @@
+package sdk
+
+import (
+	"bytes"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"reflect"
+)
+
+// ErrUnionNoMatch the error returned when the JSON value matches none of the types of the union
+// generated for the oneOf, or anyOf schema.
+var ErrUnionNoMatch = errors.New("value matches none of the union's types")
+
+func unionNoMatchError(name string) error {
+	return fmt.Errorf("could not decode %s: %w", name, ErrUnionNoMatch)
+}
+
+// marshalUnion encodes the first non-nil variant, or null if all variants are nil.
+func marshalUnion(variants ...interface{}) ([]byte, error) {
+	for _, v := range variants {
+		if !reflect.ValueOf(v).IsNil() {
+			return json.Marshal(v)
+		}
+	}
+	return []byte("null"), nil
+}
+
+// decodeUnionVariant decodes the JSON value into the variant, and sets the variant upon success only.
+// The strict decoding fails if the object defines the fields which the variant's type does not define,
+// hence the variants sharing the fields can be told apart.
+func decodeUnionVariant[T any](b []byte, variant **T, strict bool) error {
+	var v T
+	d := json.NewDecoder(bytes.NewReader(b))
+	if strict {
+		d.DisallowUnknownFields()
+	}
+	if err := d.Decode(&v); err != nil {
+		return err
+	}
+	*variant = &v
+	return nil
+}
+
+// unionDiscriminator returns the value of the object's property selecting the union's variant,
+// or the empty string if the value is not the object, or the property is not the string.
+func unionDiscriminator(b []byte, property string) string {
+	var v map[string]json.RawMessage
+	if err := json.Unmarshal(b, &v); err != nil {
+		return ""
+	}
+	var o string
+	_ = json.Unmarshal(v[property], &o)
+	return o
+}
@@ -0,0 +1,101 @@
+package sdk
+
+import (
+	"encoding/json"
+	"errors"
+	"testing"
+)
+
+type unionFoo struct {
+	Kind string `json:"kind"`
+	Foo  string `json:"foo"`
+}
+
+type unionBar struct {
+	Kind string `json:"kind"`
+	Foo  string `json:"foo,omitempty"`
+	Bar  int    `json:"bar"`
+}
+
+// unionFooBar the union as it is generated for the schema with the discriminator.
+type unionFooBar struct {
+	unionFoo *unionFoo
+	unionBar *unionBar
+	string   *string
+}
+
+func (v unionFooBar) MarshalJSON() ([]byte, error) {
+	return marshalUnion(v.unionFoo, v.unionBar, v.string)
+}
+
+func (v *unionFooBar) UnmarshalJSON(b []byte) error {
+	*v = unionFooBar{}
+	switch unionDiscriminator(b, "kind") {
+	case "bar":
+		return decodeUnionVariant(b, &v.unionBar, false)
+	}
+	for _, strict := range []bool{true, false} {
+		if decodeUnionVariant(b, &v.unionFoo, strict) == nil {
+			return nil
+		}
+		if decodeUnionVariant(b, &v.unionBar, strict) == nil {
+			return nil
+		}
+		if decodeUnionVariant(b, &v.string, strict) == nil {
+			return nil
+		}
+	}
+	return unionNoMatchError("unionFooBar")
+}
+
+func TestUnion_UnmarshalJSON(t *testing.T) {
+	tests := []struct {
+		name    string
+		in      string
+		wantFoo bool
+		wantBar bool
+		wantStr bool
+		wantErr error
+	}{
+		{name: "exact fields match", in: `{"foo":"qux"}`, wantFoo: true},
+		{name: "exact fields match of the second type", in: `{"foo":"qux","bar":1}`, wantBar: true},
+		{name: "discriminator", in: `{"kind":"bar","foo":"qux"}`, wantBar: true},
+		{name: "unknown fields fall back to the first decodable type", in: `{"foo":"qux","baz":1}`, wantFoo: true},
+		{name: "primitive", in: `"qux"`, wantStr: true},
+		{name: "unhappy path: no match", in: `1`, wantErr: ErrUnionNoMatch},
+	}
+	for _, tt := range tests {
+		t.Run(
+			tt.name, func(t *testing.T) {
+				var v unionFooBar
+				err := json.Unmarshal([]byte(tt.in), &v)
+				if !errors.Is(err, tt.wantErr) {
+					t.Fatalf("unexpected error: %v", err)
+				}
+				if (v.unionFoo != nil) != tt.wantFoo || (v.unionBar != nil) != tt.wantBar || (v.string != nil) != tt.wantStr {
+					t.Errorf("unexpected variant decoded: %+v", v)
+				}
+			},
+		)
+	}
+}
+
+func TestUnion_MarshalJSON(t *testing.T) {
+	s := "qux"
+	for _, tt := range []struct {
+		v    unionFooBar
+		want string
+	}{
+		{v: unionFooBar{}, want: `null`},
+		{v: unionFooBar{string: &s}, want: `"qux"`},
+		{v: unionFooBar{unionBar: &unionBar{Kind: "bar", Bar: 1}}, want: `{"kind":"bar","bar":1}`},
+	} {
+		got, err := json.Marshal(tt.v)
+		if err != nil {
+			t.Fatal(err)
+		}
+		if string(got) != tt.want {
+			t.Errorf("got %s, want %s", got, tt.want)
+		}
+	}
+}
@@ -0,0 +1,316 @@
+package sdk
+
+import (
+	_ "embed"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"math"
+	"net/url"
+	"strings"
+	"sync"
+	"time"
+)
+
+// responseSchemasJSON the schemas of the API's successful responses extracted from the OpenAPI spec.
+//
+//go:embed responseschemas.json
+var responseSchemasJSON []byte
+
+// ErrResponseSchemaMismatch the error returned when the response does not match the API's schema.
+var ErrResponseSchemaMismatch = errors.New("response does not match the API schema")
+
+// maxViolationsInError the maximum number of violations listed in the error message.
+const maxViolationsInError = 5
+
+// ResponseValidationError the violations of the API's schema found in the response.
+// It matches ErrResponseSchemaMismatch with errors.Is.
+type ResponseValidationError struct {
+	Method string
+	// Route the endpoint's route, e.g. "/projects/{project_id}".
+	Route      string
+	StatusCode int
+	// Violations the descriptions of the violations prefixed with the JSON path, e.g. "$.branch.id: ...".
+	Violations []string
+}
+
+func (e *ResponseValidationError) Error() string {
+	v := e.Violations
+	if len(v) > maxViolationsInError {
+		v = append(v[:maxViolationsInError:maxViolationsInError], "...")
+	}
+	return fmt.Sprintf(
+		"response %d of %s %s does not match the API schema: %s",
+		e.StatusCode, e.Method, e.Route, strings.Join(v, "; "),
+	)
+}
+
+func (e *ResponseValidationError) Is(target error) bool {
+	return target == ErrResponseSchemaMismatch
+}
+
+// validateResponse validates the successful response against the endpoint's schema.
+// The response of the endpoint without the schema is not validated.
+func (c Client) validateResponse(method, u string, statusCode int, body []byte) error {
+	schemas, err := loadResponseSchemas()
+	if err != nil {
+		return err
+	}
+
+	p, err := url.Parse(strings.TrimPrefix(u, c.baseURL))
+	if err != nil {
+		return err
+	}
+	route, ok := schemas.matchRoute(method, p.Path)
+	if !ok {
+		return nil
+	}
+	s, ok := schemas.Responses[method+" "+route][fmt.Sprint(statusCode)]
+	if !ok {
+		return nil
+	}
+
+	var v interface{}
+	if err := json.Unmarshal(body, &v); err != nil {
+		return &ResponseValidationError{
+			Method: method, Route: route, StatusCode: statusCode, Violations: []string{"$: " + err.Error()},
+		}
+	}
+
+	var violations []string
+	schemas.validate(v, s, "$", &violations)
+	if len(violations) > 0 {
+		return &ResponseValidationError{Method: method, Route: route, StatusCode: statusCode, Violations: violations}
+	}
+	return nil
+}
+
+type responseSchemas struct {
+	Responses map[string]map[string]*schema `json:"responses"`
+	Schemas   map[string]*schema            `json:"schemas"`
+}
+
+var (
+	responseSchemasOnce   sync.Once
+	responseSchemasParsed responseSchemas
+	responseSchemasErr    error
+)
+
+func loadResponseSchemas() (responseSchemas, error) {
+	responseSchemasOnce.Do(
+		func() {
+			responseSchemasErr = json.Unmarshal(responseSchemasJSON, &responseSchemasParsed)
+		},
+	)
+	return responseSchemasParsed, responseSchemasErr
+}
+
+// matchRoute finds the route of the request's path. The route with more literal segments is preferred,
+// e.g. "/projects/shared" over "/projects/{project_id}".
+func (r responseSchemas) matchRoute(method, p string) (string, bool) {
+	segments := strings.Split(strings.Trim(p, "/"), "/")
+
+	var (
+		o        string
+		literals = -1
+	)
+	for k := range r.Responses {
+		m, route, _ := strings.Cut(k, " ")
+		if m != method {
+			continue
+		}
+
+		routeSegments := strings.Split(strings.Trim(route, "/"), "/")
+		if len(routeSegments) != len(segments) {
+			continue
+		}
+
+		n := 0
+		for i, s := range routeSegments {
+			if strings.HasPrefix(s, "{") {
+				continue
+			}
+			if s != segments[i] {
+				n = -1
+				break
+			}
+			n++
+		}
+		if n > literals {
+			o, literals = route, n
+		}
+	}
+	return o, literals >= 0
+}
+
+// schema the subset of the OpenAPI schema object used to validate the responses.
+type schema struct {
+	Ref                  string             `json:"$ref"`
+	Type                 string             `json:"type"`
+	Format               string             `json:"format"`
+	Nullable             bool               `json:"nullable"`
+	Enum                 []interface{}      `json:"enum"`
+	Required             []string           `json:"required"`
+	Properties           map[string]*schema `json:"properties"`
+	AdditionalProperties *schemaOrBool      `json:"additionalProperties"`
+	Items                *schema            `json:"items"`
+	AllOf                []*schema          `json:"allOf"`
+	OneOf                []*schema          `json:"oneOf"`
+	AnyOf                []*schema          `json:"anyOf"`
+}
+
+// schemaOrBool the value of additionalProperties: the schema of the additional properties, or the flag.
+type schemaOrBool struct {
+	Schema  *schema
+	Allowed bool
+}
+
+func (v *schemaOrBool) UnmarshalJSON(b []byte) error {
+	if err := json.Unmarshal(b, &v.Allowed); err == nil {
+		return nil
+	}
+	v.Allowed = true
+	return json.Unmarshal(b, &v.Schema)
+}
+
+func (r responseSchemas) resolve(s *schema) *schema {
+	for s != nil && s.Ref != "" {
+		s = r.Schemas[strings.TrimPrefix(s.Ref, "#/components/schemas/")]
+	}
+	return s
+}
+
+func (r responseSchemas) validate(v interface{}, s *schema, p string, violations *[]string) {
+	s = r.resolve(s)
+	if s == nil {
+		return
+	}
+
+	r.validateComposition(v, s, p, violations)
+
+	if v == nil {
+		if s.Type != "" && !s.Nullable {
+			reportViolation(violations, p, "must not be null")
+		}
+		return
+	}
+
+	if len(s.Enum) > 0 && !containsEnum(s.Enum, v) {
+		reportViolation(violations, p, "unexpected value %v", v)
+	}
+
+	switch typ := s.Type; {
+	case typ == "object" || typ == "" && (len(s.Properties) > 0 || len(s.Required) > 0):
+		r.validateObject(v, s, p, violations)
+	case typ == "array":
+		r.validateArray(v, s, p, violations)
+	case typ == "string":
+		validateString(v, s, p, violations)
+	default:
+		validateScalar(v, typ, p, violations)
+	}
+}
+
+// validateComposition validates the value against the schemas combined with allOf, oneOf and anyOf.
+func (r responseSchemas) validateComposition(v interface{}, s *schema, p string, violations *[]string) {
+	for _, el := range s.AllOf {
+		r.validate(v, el, p, violations)
+	}
+	for _, alternatives := range [][]*schema{s.OneOf, s.AnyOf} {
+		if len(alternatives) > 0 && !r.matchesAny(v, alternatives, p) {
+			reportViolation(violations, p, "does not match any of the alternative schemas")
+		}
+	}
+}
+
+func (r responseSchemas) validateObject(v interface{}, s *schema, p string, violations *[]string) {
+	obj, ok := v.(map[string]interface{})
+	if !ok {
+		reportViolation(violations, p, "must be object")
+		return
+	}
+	for _, k := range s.Required {
+		if _, ok := obj[k]; !ok {
+			reportViolation(violations, p, "missing required attribute %s", k)
+		}
+	}
+	for k, el := range obj {
+		switch ps, ok := s.Properties[k]; {
+		case ok:
+			r.validate(el, ps, p+"."+k, violations)
+		case s.AdditionalProperties != nil && s.AdditionalProperties.Schema != nil:
+			r.validate(el, s.AdditionalProperties.Schema, p+"."+k, violations)
+		}
+	}
+}
+
+func (r responseSchemas) validateArray(v interface{}, s *schema, p string, violations *[]string) {
+	arr, ok := v.([]interface{})
+	if !ok {
+		reportViolation(violations, p, "must be array")
+		return
+	}
+	for i, el := range arr {
+		r.validate(el, s.Items, fmt.Sprintf("%s[%d]", p, i), violations)
+	}
+}
+
+func validateString(v interface{}, s *schema, p string, violations *[]string) {
+	str, ok := v.(string)
+	if !ok {
+		reportViolation(violations, p, "must be string")
+		return
+	}
+	if s.Format == "date-time" {
+		if _, err := time.Parse(time.RFC3339, str); err != nil {
+			reportViolation(violations, p, "must be date-time: %s", str)
+		}
+	}
+}
+
+// scalarTypes the checks of the values of the scalar schema types.
+var scalarTypes = map[string]func(interface{}) bool{
+	"integer": func(v interface{}) bool {
+		n, ok := v.(float64)
+		return ok && n == math.Trunc(n)
+	},
+	"number": func(v interface{}) bool {
+		_, ok := v.(float64)
+		return ok
+	},
+	"boolean": func(v interface{}) bool {
+		_, ok := v.(bool)
+		return ok
+	},
+}
+
+func validateScalar(v interface{}, typ, p string, violations *[]string) {
+	if check, ok := scalarTypes[typ]; ok && !check(v) {
+		reportViolation(violations, p, "must be %s", typ)
+	}
+}
+
+// reportViolation adds the violation found at the path p.
+func reportViolation(violations *[]string, p, format string, args ...interface{}) {
+	*violations = append(*violations, p+": "+fmt.Sprintf(format, args...))
+}
+
+func (r responseSchemas) matchesAny(v interface{}, alternatives []*schema, p string) bool {
+	for _, el := range alternatives {
+		var violations []string
+		r.validate(v, el, p, &violations)
+		if len(violations) == 0 {
+			return true
+		}
+	}
+	return false
+}
+
+func containsEnum(enum []interface{}, v interface{}) bool {
+	for _, el := range enum {
+		if el == v {
+			return true
+		}
+	}
+	return false
+}
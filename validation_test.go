@@ -0,0 +1,140 @@
+package sdk
+
+import (
+	"encoding/json"
+	"errors"
+	"io"
+	"net/http"
+	"sort"
+	"strings"
+	"testing"
+)
+
+type validationTestHTTPClient struct {
+	body string
+}
+
+func (c validationTestHTTPClient) Do(req *http.Request) (*http.Response, error) {
+	return &http.Response{
+		StatusCode: http.StatusOK,
+		Body:       io.NopCloser(strings.NewReader(c.body)),
+		Request:    req,
+	}, nil
+}
+
+func TestClient_requestHandler_ValidateResponses(t *testing.T) {
+	const route = "/projects/{project_id}/branches/{branch_id}"
+	valid := endpointResponseExamples[route]["GET"].Content
+
+	tests := []struct {
+		name     string
+		body     string
+		validate bool
+		wantErr  bool
+	}{
+		{
+			name:     "valid response",
+			body:     valid,
+			validate: true,
+		},
+		{
+			name:     "invalid response",
+			body:     `{"branch":{"id":1}}`,
+			validate: true,
+			wantErr:  true,
+		},
+		{
+			name: "invalid response not validated",
+			body: `{"branch":{"id":"br-foo"}}`,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(
+			tt.name, func(t *testing.T) {
+				c, err := NewClient(
+					Config{Key: "foo", HTTPClient: validationTestHTTPClient{body: tt.body}, ValidateResponses: tt.validate},
+				)
+				if err != nil {
+					t.Fatal(err)
+				}
+
+				var v interface{}
+				err = c.requestHandler(c.baseURL+"/projects/foo/branches/br-foo", http.MethodGet, nil, &v)
+				if tt.wantErr {
+					var e *ResponseValidationError
+					if !errors.Is(err, ErrResponseSchemaMismatch) || !errors.As(err, &e) || e.Route != route {
+						t.Fatalf("requestHandler() error = %v, want ResponseValidationError", err)
+					}
+					return
+				}
+				if err != nil {
+					t.Fatalf("requestHandler() unexpected error = %v", err)
+				}
+			},
+		)
+	}
+}
+
+func Test_responseSchemas_validate(t *testing.T) {
+	var schemas responseSchemas
+	if err := json.Unmarshal(
+		[]byte(`{"schemas":{
+"Item":{"type":"object","required":["id","state"],"properties":{
+	"id":{"type":"integer"},
+	"state":{"type":"string","enum":["ready","init"]},
+	"created_at":{"type":"string","format":"date-time"},
+	"parent":{"type":"string","nullable":true},
+	"tags":{"type":"array","items":{"type":"string"}},
+	"labels":{"type":"object","additionalProperties":{"type":"boolean"}}
+}}}}`),
+		&schemas,
+	); err != nil {
+		t.Fatal(err)
+	}
+	s := &schema{Ref: "#/components/schemas/Item"}
+
+	tests := []struct {
+		name string
+		body string
+		want []string
+	}{
+		{
+			name: "valid",
+			body: `{"id":1,"state":"ready","created_at":"2024-01-01T00:00:00Z","parent":null,"tags":["foo"],` +
+				`"labels":{"foo":true},"unknown":"foo"}`,
+		},
+		{
+			name: "missing required attribute",
+			body: `{"id":1}`,
+			want: []string{"$: missing required attribute state"},
+		},
+		{
+			name: "invalid values",
+			body: `{"id":1.5,"state":"archived","created_at":"yesterday","tags":[1],"labels":{"foo":"bar"}}`,
+			want: []string{
+				"$.created_at: must be date-time: yesterday",
+				"$.id: must be integer",
+				"$.labels.foo: must be boolean",
+				"$.state: unexpected value archived",
+				"$.tags[0]: must be string",
+			},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(
+			tt.name, func(t *testing.T) {
+				var v interface{}
+				if err := json.Unmarshal([]byte(tt.body), &v); err != nil {
+					t.Fatal(err)
+				}
+
+				var got []string
+				schemas.validate(v, s, "$", &got)
+				sort.Strings(got)
+				if strings.Join(got, "\n") != strings.Join(tt.want, "\n") {
+					t.Errorf("validate() got = %v, want %v", got, tt.want)
+				}
+			},
+		)
+	}
+}
@@ -0,0 +1,35 @@
+package sdk
+
+const (
+	// SpecVersion the version of the Neon API spec the SDK is generated from.
+	SpecVersion = "v2"
+	// SpecDigest the SHA256 digest of the Neon API spec the SDK is generated from.
+	SpecDigest = "sha256:109fc189449019860fbf8f603da1d1f048ed8511c9815dec58de37790a78ed8d"
+	// GeneratorVersion the version of the code generator the SDK is generated with.
+	GeneratorVersion = "v0.12.0"
+	// GeneratedAt the timestamp of the SDK generation in RFC3339 format, empty if not embedded upon generation.
+	GeneratedAt = ""
+)
+
+// SpecInfo defines the revision of the Neon API spec the SDK is generated from.
+type SpecInfo struct {
+	Version          string `json:"version"`
+	Digest           string `json:"digest"`
+	GeneratorVersion string `json:"generator_version"`
+	GeneratedAt      string `json:"generated_at,omitempty"`
+}
+
+// String returns the spec's version and digest, e.g. for bug reports.
+func (s SpecInfo) String() string {
+	return s.Version + " (" + s.Digest + ", generator " + s.GeneratorVersion + ")"
+}
+
+// SpecVersion returns the revision of the Neon API spec the SDK is generated from.
+func (c Client) SpecVersion() SpecInfo {
+	return SpecInfo{
+		Version:          SpecVersion,
+		Digest:           SpecDigest,
+		GeneratorVersion: GeneratorVersion,
+		GeneratedAt:      GeneratedAt,
+	}
+}
@@ -0,0 +1,24 @@
+package sdk
+
+import (
+	"strings"
+	"testing"
+)
+
+func TestClient_SpecVersion(t *testing.T) {
+	c, err := NewClient(Config{Key: "foo", HTTPClient: NewMockHTTPClient()})
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	got := c.SpecVersion()
+	if got.Version != SpecVersion || got.Digest != SpecDigest || got.GeneratorVersion != GeneratorVersion {
+		t.Errorf("SpecVersion() = %v", got)
+	}
+	if !strings.HasPrefix(got.Digest, "sha256:") {
+		t.Errorf("unexpected digest format: %s", got.Digest)
+	}
+	if s := got.String(); !strings.Contains(s, got.Digest) || !strings.HasPrefix(s, got.Version) {
+		t.Errorf("String() = %s", s)
+	}
+}